@@ -6,6 +6,7 @@ import (
 	"net/url"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -17,7 +18,17 @@ type ServerConfig struct {
 	Port           string
 	ReadTimeout    int // Read timeout in seconds
 	WriteTimeout   int // Write timeout in seconds
-	HandlerTimeout int // Handler/request processing timeout in seconds
+	HandlerTimeout int // Handler/request processing timeout in seconds (standard routes)
+
+	// Per route group timeouts in seconds
+	HealthTimeout      int // Health checks
+	AuthTimeout        int // Authentication (bcrypt makes these slower than plain reads)
+	LongRunningTimeout int // Bulk operations such as batch creation and imports
+	StreamingTimeout   int // Streaming responses such as exports; 0 disables the deadline
+
+	MaxBodyBytes   int      // Maximum size of a JSON request body in bytes
+	MaxImportBytes int      // Maximum size of an imported catalogue file in bytes
+	TrustedProxies []string // CIDRs of proxies allowed to set X-Forwarded-For/Forwarded
 }
 
 // DatabaseConfig holds database-related configuration
@@ -51,6 +62,44 @@ type JWTConfig struct {
 	TokenDuration int               // Token expiration duration in minutes
 }
 
+// Rate limit store backends
+const (
+	RateLimitBackendMemory   = "memory"   // Per-instance in-process store
+	RateLimitBackendPostgres = "postgres" // Shared store for multi-instance deployments
+)
+
+// RateLimitConfig holds rate limiting configuration
+type RateLimitConfig struct {
+	Backend         string // Store backend (memory, postgres)
+	CleanupInterval int    // Interval between expired state cleanups in seconds
+
+	// Per client IP on authentication endpoints
+	AuthMaxRequests int // Maximum requests per window
+	AuthWindow      int // Window in seconds
+
+	// Per login email, regardless of the client IP (slows down distributed credential stuffing)
+	LoginEmailMaxRequests int // Maximum requests per window
+	LoginEmailWindow      int // Window in seconds
+
+	// Per client IP on the rest of the API, before authentication (shared by the users behind one IP)
+	APIIPMaxRequests int // Maximum requests per window
+	APIIPWindow      int // Window in seconds
+
+	// Per user ID or client IP (first available) on the rest of the API
+	APIMaxRequests int // Maximum requests per window
+	APIWindow      int // Window in seconds
+}
+
+// CORSConfig holds the cross-origin resource sharing policy
+type CORSConfig struct {
+	AllowedOrigins   []string // Exact origins or wildcard patterns (https://*.example.com); "*" allows any origin
+	AllowedMethods   []string // Methods allowed in cross-origin requests
+	AllowedHeaders   []string // Request headers allowed in cross-origin requests
+	ExposedHeaders   []string // Response headers readable by browser scripts
+	AllowCredentials bool     // Whether cookies/Authorization may be sent cross-origin
+	MaxAge           int      // How long browsers may cache preflight responses in seconds
+}
+
 // AppConfig holds application-level configuration
 type AppConfig struct {
 	Env             string // Application environment (development, staging, production)
@@ -59,14 +108,18 @@ type AppConfig struct {
 
 // Config holds all application configuration
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	JWT      JWTConfig
-	App      AppConfig
+	Server    ServerConfig
+	Database  DatabaseConfig
+	JWT       JWTConfig
+	RateLimit RateLimitConfig
+	CORS      CORSConfig
+	App       AppConfig
 }
 
 // Load reads configuration from environment variables
 func Load() (*Config, error) {
+	env := getEnv("APP_ENV", "development")
+
 	cfg := &Config{
 		Server: ServerConfig{
 			Host:           getEnv("SERVER_HOST", "localhost"),
@@ -74,6 +127,15 @@ func Load() (*Config, error) {
 			ReadTimeout:    getEnvAsInt("SERVER_READ_TIMEOUT", 15),
 			WriteTimeout:   getEnvAsInt("SERVER_WRITE_TIMEOUT", 15),
 			HandlerTimeout: getEnvAsInt("SERVER_HANDLER_TIMEOUT", 10),
+
+			HealthTimeout:      getEnvAsInt("SERVER_HEALTH_TIMEOUT", 2),
+			AuthTimeout:        getEnvAsInt("SERVER_AUTH_TIMEOUT", 10),
+			LongRunningTimeout: getEnvAsInt("SERVER_LONG_RUNNING_TIMEOUT", 120),
+			StreamingTimeout:   getEnvAsInt("SERVER_STREAMING_TIMEOUT", 0),
+
+			MaxBodyBytes:   getEnvAsInt("SERVER_MAX_BODY_BYTES", 1<<20),
+			MaxImportBytes: getEnvAsInt("SERVER_MAX_IMPORT_BYTES", 10<<20),
+			TrustedProxies: getEnvAsSlice("SERVER_TRUSTED_PROXIES", nil),
 		},
 		JWT: JWTConfig{
 			Keys: map[string]string{
@@ -105,8 +167,32 @@ func Load() (*Config, error) {
 			MigrationsPath:  getEnv("DB_MIGRATIONS_PATH", "db/migrations"),
 			MigrationsTable: getEnv("DB_MIGRATIONS_TABLE", "schema_migrations"),
 		},
+		RateLimit: RateLimitConfig{
+			Backend:         getEnv("RATE_LIMIT_BACKEND", RateLimitBackendMemory),
+			CleanupInterval: getEnvAsInt("RATE_LIMIT_CLEANUP_INTERVAL", 60),
+
+			AuthMaxRequests: getEnvAsInt("RATE_LIMIT_AUTH_MAX_REQUESTS", 10),
+			AuthWindow:      getEnvAsInt("RATE_LIMIT_AUTH_WINDOW", 300),
+
+			LoginEmailMaxRequests: getEnvAsInt("RATE_LIMIT_LOGIN_EMAIL_MAX_REQUESTS", 5),
+			LoginEmailWindow:      getEnvAsInt("RATE_LIMIT_LOGIN_EMAIL_WINDOW", 900),
+
+			APIIPMaxRequests: getEnvAsInt("RATE_LIMIT_API_IP_MAX_REQUESTS", 1200),
+			APIIPWindow:      getEnvAsInt("RATE_LIMIT_API_IP_WINDOW", 60),
+
+			APIMaxRequests: getEnvAsInt("RATE_LIMIT_API_MAX_REQUESTS", 300),
+			APIWindow:      getEnvAsInt("RATE_LIMIT_API_WINDOW", 60),
+		},
+		CORS: CORSConfig{
+			AllowedOrigins:   getEnvAsSlice("CORS_ALLOWED_ORIGINS", defaultCORSOrigins(env)),
+			AllowedMethods:   getEnvAsSlice("CORS_ALLOWED_METHODS", []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"}),
+			AllowedHeaders:   getEnvAsSlice("CORS_ALLOWED_HEADERS", []string{"Content-Type", "Authorization", "X-Request-ID", "X-API-Key", "If-Match", "If-None-Match"}),
+			ExposedHeaders:   getEnvAsSlice("CORS_EXPOSED_HEADERS", []string{"X-Request-ID", "ETag"}),
+			AllowCredentials: getEnvAsBool("CORS_ALLOW_CREDENTIALS", false),
+			MaxAge:           getEnvAsInt("CORS_MAX_AGE", 3600),
+		},
 		App: AppConfig{
-			Env:             getEnv("APP_ENV", "development"),
+			Env:             env,
 			ShutdownTimeout: getEnvAsInt("APP_SHUTDOWN_TIMEOUT", 30),
 		},
 	}
@@ -150,6 +236,46 @@ func getEnvAsInt(key string, defaultValue int) int {
 	return defaultValue
 }
 
+// getEnvAsBool reads an environment variable as boolean or returns a default value
+func getEnvAsBool(key string, defaultValue bool) bool {
+	if value := os.Getenv(key); value != "" {
+		boolValue, err := strconv.ParseBool(value)
+		if err != nil {
+			log.Printf("Warning: Invalid boolean value for %s: %v\n", key, err)
+			return defaultValue
+		}
+		return boolValue
+	}
+	return defaultValue
+}
+
+// defaultCORSOrigins returns the allowed origins used when CORS_ALLOWED_ORIGINS is not set
+// Development allows local frontends on any port; other environments allow no cross-origin
+// requests until origins are configured explicitly
+func defaultCORSOrigins(env string) []string {
+	if strings.EqualFold(env, "development") {
+		return []string{"http://localhost:*", "http://127.0.0.1:*"}
+	}
+	return nil
+}
+
+// getEnvAsSlice reads a comma-separated environment variable or returns a default value
+// Empty items are dropped, so "a, ,b" yields [a b]
+func getEnvAsSlice(key string, defaultValue []string) []string {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
 func LoadConfig() (*Config, error) {
 	if err := godotenv.Load(); err != nil {
 		log.Printf("Error loading .env file: %v", err)
@@ -3,6 +3,7 @@ package config
 import (
 	"errors"
 	"fmt"
+	"net/netip"
 	"strings"
 )
 
@@ -27,6 +28,14 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("%w: database config: %w", ErrConfigValidationFailed, err)
 	}
 
+	if err := c.validateRateLimit(); err != nil {
+		return fmt.Errorf("%w: rate limit config: %w", ErrConfigValidationFailed, err)
+	}
+
+	if err := c.validateCORS(); err != nil {
+		return fmt.Errorf("%w: CORS config: %w", ErrConfigValidationFailed, err)
+	}
+
 	return nil
 }
 
@@ -48,6 +57,38 @@ func (c *Config) validateServer() error {
 		return errors.New("handler timeout must be greater than 0")
 	}
 
+	if c.Server.HealthTimeout <= 0 {
+		return errors.New("health timeout must be greater than 0")
+	}
+
+	if c.Server.AuthTimeout <= 0 {
+		return errors.New("auth timeout must be greater than 0")
+	}
+
+	if c.Server.LongRunningTimeout <= 0 {
+		return errors.New("long-running timeout must be greater than 0")
+	}
+
+	if c.Server.StreamingTimeout < 0 {
+		return errors.New("streaming timeout cannot be negative")
+	}
+
+	if c.Server.MaxBodyBytes <= 0 {
+		return errors.New("max body bytes must be greater than 0")
+	}
+
+	if c.Server.MaxImportBytes <= 0 {
+		return errors.New("max import bytes must be greater than 0")
+	}
+
+	for _, proxy := range c.Server.TrustedProxies {
+		if _, err := netip.ParsePrefix(proxy); err != nil {
+			if _, err := netip.ParseAddr(proxy); err != nil {
+				return fmt.Errorf("trusted proxy '%s' must be an IP address or CIDR", proxy)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -133,6 +174,71 @@ func (c *Config) validateDatabaseSettings() error {
 	return nil
 }
 
+// validateRateLimit validates rate limiting configuration
+func (c *Config) validateRateLimit() error {
+	switch c.RateLimit.Backend {
+	case RateLimitBackendMemory, RateLimitBackendPostgres:
+	default:
+		return fmt.Errorf("unknown backend '%s' (must be %s or %s)", c.RateLimit.Backend, RateLimitBackendMemory, RateLimitBackendPostgres)
+	}
+
+	if c.RateLimit.CleanupInterval <= 0 {
+		return errors.New("cleanup interval must be greater than 0")
+	}
+
+	limits := []struct {
+		name        string
+		maxRequests int
+		window      int
+	}{
+		{"auth", c.RateLimit.AuthMaxRequests, c.RateLimit.AuthWindow},
+		{"login email", c.RateLimit.LoginEmailMaxRequests, c.RateLimit.LoginEmailWindow},
+		{"API IP", c.RateLimit.APIIPMaxRequests, c.RateLimit.APIIPWindow},
+		{"API", c.RateLimit.APIMaxRequests, c.RateLimit.APIWindow},
+	}
+	for _, limit := range limits {
+		if limit.maxRequests <= 0 {
+			return fmt.Errorf("%s max requests must be greater than 0", limit.name)
+		}
+		if limit.window <= 0 {
+			return fmt.Errorf("%s window must be greater than 0", limit.name)
+		}
+	}
+
+	return nil
+}
+
+// validateCORS validates the CORS policy
+func (c *Config) validateCORS() error {
+	for _, origin := range c.CORS.AllowedOrigins {
+		if origin == "*" {
+			// Browsers reject "Access-Control-Allow-Origin: *" on credentialed requests
+			if c.CORS.AllowCredentials {
+				return errors.New("allowed origin '*' cannot be combined with credentials")
+			}
+			continue
+		}
+
+		if !strings.HasPrefix(origin, "http://") && !strings.HasPrefix(origin, "https://") {
+			return fmt.Errorf("allowed origin '%s' must start with http:// or https://", origin)
+		}
+
+		if strings.Count(origin, "*") > 1 {
+			return fmt.Errorf("allowed origin '%s' can contain at most one wildcard", origin)
+		}
+	}
+
+	if len(c.CORS.AllowedMethods) == 0 {
+		return errors.New("at least one allowed method is required")
+	}
+
+	if c.CORS.MaxAge < 0 {
+		return errors.New("max age cannot be negative")
+	}
+
+	return nil
+}
+
 // validateApp validates application-level configuration
 func (c *Config) validateApp() error {
 	if c.App.ShutdownTimeout <= 0 {
@@ -0,0 +1,12 @@
+-- Shared rate limit counters so every API instance enforces the same limits
+-- UNLOGGED: counters are short-lived and losing them on a crash only resets the windows
+CREATE UNLOGGED TABLE rate_limit_counters (
+    key TEXT NOT NULL,
+    window_start TIMESTAMPTZ NOT NULL,
+    hits INTEGER NOT NULL DEFAULT 1,
+    expires_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (key, window_start)
+);
+
+-- Index for expired counter cleanup
+CREATE INDEX idx_rate_limit_counters_expires_at ON rate_limit_counters(expires_at);
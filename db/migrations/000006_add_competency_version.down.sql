@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS increment_competencies_version ON competencies;
+DROP FUNCTION IF EXISTS increment_version_column();
+
+ALTER TABLE competencies DROP COLUMN IF EXISTS version;
@@ -0,0 +1,16 @@
+-- Version of a competency for optimistic concurrency control (exposed as the ETag)
+ALTER TABLE competencies ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+
+-- Bump the version on every update, so no write path can forget it
+CREATE OR REPLACE FUNCTION increment_version_column()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.version = OLD.version + 1;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER increment_competencies_version
+    BEFORE UPDATE ON competencies
+    FOR EACH ROW
+    EXECUTE FUNCTION increment_version_column();
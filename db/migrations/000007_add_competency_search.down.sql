@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_competencies_name_trgm;
+DROP INDEX IF EXISTS idx_competencies_search_vector;
+
+ALTER TABLE competencies DROP COLUMN IF EXISTS search_vector;
+
+-- pg_trgm is left installed: other objects may depend on it
@@ -0,0 +1,14 @@
+-- Trigram matching for typo-tolerant search and typeahead on names
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- Full-text document of a competency: the name weighs more than the description
+ALTER TABLE competencies ADD COLUMN search_vector TSVECTOR
+    GENERATED ALWAYS AS (
+        setweight(to_tsvector('english', name::TEXT), 'A') ||
+        setweight(to_tsvector('english', COALESCE(description, '')), 'B')
+    ) STORED;
+
+CREATE INDEX idx_competencies_search_vector ON competencies USING GIN (search_vector);
+
+-- Serves the % (similarity) and <% (word similarity) operators on names
+CREATE INDEX idx_competencies_name_trgm ON competencies USING GIN ((name::TEXT) gin_trgm_ops);
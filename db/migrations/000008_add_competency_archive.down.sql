@@ -0,0 +1 @@
+ALTER TABLE competencies DROP COLUMN IF EXISTS archived_at;
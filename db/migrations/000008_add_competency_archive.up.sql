@@ -0,0 +1,2 @@
+-- Archived competencies are kept (history, references) but hidden from listings and search
+ALTER TABLE competencies ADD COLUMN archived_at TIMESTAMP;
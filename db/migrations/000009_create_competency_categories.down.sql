@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_competencies_category_id;
+ALTER TABLE competencies DROP COLUMN IF EXISTS category_id;
+
+DROP TABLE IF EXISTS competency_categories;
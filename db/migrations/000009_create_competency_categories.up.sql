@@ -0,0 +1,25 @@
+-- Competency taxonomy: a tree of categories (adjacency list), e.g. Engineering -> Backend
+CREATE TABLE competency_categories (
+    id SERIAL PRIMARY KEY,
+    parent_id INTEGER REFERENCES competency_categories(id) ON DELETE RESTRICT,
+    name CITEXT NOT NULL,
+    position INTEGER NOT NULL DEFAULT 0, -- Order among siblings
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Sibling names are unique; root categories (NULL parent) are siblings too
+CREATE UNIQUE INDEX idx_competency_categories_parent_name ON competency_categories (COALESCE(parent_id, 0), name);
+
+-- Serves the children of a category in display order
+CREATE INDEX idx_competency_categories_parent_position ON competency_categories (parent_id, position);
+
+CREATE TRIGGER update_competency_categories_updated_at
+    BEFORE UPDATE ON competency_categories
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Each competency is placed under at most one category
+ALTER TABLE competencies ADD COLUMN category_id INTEGER REFERENCES competency_categories(id) ON DELETE RESTRICT;
+
+CREATE INDEX idx_competencies_category_id ON competencies (category_id);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS competency_levels;
+DROP TABLE IF EXISTS competency_rubrics;
+DROP TABLE IF EXISTS rating_scale_levels;
+DROP TABLE IF EXISTS rating_scales;
@@ -0,0 +1,63 @@
+-- Rating scales define the proficiency levels competencies are assessed against, e.g. 1 Aware .. 4 Expert
+CREATE TABLE rating_scales (
+    id SERIAL PRIMARY KEY,
+    name CITEXT NOT NULL UNIQUE,
+    description TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE TRIGGER update_rating_scales_updated_at
+    BEFORE UPDATE ON rating_scales
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Levels are ordered by value; they are owned by their scale
+CREATE TABLE rating_scale_levels (
+    scale_id INTEGER NOT NULL REFERENCES rating_scales(id) ON DELETE CASCADE,
+    value INTEGER NOT NULL CHECK (value > 0),
+    label TEXT NOT NULL,
+    PRIMARY KEY (scale_id, value)
+);
+
+-- The rubric of a competency: the scale it is assessed on
+-- A scale can't be deleted while a rubric uses it
+CREATE TABLE competency_rubrics (
+    competency_id INTEGER PRIMARY KEY REFERENCES competencies(id) ON DELETE CASCADE,
+    scale_id INTEGER NOT NULL REFERENCES rating_scales(id) ON DELETE RESTRICT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE (competency_id, scale_id)
+);
+
+CREATE INDEX idx_competency_rubrics_scale_id ON competency_rubrics (scale_id);
+
+CREATE TRIGGER update_competency_rubrics_updated_at
+    BEFORE UPDATE ON competency_rubrics
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- What each level of the scale means for one competency, with its behavioral indicators in order
+-- A level of a scale can't be removed while a competency describes it
+CREATE TABLE competency_levels (
+    competency_id INTEGER NOT NULL,
+    scale_id INTEGER NOT NULL,
+    level INTEGER NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    indicators TEXT[] NOT NULL DEFAULT '{}',
+    PRIMARY KEY (competency_id, level),
+    FOREIGN KEY (competency_id, scale_id) REFERENCES competency_rubrics (competency_id, scale_id) ON DELETE CASCADE,
+    FOREIGN KEY (scale_id, level) REFERENCES rating_scale_levels (scale_id, value) ON DELETE RESTRICT
+);
+
+CREATE INDEX idx_competency_levels_scale_level ON competency_levels (scale_id, level);
+
+-- Default scale
+WITH scale AS (
+    INSERT INTO rating_scales (name, description)
+    VALUES ('Proficiency', 'Four-level proficiency scale')
+    RETURNING id
+)
+INSERT INTO rating_scale_levels (scale_id, value, label)
+SELECT scale.id, levels.value, levels.label
+FROM scale, (VALUES (1, 'Aware'), (2, 'Practitioner'), (3, 'Advanced'), (4, 'Expert')) AS levels(value, label);
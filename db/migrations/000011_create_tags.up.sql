@@ -0,0 +1,25 @@
+-- Tags label competencies across categories, e.g. "go", "cloud", "soft-skill"
+-- Anyone tagging a competency can create free-form tags; curated ones are managed by admins
+CREATE TABLE tags (
+    id SERIAL PRIMARY KEY,
+    name CITEXT NOT NULL UNIQUE,
+    curated BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE TRIGGER update_tags_updated_at
+    BEFORE UPDATE ON tags
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Links are removed with their competency or tag
+CREATE TABLE competency_tags (
+    competency_id INTEGER NOT NULL REFERENCES competencies(id) ON DELETE CASCADE,
+    tag_id INTEGER NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (competency_id, tag_id)
+);
+
+-- Tag filters and usage counts look links up by tag
+CREATE INDEX idx_competency_tags_tag_id ON competency_tags(tag_id);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS competency_revisions;
+DROP FUNCTION IF EXISTS competency_snapshot(competencies);
@@ -0,0 +1,28 @@
+-- The state of a competency recorded by its revisions
+CREATE FUNCTION competency_snapshot(c competencies) RETURNS JSONB AS $$
+    SELECT jsonb_build_object(
+        'name', c.name,
+        'description', COALESCE(c.description, ''),
+        'category_id', c.category_id,
+        'archived', c.archived_at IS NOT NULL
+    );
+$$ LANGUAGE SQL STABLE;
+
+-- Change history of competencies: one row per change, numbered per competency from 1
+-- "before" is the state recorded by the previous revision (NULL for the first one), "after" the state after the change
+CREATE TABLE competency_revisions (
+    competency_id INTEGER NOT NULL REFERENCES competencies(id) ON DELETE CASCADE,
+    revision INTEGER NOT NULL,
+    action VARCHAR(20) NOT NULL CHECK (action IN ('create', 'update', 'rename', 'archive', 'restore', 'categorize', 'revert')),
+    editor_id INTEGER REFERENCES users(id) ON DELETE SET NULL, -- NULL for anonymous changes
+    reverted_from INTEGER, -- Revision restored by a revert
+    before JSONB,
+    after JSONB NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (competency_id, revision)
+);
+
+-- Existing competencies start their history with their current state
+INSERT INTO competency_revisions (competency_id, revision, action, after, created_at)
+SELECT c.id, 1, 'create', competency_snapshot(c), c.created_at
+FROM competencies c;
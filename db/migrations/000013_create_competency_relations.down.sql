@@ -0,0 +1 @@
+DROP TABLE IF EXISTS competency_relations;
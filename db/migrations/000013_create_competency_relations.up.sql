@@ -0,0 +1,14 @@
+-- Edges between competencies: "requires" (competency_id requires related_id) and the symmetric "related"
+-- A related pair is stored once, the lower ID first; edges go with either competency
+CREATE TABLE competency_relations (
+    competency_id INTEGER NOT NULL REFERENCES competencies(id) ON DELETE CASCADE,
+    related_id INTEGER NOT NULL REFERENCES competencies(id) ON DELETE CASCADE,
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('requires', 'related')),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (competency_id, related_id, kind),
+    CHECK (competency_id <> related_id),
+    CHECK (kind = 'requires' OR competency_id < related_id)
+);
+
+-- Serves the reverse direction ("required by", related pairs stored with the other ID first)
+CREATE INDEX idx_competency_relations_related_id ON competency_relations(related_id);
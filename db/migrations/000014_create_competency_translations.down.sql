@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS competency_level_translations;
+DROP TABLE IF EXISTS competency_translations;
@@ -0,0 +1,28 @@
+-- Translations of competencies into the locales other than the fallback one (en), which is stored on the competency itself
+-- Names are unique per locale, like the names of the competencies
+CREATE TABLE competency_translations (
+    competency_id INTEGER NOT NULL REFERENCES competencies(id) ON DELETE CASCADE,
+    locale VARCHAR(10) NOT NULL CHECK (locale IN ('de', 'fa')),
+    name CITEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (competency_id, locale),
+    UNIQUE (locale, name)
+);
+
+CREATE TRIGGER update_competency_translations_updated_at
+    BEFORE UPDATE ON competency_translations
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Translations of the level descriptions of a competency rubric; they go with the rubric
+-- Translations of levels that aren't described (anymore) are kept but never shown
+CREATE TABLE competency_level_translations (
+    competency_id INTEGER NOT NULL REFERENCES competency_rubrics(competency_id) ON DELETE CASCADE,
+    level INTEGER NOT NULL,
+    locale VARCHAR(10) NOT NULL CHECK (locale IN ('de', 'fa')),
+    description TEXT NOT NULL DEFAULT '',
+    indicators TEXT[] NOT NULL DEFAULT '{}',
+    PRIMARY KEY (competency_id, locale, level)
+);
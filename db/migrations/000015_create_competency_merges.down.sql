@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_competencies_description_trgm;
+DELETE FROM competency_revisions WHERE action = 'merge';
+ALTER TABLE competency_revisions DROP CONSTRAINT competency_revisions_action_check;
+ALTER TABLE competency_revisions ADD CONSTRAINT competency_revisions_action_check
+    CHECK (action IN ('create', 'update', 'rename', 'archive', 'restore', 'categorize', 'revert'));
+ALTER TABLE competency_revisions DROP COLUMN IF EXISTS merged_id;
+DROP TABLE IF EXISTS competency_merges;
@@ -0,0 +1,20 @@
+-- Competencies merged into another one: the merged row is deleted, and lookups of its ID are redirected
+-- to the competency it was merged into. The state of the merged competency is kept for the record
+CREATE TABLE competency_merges (
+    merged_id INTEGER PRIMARY KEY, -- ID of the deleted competency
+    competency_id INTEGER NOT NULL REFERENCES competencies(id) ON DELETE CASCADE,
+    snapshot JSONB NOT NULL, -- competency_snapshot of the merged competency
+    merged_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    merged_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_competency_merges_competency_id ON competency_merges(competency_id);
+
+-- Merges are recorded in the history of the competency that absorbed the other one
+ALTER TABLE competency_revisions ADD COLUMN merged_id INTEGER; -- Competency merged by a merge
+ALTER TABLE competency_revisions DROP CONSTRAINT competency_revisions_action_check;
+ALTER TABLE competency_revisions ADD CONSTRAINT competency_revisions_action_check
+    CHECK (action IN ('create', 'update', 'rename', 'archive', 'restore', 'categorize', 'revert', 'merge'));
+
+-- The duplicate report compares descriptions too
+CREATE INDEX idx_competencies_description_trgm ON competencies USING GIN (description gin_trgm_ops);
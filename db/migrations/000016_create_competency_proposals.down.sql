@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS proposal_comments;
+DROP TABLE IF EXISTS competency_proposals;
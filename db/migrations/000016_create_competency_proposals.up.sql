@@ -0,0 +1,42 @@
+-- Changes to the catalogue suggested by users: a new competency ("create") or new texts or category for an
+-- existing one ("edit"; NULL fields are left as they are). Admins approve them, which applies the change
+-- Status flow: draft -> submitted -> approved | rejected
+CREATE TABLE competency_proposals (
+    id SERIAL PRIMARY KEY,
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('create', 'edit')),
+    -- Competency edited, or the one created on approval; kept NULL when the competency is deleted
+    competency_id INTEGER REFERENCES competencies(id) ON DELETE SET NULL,
+    proposer_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name TEXT,
+    description TEXT,
+    category_id INTEGER REFERENCES competency_categories(id) ON DELETE SET NULL,
+    rationale TEXT NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL DEFAULT 'draft' CHECK (status IN ('draft', 'submitted', 'approved', 'rejected')),
+    reviewer_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    submitted_at TIMESTAMP,
+    reviewed_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CHECK (kind = 'edit' OR name IS NOT NULL)
+);
+
+CREATE TRIGGER update_competency_proposals_updated_at
+    BEFORE UPDATE ON competency_proposals
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- "My proposals" and the review queue
+CREATE INDEX idx_competency_proposals_proposer_id ON competency_proposals(proposer_id);
+CREATE INDEX idx_competency_proposals_submitted ON competency_proposals(submitted_at) WHERE status = 'submitted';
+CREATE INDEX idx_competency_proposals_competency_id ON competency_proposals(competency_id);
+
+-- Discussion of a proposal between its proposer and reviewers, oldest first
+CREATE TABLE proposal_comments (
+    id SERIAL PRIMARY KEY,
+    proposal_id INTEGER NOT NULL REFERENCES competency_proposals(id) ON DELETE CASCADE,
+    author_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    body TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_proposal_comments_proposal_id ON proposal_comments(proposal_id);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS notifications;
+DROP TABLE IF EXISTS competency_reports;
+DROP TABLE IF EXISTS competency_stewards;
@@ -0,0 +1,37 @@
+-- Users responsible for keeping a competency current; they can edit it without being admins
+CREATE TABLE competency_stewards (
+    competency_id INTEGER NOT NULL REFERENCES competencies(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    assigned_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    assigned_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (competency_id, user_id)
+);
+
+-- "My stewarded competencies" and the permission check of stewards
+CREATE INDEX idx_competency_stewards_user_id ON competency_stewards(user_id);
+
+-- Reports that a competency is outdated, sent to its stewards
+CREATE TABLE competency_reports (
+    id SERIAL PRIMARY KEY,
+    competency_id INTEGER NOT NULL REFERENCES competencies(id) ON DELETE CASCADE,
+    reporter_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    reason TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_competency_reports_competency_id ON competency_reports(competency_id, created_at);
+
+-- Inbox of the users: stewards are notified of submitted proposals and stale-content reports on their competencies
+CREATE TABLE notifications (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    kind VARCHAR(30) NOT NULL CHECK (kind IN ('proposal_submitted', 'stale_report')),
+    competency_id INTEGER NOT NULL REFERENCES competencies(id) ON DELETE CASCADE,
+    proposal_id INTEGER REFERENCES competency_proposals(id) ON DELETE CASCADE,
+    report_id INTEGER REFERENCES competency_reports(id) ON DELETE CASCADE,
+    read_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_notifications_user_id ON notifications(user_id, id DESC);
+CREATE INDEX idx_notifications_competency_id ON notifications(competency_id);
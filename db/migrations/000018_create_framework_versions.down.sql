@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS framework_versions;
+DROP FUNCTION IF EXISTS prevent_framework_version_change();
+DROP FUNCTION IF EXISTS framework_snapshot();
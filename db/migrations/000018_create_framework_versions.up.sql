@@ -0,0 +1,76 @@
+-- The competency framework as published: the category tree, and the competencies that aren't archived with
+-- their rubrics (every level of the scale, undescribed ones with empty texts), in the fallback locale
+CREATE FUNCTION framework_snapshot() RETURNS JSONB AS $$
+    SELECT jsonb_build_object(
+        'categories', COALESCE((
+            SELECT jsonb_agg(jsonb_build_object(
+                'id', cc.id,
+                'parent_id', cc.parent_id,
+                'name', cc.name,
+                'position', cc.position
+            ) ORDER BY cc.id)
+            FROM competency_categories cc
+        ), '[]'::jsonb),
+        'competencies', COALESCE((
+            SELECT jsonb_agg(jsonb_build_object(
+                'id', c.id,
+                'name', c.name,
+                'description', COALESCE(c.description, ''),
+                'category_id', c.category_id,
+                'rubric', (
+                    SELECT jsonb_build_object(
+                        'scale_id', r.scale_id,
+                        'scale_name', s.name,
+                        'levels', (
+                            SELECT COALESCE(jsonb_agg(jsonb_build_object(
+                                'value', sl.value,
+                                'label', sl.label,
+                                'description', COALESCE(cl.description, ''),
+                                'indicators', to_jsonb(COALESCE(cl.indicators, '{}'))
+                            ) ORDER BY sl.value), '[]'::jsonb)
+                            FROM rating_scale_levels sl
+                            LEFT JOIN competency_levels cl ON cl.competency_id = c.id AND cl.level = sl.value
+                            WHERE sl.scale_id = r.scale_id
+                        )
+                    )
+                    FROM competency_rubrics r
+                    JOIN rating_scales s ON s.id = r.scale_id
+                    WHERE r.competency_id = c.id
+                )
+            ) ORDER BY c.id)
+            FROM competencies c
+            WHERE c.archived_at IS NULL
+        ), '[]'::jsonb)
+    );
+$$ LANGUAGE SQL STABLE;
+
+-- Published versions of the framework, e.g. "2026.2"; the catalogue itself is the draft of the next one
+CREATE TABLE framework_versions (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(32) NOT NULL UNIQUE,
+    notes TEXT NOT NULL DEFAULT '',
+    snapshot JSONB NOT NULL,
+    competency_count INTEGER NOT NULL,
+    published_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    published_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Published versions are immutable: only the publisher is cleared when the user is deleted
+CREATE FUNCTION prevent_framework_version_change() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'DELETE'
+        OR NEW.name <> OLD.name
+        OR NEW.notes <> OLD.notes
+        OR NEW.snapshot <> OLD.snapshot
+        OR NEW.competency_count <> OLD.competency_count
+        OR NEW.published_at <> OLD.published_at THEN
+        RAISE EXCEPTION 'framework version % is immutable', OLD.name;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER framework_versions_immutable
+    BEFORE UPDATE OR DELETE ON framework_versions
+    FOR EACH ROW
+    EXECUTE FUNCTION prevent_framework_version_change();
@@ -0,0 +1,84 @@
+-- name: CreateCategory :one
+-- The category is placed after its siblings
+INSERT INTO competency_categories (
+    parent_id,
+    name,
+    position
+) VALUES (
+    sqlc.narg(parent_id),
+    @name,
+    (SELECT COUNT(*) FROM competency_categories WHERE parent_id IS NOT DISTINCT FROM sqlc.narg(parent_id)::INTEGER)
+) RETURNING *;
+
+-- name: GetCategoryByID :one
+SELECT * FROM competency_categories
+WHERE id = $1
+LIMIT 1;
+
+-- name: ListCategories :many
+-- Every category, siblings in display order
+SELECT * FROM competency_categories
+ORDER BY parent_id NULLS FIRST, position, id;
+
+-- name: ListCategorySubtree :many
+-- The category and all its descendants, siblings in display order
+WITH RECURSIVE subtree AS (
+    SELECT competency_categories.id FROM competency_categories WHERE competency_categories.id = @id
+    UNION ALL
+    SELECT child.id FROM competency_categories child JOIN subtree ON child.parent_id = subtree.id
+)
+SELECT * FROM competency_categories
+WHERE id IN (SELECT subtree.id FROM subtree)
+ORDER BY parent_id NULLS FIRST, position, id;
+
+-- name: ListCategoryChildren :many
+-- The children of a category (root categories when parent_id is NULL) in display order
+SELECT * FROM competency_categories
+WHERE parent_id IS NOT DISTINCT FROM sqlc.narg(parent_id)::INTEGER
+ORDER BY position, id;
+
+-- name: IsCategoryInSubtree :one
+-- Reports whether candidate_id is root_id or one of its descendants
+WITH RECURSIVE subtree AS (
+    SELECT competency_categories.id FROM competency_categories WHERE competency_categories.id = @root_id
+    UNION ALL
+    SELECT child.id FROM competency_categories child JOIN subtree ON child.parent_id = subtree.id
+)
+SELECT EXISTS (SELECT 1 FROM subtree WHERE subtree.id = @candidate_id::INTEGER);
+
+-- name: LockCategories :exec
+-- Serializes changes to the shape of the tree until the end of the transaction; reads aren't blocked
+LOCK TABLE competency_categories IN SHARE ROW EXCLUSIVE MODE;
+
+-- name: RenameCategory :one
+UPDATE competency_categories
+SET name = @name
+WHERE id = @id
+RETURNING *;
+
+-- name: SetCategoryParent :one
+UPDATE competency_categories
+SET parent_id = sqlc.narg(parent_id)
+WHERE id = @id
+RETURNING *;
+
+-- name: SetCategoryPositions :exec
+-- Sets the position of each category to its index in ids
+UPDATE competency_categories
+SET position = ordered.ord - 1
+FROM unnest(@ids::INTEGER[]) WITH ORDINALITY AS ordered(id, ord)
+WHERE competency_categories.id = ordered.id
+  AND competency_categories.position <> ordered.ord - 1;
+
+-- name: DeleteCategory :execrows
+-- Fails with a foreign key violation while the category has children or competencies
+DELETE FROM competency_categories
+WHERE id = @id;
+
+-- name: CountCompetenciesByCategory :many
+-- Number of competencies (archived ones excluded) placed directly in each category
+SELECT category_id::INTEGER AS category_id, COUNT(*) AS competencies
+FROM competencies
+WHERE category_id IS NOT NULL
+  AND archived_at IS NULL
+GROUP BY category_id;
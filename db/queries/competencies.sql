@@ -4,25 +4,196 @@ INSERT INTO competencies (
     description
 ) VALUES (
     $1, $2
-) RETURNING *;
+) RETURNING id, name, description, created_at, updated_at, version, archived_at, category_id;
+
+-- name: CreateCompetencies :batchone
+-- Inserts competencies in one round trip; names that already exist are skipped and return no row
+INSERT INTO competencies (
+    name,
+    description
+) VALUES (
+    @name, @description
+)
+ON CONFLICT (name) DO NOTHING
+RETURNING id, name, description, created_at, updated_at, version, archived_at, category_id;
 
 -- name: GetCompetencyByName :one
-SELECT * FROM competencies
+SELECT id, name, description, created_at, updated_at, version, archived_at, category_id FROM competencies
 WHERE name = $1
 LIMIT 1;
 
+-- name: GetCompetenciesByNames :many
+-- Names are compared case-insensitively (CITEXT)
+SELECT id, name, description, created_at, updated_at, version, archived_at, category_id FROM competencies
+WHERE name = ANY(@names::CITEXT[]);
+
 -- name: GetCompetencyByID :one
-SELECT * FROM competencies
+SELECT id, name, description, created_at, updated_at, version, archived_at, category_id FROM competencies
 WHERE id = $1
 LIMIT 1;
 
 -- name: UpdateCompetencyDescription :one
+-- Updates only when the competency still has the expected version (any version when it is NULL)
+-- Returns no row when the competency doesn't exist or has a different version
+UPDATE competencies
+SET description = @description,
+    updated_at = NOW()
+WHERE id = @id
+  AND (sqlc.narg(expected_version)::INTEGER IS NULL OR version = sqlc.narg(expected_version)::INTEGER)
+RETURNING id, name, description, created_at, updated_at, version, archived_at, category_id;
+
+-- name: RenameCompetency :one
+-- Renames only when the competency still has the expected version (any version when it is NULL)
+-- Returns no row when the competency doesn't exist or has a different version
+UPDATE competencies
+SET name = @name,
+    updated_at = NOW()
+WHERE id = @id
+  AND (sqlc.narg(expected_version)::INTEGER IS NULL OR version = sqlc.narg(expected_version)::INTEGER)
+RETURNING id, name, description, created_at, updated_at, version, archived_at, category_id;
+
+-- name: SetCompetencyCategory :one
+-- Places a competency under a category (none when category_id is NULL)
+UPDATE competencies
+SET category_id = sqlc.narg(category_id),
+    updated_at = NOW()
+WHERE id = @id
+RETURNING id, name, description, created_at, updated_at, version, archived_at, category_id;
+
+-- name: ArchiveCompetency :one
+-- Returns no row when the competency doesn't exist or is already archived
 UPDATE competencies
-SET description = $1,
+SET archived_at = NOW(),
     updated_at = NOW()
-WHERE id = $2
-RETURNING *;
+WHERE id = @id
+  AND archived_at IS NULL
+RETURNING id, name, description, created_at, updated_at, version, archived_at, category_id;
+
+-- name: RestoreCompetency :one
+-- Returns no row when the competency doesn't exist or isn't archived
+UPDATE competencies
+SET archived_at = NULL,
+    updated_at = NOW()
+WHERE id = @id
+  AND archived_at IS NOT NULL
+RETURNING id, name, description, created_at, updated_at, version, archived_at, category_id;
+
+-- name: IsCompetencyReferenced :one
+-- Locks the competency until the end of the transaction, so no reference can be added before it is deleted,
+-- and tells whether prerequisite or related edges, merge redirects or a rubric reference it
+-- Its tags, translations, revisions, stewards, reports and notifications are deleted with it (ON DELETE CASCADE)
+SELECT (
+    EXISTS (SELECT 1 FROM competency_relations r WHERE r.competency_id = c.id OR r.related_id = c.id)
+    OR EXISTS (SELECT 1 FROM competency_merges m WHERE m.competency_id = c.id)
+    OR EXISTS (SELECT 1 FROM competency_rubrics cr WHERE cr.competency_id = c.id)
+)::BOOLEAN AS referenced
+FROM competencies c
+WHERE c.id = @id
+FOR UPDATE;
+
+-- name: DeleteCompetency :execrows
+-- Deletes the competency with the rows referencing it (ON DELETE CASCADE); see IsCompetencyReferenced
+DELETE FROM competencies
+WHERE id = @id;
 
--- name: GetAllCompetencies :many
-SELECT * FROM competencies
-ORDER BY created_at DESC;
+-- name: ListCompetencies :many
+-- Lists a page of competencies matching the filters (NULL filters are ignored); archived ones only with include_archived
+-- category_id matches the competencies of the category and of all its descendants
+-- tags (distinct names) match competencies carrying any of them, or all of them with match_all_tags
+-- Rows are ordered by sort_by (name, created_at or updated_at) then id, ascending or descending
+-- Keyset pagination: only rows after the cursor (after_id plus after_name or after_time) are returned
+SELECT id, name, description, created_at, updated_at, version, archived_at, category_id FROM competencies
+WHERE (sqlc.narg(name_pattern)::TEXT IS NULL OR name LIKE sqlc.narg(name_pattern)::TEXT)
+  AND (sqlc.narg(has_description)::BOOLEAN IS NULL OR (COALESCE(description, '') <> '') = sqlc.narg(has_description)::BOOLEAN)
+  AND (sqlc.narg(created_after)::TIMESTAMP IS NULL OR created_at > sqlc.narg(created_after)::TIMESTAMP)
+  AND (@include_archived::BOOLEAN OR archived_at IS NULL)
+  AND (sqlc.narg(category_id)::INTEGER IS NULL OR category_id IN (
+    WITH RECURSIVE subtree AS (
+        SELECT competency_categories.id FROM competency_categories WHERE competency_categories.id = sqlc.narg(category_id)::INTEGER
+        UNION ALL
+        SELECT child.id FROM competency_categories child JOIN subtree ON child.parent_id = subtree.id
+    )
+    SELECT subtree.id FROM subtree
+  ))
+  AND (cardinality(@tags::CITEXT[]) = 0 OR (
+    SELECT COUNT(*) FROM competency_tags
+    JOIN tags ON tags.id = competency_tags.tag_id
+    WHERE competency_tags.competency_id = competencies.id AND tags.name = ANY(@tags::CITEXT[])
+  ) >= CASE WHEN @match_all_tags::BOOLEAN THEN cardinality(@tags::CITEXT[]) ELSE 1 END)
+  AND (
+    sqlc.narg(after_id)::INTEGER IS NULL
+    OR (@sort_by::TEXT = 'name' AND NOT @descending::BOOLEAN AND (name, id) > (sqlc.narg(after_name)::CITEXT, sqlc.narg(after_id)::INTEGER))
+    OR (@sort_by::TEXT = 'name' AND @descending::BOOLEAN AND (name, id) < (sqlc.narg(after_name)::CITEXT, sqlc.narg(after_id)::INTEGER))
+    OR (@sort_by::TEXT = 'created_at' AND NOT @descending::BOOLEAN AND (created_at, id) > (sqlc.narg(after_time)::TIMESTAMP, sqlc.narg(after_id)::INTEGER))
+    OR (@sort_by::TEXT = 'created_at' AND @descending::BOOLEAN AND (created_at, id) < (sqlc.narg(after_time)::TIMESTAMP, sqlc.narg(after_id)::INTEGER))
+    OR (@sort_by::TEXT = 'updated_at' AND NOT @descending::BOOLEAN AND (updated_at, id) > (sqlc.narg(after_time)::TIMESTAMP, sqlc.narg(after_id)::INTEGER))
+    OR (@sort_by::TEXT = 'updated_at' AND @descending::BOOLEAN AND (updated_at, id) < (sqlc.narg(after_time)::TIMESTAMP, sqlc.narg(after_id)::INTEGER))
+  )
+ORDER BY
+    CASE WHEN @sort_by::TEXT = 'name' AND NOT @descending::BOOLEAN THEN name END ASC,
+    CASE WHEN @sort_by::TEXT = 'name' AND @descending::BOOLEAN THEN name END DESC,
+    CASE WHEN @sort_by::TEXT = 'created_at' AND NOT @descending::BOOLEAN THEN created_at END ASC,
+    CASE WHEN @sort_by::TEXT = 'created_at' AND @descending::BOOLEAN THEN created_at END DESC,
+    CASE WHEN @sort_by::TEXT = 'updated_at' AND NOT @descending::BOOLEAN THEN updated_at END ASC,
+    CASE WHEN @sort_by::TEXT = 'updated_at' AND @descending::BOOLEAN THEN updated_at END DESC,
+    CASE WHEN NOT @descending::BOOLEAN THEN id END ASC,
+    CASE WHEN @descending::BOOLEAN THEN id END DESC
+LIMIT @row_limit;
+
+-- name: CountCompetencies :one
+-- Counts the competencies matching the filters of ListCompetencies
+SELECT COUNT(*) FROM competencies
+WHERE (sqlc.narg(name_pattern)::TEXT IS NULL OR name LIKE sqlc.narg(name_pattern)::TEXT)
+  AND (sqlc.narg(has_description)::BOOLEAN IS NULL OR (COALESCE(description, '') <> '') = sqlc.narg(has_description)::BOOLEAN)
+  AND (sqlc.narg(created_after)::TIMESTAMP IS NULL OR created_at > sqlc.narg(created_after)::TIMESTAMP)
+  AND (@include_archived::BOOLEAN OR archived_at IS NULL)
+  AND (sqlc.narg(category_id)::INTEGER IS NULL OR category_id IN (
+    WITH RECURSIVE subtree AS (
+        SELECT competency_categories.id FROM competency_categories WHERE competency_categories.id = sqlc.narg(category_id)::INTEGER
+        UNION ALL
+        SELECT child.id FROM competency_categories child JOIN subtree ON child.parent_id = subtree.id
+    )
+    SELECT subtree.id FROM subtree
+  ))
+  AND (cardinality(@tags::CITEXT[]) = 0 OR (
+    SELECT COUNT(*) FROM competency_tags
+    JOIN tags ON tags.id = competency_tags.tag_id
+    WHERE competency_tags.competency_id = competencies.id AND tags.name = ANY(@tags::CITEXT[])
+  ) >= CASE WHEN @match_all_tags::BOOLEAN THEN cardinality(@tags::CITEXT[]) ELSE 1 END);
+
+-- name: SearchCompetencies :many
+-- Full-text search over names and descriptions, plus trigram matches on names to tolerate typos
+-- Highlighted terms are wrapped in U+E000 and U+E001, so callers can escape the text before marking them up
+-- Archived competencies are never found
+SELECT id, name, description, created_at, updated_at, version,
+    (ts_rank(search_vector, websearch_to_tsquery('english', @query::TEXT)) + similarity(name::TEXT, @query::TEXT))::REAL AS rank,
+    ts_headline('english', name::TEXT, websearch_to_tsquery('english', @query::TEXT),
+        'HighlightAll=true, StartSel=' || chr(57344) || ', StopSel=' || chr(57345)) AS name_highlight,
+    ts_headline('english', COALESCE(description, ''), websearch_to_tsquery('english', @query::TEXT),
+        'MaxFragments=2, MaxWords=20, MinWords=5, StartSel=' || chr(57344) || ', StopSel=' || chr(57345)) AS description_snippet
+FROM competencies
+WHERE (search_vector @@ websearch_to_tsquery('english', @query::TEXT) OR name::TEXT % @query::TEXT)
+  AND archived_at IS NULL
+ORDER BY rank DESC, id
+LIMIT @row_limit;
+
+-- name: SuggestCompetencies :many
+-- Typeahead: names starting with the prefix first, then names containing a word similar to it (archived ones excluded)
+SELECT id, name FROM competencies
+WHERE (name LIKE @name_pattern::TEXT OR @prefix::TEXT <% name::TEXT)
+  AND archived_at IS NULL
+ORDER BY name LIKE @name_pattern::TEXT DESC, word_similarity(@prefix::TEXT, name::TEXT) DESC, length(name), name
+LIMIT @row_limit;
+
+-- name: RevertCompetency :one
+-- Sets the state recorded by a revision if the competency still has the expected version (any version when it is NULL)
+-- An archived competency keeps its archive date; returns no row when the competency doesn't exist or has a different version
+UPDATE competencies
+SET name = @name,
+    description = @description,
+    category_id = sqlc.narg(category_id),
+    archived_at = CASE WHEN @archived::BOOLEAN THEN COALESCE(archived_at, NOW()) END,
+    updated_at = NOW()
+WHERE id = @id
+  AND (sqlc.narg(expected_version)::INTEGER IS NULL OR version = sqlc.narg(expected_version)::INTEGER)
+RETURNING id, name, description, created_at, updated_at, version, archived_at, category_id;
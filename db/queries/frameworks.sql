@@ -0,0 +1,19 @@
+-- name: PublishFrameworkVersion :one
+-- Snapshots the current framework in one statement, so the version is consistent even while it is edited
+INSERT INTO framework_versions (name, notes, snapshot, competency_count, published_by)
+SELECT @name, @notes, draft.snapshot, jsonb_array_length(draft.snapshot->'competencies'), sqlc.narg(published_by)
+FROM (SELECT framework_snapshot() AS snapshot) draft
+RETURNING id, name, notes, competency_count, published_by, published_at;
+
+-- name: ListFrameworkVersions :many
+-- Latest published first, without their snapshots
+SELECT id, name, notes, competency_count, published_by, published_at
+FROM framework_versions
+ORDER BY id DESC;
+
+-- name: GetFrameworkVersion :one
+SELECT * FROM framework_versions
+WHERE name = @name;
+
+-- name: GetDraftFramework :one
+SELECT framework_snapshot();
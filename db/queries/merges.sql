@@ -0,0 +1,119 @@
+-- name: ListDuplicateCandidates :many
+-- Pairs of competencies that aren't archived with similar names or descriptions, most similar first
+-- Candidates are found with the trigram indexes (% uses pg_trgm.similarity_threshold, 0.3 by default), then scored:
+-- the name similarity, or the mean of the name and description similarities when that is higher
+SELECT competency_id, name, other_id, other_name, name_similarity, description_similarity,
+    GREATEST(name_similarity, (name_similarity + description_similarity) / 2)::REAL AS score
+FROM (
+    SELECT a.id AS competency_id, a.name::TEXT AS name, b.id AS other_id, b.name::TEXT AS other_name,
+        similarity(a.name::TEXT, b.name::TEXT)::REAL AS name_similarity,
+        COALESCE(similarity(NULLIF(a.description, ''), NULLIF(b.description, '')), 0)::REAL AS description_similarity
+    FROM competencies a
+    JOIN competencies b ON b.id > a.id
+        AND (b.name::TEXT % a.name::TEXT OR b.description % a.description)
+    WHERE a.archived_at IS NULL
+      AND b.archived_at IS NULL
+) pairs
+WHERE GREATEST(name_similarity, (name_similarity + description_similarity) / 2) >= @threshold::REAL
+ORDER BY score DESC, competency_id, other_id
+LIMIT @row_limit;
+
+-- name: GetCompetencyMerge :one
+-- The competency a merged competency now is
+SELECT competency_id FROM competency_merges
+WHERE merged_id = @merged_id;
+
+-- name: AbsorbCompetency :execrows
+-- The surviving competency keeps its texts and category, taking those of the merged one where it has none
+UPDATE competencies c
+SET description = CASE WHEN COALESCE(c.description, '') = '' THEN m.description ELSE c.description END,
+    category_id = COALESCE(c.category_id, m.category_id)
+FROM competencies m
+WHERE c.id = @competency_id
+  AND m.id = @merged_id;
+
+-- name: MoveCompetencyTags :exec
+-- Tags both competencies have are kept once
+INSERT INTO competency_tags (competency_id, tag_id, created_at)
+SELECT @competency_id, tag_id, created_at FROM competency_tags
+WHERE competency_id = @merged_id
+ON CONFLICT DO NOTHING;
+
+-- name: MoveCompetencyRelations :exec
+-- Edges of the merged competency are copied to the surviving one; edges between the two disappear,
+-- and related edges keep the lower ID first
+INSERT INTO competency_relations (competency_id, related_id, kind)
+SELECT
+    CASE WHEN kind = 'related' THEN LEAST(source_id, target_id) ELSE source_id END,
+    CASE WHEN kind = 'related' THEN GREATEST(source_id, target_id) ELSE target_id END,
+    kind
+FROM (
+    SELECT
+        CASE WHEN r.competency_id = @merged_id::INTEGER THEN @competency_id::INTEGER ELSE r.competency_id END AS source_id,
+        CASE WHEN r.related_id = @merged_id::INTEGER THEN @competency_id::INTEGER ELSE r.related_id END AS target_id,
+        r.kind
+    FROM competency_relations r
+    WHERE r.competency_id = @merged_id::INTEGER OR r.related_id = @merged_id::INTEGER
+) moved
+WHERE source_id <> target_id
+ON CONFLICT DO NOTHING;
+
+-- name: CopyCompetencyRubric :execrows
+-- Only when the surviving competency has no rubric
+INSERT INTO competency_rubrics (competency_id, scale_id)
+SELECT @competency_id, scale_id FROM competency_rubrics
+WHERE competency_id = @merged_id
+ON CONFLICT (competency_id) DO NOTHING;
+
+-- name: CopyCompetencyLevels :exec
+INSERT INTO competency_levels (competency_id, scale_id, level, description, indicators)
+SELECT @competency_id, scale_id, level, description, indicators FROM competency_levels
+WHERE competency_id = @merged_id;
+
+-- name: CopyCompetencyLevelTranslations :exec
+INSERT INTO competency_level_translations (competency_id, level, locale, description, indicators)
+SELECT @competency_id, level, locale, description, indicators FROM competency_level_translations
+WHERE competency_id = @merged_id;
+
+-- name: MoveCompetencyTranslations :exec
+-- Only the locales the surviving competency has no translation in; moving the rows keeps translated names unique
+UPDATE competency_translations
+SET competency_id = @competency_id
+WHERE competency_id = @merged_id
+  AND locale NOT IN (SELECT t.locale FROM competency_translations t WHERE t.competency_id = @competency_id);
+
+-- name: RedirectCompetencyMerges :exec
+-- Competencies merged into the merged one now redirect to the surviving one
+UPDATE competency_merges
+SET competency_id = @competency_id
+WHERE competency_id = @merged_id;
+
+-- name: InsertCompetencyMerge :exec
+INSERT INTO competency_merges (merged_id, competency_id, snapshot, merged_by)
+SELECT m.id, @competency_id, competency_snapshot(m), sqlc.narg(merged_by)
+FROM competencies m
+WHERE m.id = @merged_id;
+
+-- name: MoveCompetencyProposals :exec
+-- Proposals about the merged competency now are about the surviving one
+UPDATE competency_proposals
+SET competency_id = @competency_id
+WHERE competency_id = @merged_id;
+
+-- name: MoveCompetencyStewards :exec
+-- Stewards of both competencies are kept once
+INSERT INTO competency_stewards (competency_id, user_id, assigned_by, assigned_at)
+SELECT @competency_id, user_id, assigned_by, assigned_at FROM competency_stewards
+WHERE competency_id = @merged_id
+ON CONFLICT DO NOTHING;
+
+-- name: MoveCompetencyReports :exec
+-- Stale-content reports about the merged competency now are about the surviving one
+UPDATE competency_reports
+SET competency_id = @competency_id
+WHERE competency_id = @merged_id;
+
+-- name: MoveCompetencyNotifications :exec
+UPDATE notifications
+SET competency_id = @competency_id
+WHERE competency_id = @merged_id;
@@ -0,0 +1,75 @@
+-- name: CreateProposal :one
+INSERT INTO competency_proposals (kind, competency_id, proposer_id, name, description, category_id, rationale)
+VALUES (@kind, sqlc.narg(competency_id), @proposer_id, sqlc.narg(name), sqlc.narg(description), sqlc.narg(category_id), @rationale)
+RETURNING *;
+
+-- name: GetProposal :one
+SELECT * FROM competency_proposals
+WHERE id = @id;
+
+-- name: GetProposalForUpdate :one
+-- Locks the proposal until the end of the transaction, so it is reviewed once
+SELECT * FROM competency_proposals
+WHERE id = @id
+FOR UPDATE;
+
+-- name: ListProposalsByProposer :many
+-- Newest first; only those with status when it is set
+SELECT * FROM competency_proposals
+WHERE proposer_id = @proposer_id
+  AND (sqlc.narg(status)::TEXT IS NULL OR status = sqlc.narg(status)::TEXT)
+ORDER BY created_at DESC, id DESC;
+
+-- name: ListSubmittedProposals :many
+-- The review queue: oldest submission first
+SELECT * FROM competency_proposals
+WHERE status = 'submitted'
+ORDER BY submitted_at, id
+LIMIT @row_limit;
+
+-- name: UpdateProposalDraft :one
+-- Only drafts can be edited; the kind and competency of a proposal don't change
+UPDATE competency_proposals
+SET name = sqlc.narg(name),
+    description = sqlc.narg(description),
+    category_id = sqlc.narg(category_id),
+    rationale = @rationale
+WHERE id = @id
+  AND status = 'draft'
+RETURNING *;
+
+-- name: SubmitProposal :one
+UPDATE competency_proposals
+SET status = 'submitted',
+    submitted_at = NOW()
+WHERE id = @id
+  AND status = 'draft'
+RETURNING *;
+
+-- name: ReviewProposal :one
+-- Approves or rejects a submitted proposal; an approved creation records the competency it created
+UPDATE competency_proposals
+SET status = @status,
+    reviewer_id = sqlc.narg(reviewer_id),
+    reviewed_at = NOW(),
+    competency_id = COALESCE(sqlc.narg(competency_id), competency_id)
+WHERE id = @id
+  AND status = 'submitted'
+RETURNING *;
+
+-- name: DeleteProposal :execrows
+-- Reviewed proposals are kept as the record of the review
+DELETE FROM competency_proposals
+WHERE id = @id
+  AND status IN ('draft', 'submitted');
+
+-- name: CreateProposalComment :one
+INSERT INTO proposal_comments (proposal_id, author_id, body)
+VALUES (@proposal_id, sqlc.narg(author_id), @body)
+RETURNING id, proposal_id, author_id, body, created_at;
+
+-- name: ListProposalComments :many
+-- Oldest first
+SELECT id, proposal_id, author_id, body, created_at FROM proposal_comments
+WHERE proposal_id = @proposal_id
+ORDER BY created_at, id;
@@ -0,0 +1,23 @@
+-- name: IncrementRateLimitCounter :one
+-- Counts a request in the current fixed window of the key
+-- The window start is computed from the database clock so all instances agree on it
+-- Returns no row when the counter already reached max_hits (request rejected, not counted)
+INSERT INTO rate_limit_counters (
+    key,
+    window_start,
+    hits,
+    expires_at
+) VALUES (
+    @key,
+    to_timestamp(floor(extract(epoch FROM NOW()) / @window_seconds::int) * @window_seconds::int),
+    1,
+    to_timestamp(floor(extract(epoch FROM NOW()) / @window_seconds::int) * @window_seconds::int) + make_interval(secs => @window_seconds::int)
+)
+ON CONFLICT (key, window_start) DO UPDATE
+SET hits = rate_limit_counters.hits + 1
+WHERE rate_limit_counters.hits < @max_hits::int
+RETURNING hits;
+
+-- name: DeleteExpiredRateLimitCounters :execrows
+DELETE FROM rate_limit_counters
+WHERE expires_at < NOW();
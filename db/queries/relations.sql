@@ -0,0 +1,77 @@
+-- name: LockCompetencyRelations :exec
+-- Serializes changes to the relations until the end of the transaction; reads aren't blocked
+LOCK TABLE competency_relations IN SHARE ROW EXCLUSIVE MODE;
+
+-- name: AddCompetencyRelation :exec
+-- Adding an existing edge changes nothing
+INSERT INTO competency_relations (competency_id, related_id, kind)
+VALUES (@competency_id, @related_id, @kind)
+ON CONFLICT DO NOTHING;
+
+-- name: RemoveCompetencyRelation :execrows
+DELETE FROM competency_relations
+WHERE competency_id = @competency_id
+  AND related_id = @related_id
+  AND kind = @kind;
+
+-- name: IsPrerequisite :one
+-- Reports whether competency_id requires candidate_id, directly or through other prerequisites (or is it)
+WITH RECURSIVE prerequisites AS (
+    SELECT @competency_id::INTEGER AS id
+    UNION
+    SELECT r.related_id FROM competency_relations r
+    JOIN prerequisites ON r.competency_id = prerequisites.id
+    WHERE r.kind = 'requires'
+)
+SELECT EXISTS (SELECT 1 FROM prerequisites WHERE prerequisites.id = @candidate_id::INTEGER);
+
+-- name: ListDirectRelations :many
+-- The competencies linked to competency_id, with the direction of the edge: requires, required_by or related
+SELECT c.id, c.name,
+    (CASE
+        WHEN r.kind = 'related' THEN 'related'
+        WHEN r.competency_id = @competency_id::INTEGER THEN 'requires'
+        ELSE 'required_by'
+    END)::TEXT AS direction
+FROM competency_relations r
+JOIN competencies c ON c.id = CASE WHEN r.competency_id = @competency_id::INTEGER THEN r.related_id ELSE r.competency_id END
+WHERE r.competency_id = @competency_id::INTEGER OR r.related_id = @competency_id::INTEGER
+ORDER BY c.name;
+
+-- name: ListPrerequisites :many
+-- The competencies the competencies of ids require, directly or not, with the fewest steps to reach them
+-- The competencies of ids aren't listed unless one requires another
+WITH RECURSIVE prerequisites AS (
+    SELECT r.related_id AS id, 1 AS depth
+    FROM competency_relations r
+    WHERE r.kind = 'requires' AND r.competency_id = ANY(@ids::INTEGER[])
+    UNION
+    SELECT r.related_id, prerequisites.depth + 1
+    FROM competency_relations r
+    JOIN prerequisites ON r.competency_id = prerequisites.id
+    WHERE r.kind = 'requires'
+)
+SELECT c.id, c.name, MIN(prerequisites.depth)::INTEGER AS depth
+FROM prerequisites
+JOIN competencies c ON c.id = prerequisites.id
+GROUP BY c.id, c.name
+ORDER BY depth, c.name;
+
+-- name: ListRequiresEdges :many
+-- The "requires" edges leaving the competencies of ids
+SELECT competency_id, related_id FROM competency_relations
+WHERE kind = 'requires' AND competency_id = ANY(@ids::INTEGER[]);
+
+-- name: ListGraphEdges :many
+-- Every edge between competencies that aren't archived
+SELECT r.competency_id, r.related_id, r.kind
+FROM competency_relations r
+JOIN competencies c ON c.id = r.competency_id AND c.archived_at IS NULL
+JOIN competencies related ON related.id = r.related_id AND related.archived_at IS NULL
+ORDER BY r.competency_id, r.related_id, r.kind;
+
+-- name: ListCompetencyNames :many
+-- The names of the competencies of ids; unknown IDs are left out
+SELECT id, name FROM competencies
+WHERE id = ANY(@ids::INTEGER[])
+ORDER BY name;
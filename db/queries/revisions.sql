@@ -0,0 +1,28 @@
+-- name: RecordCompetencyRevisions :execrows
+-- Records the current state of the competencies as their next revision, the previous one being its before value
+-- Competencies whose state didn't change since their last revision are skipped, unless the change is a merge
+INSERT INTO competency_revisions (competency_id, revision, action, editor_id, reverted_from, merged_id, before, after)
+SELECT c.id, COALESCE(last.revision, 0) + 1, @action, sqlc.narg(editor_id), sqlc.narg(reverted_from), sqlc.narg(merged_id), last.after, competency_snapshot(c)
+FROM competencies c
+LEFT JOIN LATERAL (
+    SELECT r.revision, r.after
+    FROM competency_revisions r
+    WHERE r.competency_id = c.id
+    ORDER BY r.revision DESC
+    LIMIT 1
+) last ON TRUE
+WHERE c.id = ANY(@competency_ids::INTEGER[])
+  AND (sqlc.narg(merged_id)::INTEGER IS NOT NULL OR last.after IS DISTINCT FROM competency_snapshot(c));
+
+-- name: ListCompetencyRevisions :many
+-- Newest first; only the revisions before before_revision when it is set
+SELECT * FROM competency_revisions
+WHERE competency_id = @competency_id
+  AND (sqlc.narg(before_revision)::INTEGER IS NULL OR revision < sqlc.narg(before_revision)::INTEGER)
+ORDER BY revision DESC
+LIMIT @row_limit;
+
+-- name: GetCompetencyRevision :one
+SELECT * FROM competency_revisions
+WHERE competency_id = @competency_id
+  AND revision = @revision;
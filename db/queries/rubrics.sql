@@ -0,0 +1,115 @@
+-- name: CreateRatingScale :one
+INSERT INTO rating_scales (
+    name,
+    description
+) VALUES (
+    @name,
+    @description
+) RETURNING *;
+
+-- name: GetRatingScaleByID :one
+SELECT * FROM rating_scales
+WHERE id = $1
+LIMIT 1;
+
+-- name: ListRatingScales :many
+SELECT * FROM rating_scales
+ORDER BY name;
+
+-- name: UpdateRatingScale :one
+UPDATE rating_scales
+SET name = @name,
+    description = @description
+WHERE id = @id
+RETURNING *;
+
+-- name: DeleteRatingScale :execrows
+-- Fails with a foreign key violation while a competency rubric uses the scale
+DELETE FROM rating_scales
+WHERE id = @id;
+
+-- name: ListRatingScaleLevels :many
+-- The levels of the given scales, ordered by scale then value
+SELECT * FROM rating_scale_levels
+WHERE scale_id = ANY(@scale_ids::INTEGER[])
+ORDER BY scale_id, value;
+
+-- name: UpsertRatingScaleLevels :exec
+-- Adds the levels of level_values with their labels, or relabels them when they exist
+INSERT INTO rating_scale_levels (scale_id, value, label)
+SELECT @scale_id::INTEGER, levels.value, levels.label
+FROM unnest(@level_values::INTEGER[], @labels::TEXT[]) AS levels(value, label)
+ON CONFLICT (scale_id, value) DO UPDATE SET label = EXCLUDED.label;
+
+-- name: DeleteRatingScaleLevelsExcept :exec
+-- Removes the levels not in level_values; fails with a foreign key violation while a competency describes one of them
+DELETE FROM rating_scale_levels
+WHERE scale_id = @scale_id
+  AND NOT (value = ANY(@level_values::INTEGER[]));
+
+-- name: GetCompetencyRubric :one
+SELECT * FROM competency_rubrics
+WHERE competency_id = $1
+LIMIT 1;
+
+-- name: UpsertCompetencyRubric :one
+-- Sets the scale of a competency rubric; its level descriptions must be removed first when the scale changes
+INSERT INTO competency_rubrics (
+    competency_id,
+    scale_id
+) VALUES (
+    @competency_id,
+    @scale_id
+)
+ON CONFLICT (competency_id) DO UPDATE SET scale_id = EXCLUDED.scale_id
+RETURNING *;
+
+-- name: DeleteCompetencyRubric :execrows
+-- The level descriptions are deleted with the rubric
+DELETE FROM competency_rubrics
+WHERE competency_id = @competency_id;
+
+-- name: ListCompetencyLevels :many
+SELECT * FROM competency_levels
+WHERE competency_id = $1
+ORDER BY level;
+
+-- name: UpsertCompetencyLevel :exec
+-- Fails with a foreign key violation when the rubric doesn't use scale_id or the scale has no such level
+INSERT INTO competency_levels (
+    competency_id,
+    scale_id,
+    level,
+    description,
+    indicators
+) VALUES (
+    @competency_id,
+    @scale_id,
+    @level,
+    @description,
+    @indicators
+)
+ON CONFLICT (competency_id, level) DO UPDATE SET
+    description = EXCLUDED.description,
+    indicators = EXCLUDED.indicators;
+
+-- name: DeleteCompetencyLevels :exec
+DELETE FROM competency_levels
+WHERE competency_id = @competency_id;
+
+-- name: DeleteCompetencyLevel :execrows
+DELETE FROM competency_levels
+WHERE competency_id = @competency_id
+  AND level = @level;
+
+-- name: TouchCompetency :exec
+-- Bumps the version of a competency (see the version trigger) when data embedded in it changes
+UPDATE competencies
+SET updated_at = NOW()
+WHERE id = @id;
+
+-- name: TouchCompetenciesByScale :exec
+-- Bumps the version of the competencies whose rubric uses the scale
+UPDATE competencies
+SET updated_at = NOW()
+WHERE id IN (SELECT competency_id FROM competency_rubrics WHERE scale_id = @scale_id);
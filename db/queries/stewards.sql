@@ -0,0 +1,73 @@
+-- name: AssignSteward :execrows
+-- Assigning a steward twice is a no-op
+INSERT INTO competency_stewards (
+    competency_id,
+    user_id,
+    assigned_by
+) VALUES (
+    @competency_id, @user_id, sqlc.narg(assigned_by)
+) ON CONFLICT DO NOTHING;
+
+-- name: UnassignSteward :execrows
+DELETE FROM competency_stewards
+WHERE competency_id = @competency_id AND user_id = @user_id;
+
+-- name: ListCompetencyStewards :many
+-- The stewards of a competency, first assigned first
+SELECT competency_id, user_id, assigned_by, assigned_at
+FROM competency_stewards
+WHERE competency_id = @competency_id
+ORDER BY assigned_at, user_id;
+
+-- name: IsSteward :one
+SELECT EXISTS (
+    SELECT 1 FROM competency_stewards
+    WHERE competency_id = @competency_id AND user_id = @user_id
+) AS is_steward;
+
+-- name: ListStewardedCompetencies :many
+-- The competencies a user is a steward of, by name
+SELECT id, name, description, created_at, updated_at, version, archived_at, category_id FROM competencies
+WHERE id IN (SELECT competency_id FROM competency_stewards WHERE user_id = @user_id)
+ORDER BY name;
+
+-- name: CreateCompetencyReport :one
+INSERT INTO competency_reports (
+    competency_id,
+    reporter_id,
+    reason
+) VALUES (
+    @competency_id, sqlc.narg(reporter_id), @reason
+) RETURNING id, competency_id, reporter_id, reason, created_at;
+
+-- name: ListCompetencyReports :many
+-- The stale-content reports of a competency, newest first
+SELECT id, competency_id, reporter_id, reason, created_at
+FROM competency_reports
+WHERE competency_id = @competency_id
+ORDER BY created_at DESC, id DESC
+LIMIT @row_limit;
+
+-- name: NotifyStewards :execrows
+-- Notifies every steward of a competency, except the user causing the notification
+INSERT INTO notifications (user_id, kind, competency_id, proposal_id, report_id)
+SELECT user_id, @kind, @competency_id, sqlc.narg(proposal_id), sqlc.narg(report_id)
+FROM competency_stewards
+WHERE competency_id = @competency_id
+  AND user_id IS DISTINCT FROM sqlc.narg(sender_id)::INTEGER;
+
+-- name: ListNotifications :many
+-- The notifications of a user, newest first, only unread ones with unread_only
+SELECT id, user_id, kind, competency_id, proposal_id, report_id, read_at, created_at
+FROM notifications
+WHERE user_id = @user_id
+  AND (NOT @unread_only::BOOLEAN OR read_at IS NULL)
+ORDER BY id DESC
+LIMIT @row_limit;
+
+-- name: MarkNotificationRead :one
+-- Marking a notification read twice keeps the first time
+UPDATE notifications
+SET read_at = COALESCE(read_at, NOW())
+WHERE id = @id AND user_id = @user_id
+RETURNING id, user_id, kind, competency_id, proposal_id, report_id, read_at, created_at;
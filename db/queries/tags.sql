@@ -0,0 +1,73 @@
+-- name: CreateTag :one
+INSERT INTO tags (
+    name,
+    curated
+) VALUES (
+    @name, @curated
+) RETURNING *;
+
+-- name: GetTagByID :one
+-- usage_count counts the competencies carrying the tag, archived ones excluded
+SELECT tags.id, tags.name, tags.curated, tags.created_at, tags.updated_at,
+    (SELECT COUNT(*) FROM competency_tags
+        JOIN competencies ON competencies.id = competency_tags.competency_id
+        WHERE competency_tags.tag_id = tags.id AND competencies.archived_at IS NULL) AS usage_count
+FROM tags
+WHERE tags.id = @id
+LIMIT 1;
+
+-- name: ListTags :many
+-- Lists the tags matching the filters (NULL filters are ignored), most used first
+-- usage_count counts the competencies carrying the tag, archived ones excluded
+SELECT tags.id, tags.name, tags.curated, tags.created_at, tags.updated_at,
+    (SELECT COUNT(*) FROM competency_tags
+        JOIN competencies ON competencies.id = competency_tags.competency_id
+        WHERE competency_tags.tag_id = tags.id AND competencies.archived_at IS NULL) AS usage_count
+FROM tags
+WHERE (sqlc.narg(name_pattern)::TEXT IS NULL OR tags.name LIKE sqlc.narg(name_pattern)::TEXT)
+  AND (sqlc.narg(curated)::BOOLEAN IS NULL OR tags.curated = sqlc.narg(curated)::BOOLEAN)
+ORDER BY usage_count DESC, tags.name
+LIMIT @row_limit;
+
+-- name: ListCompetencyTags :many
+-- The tags of a competency by name, with their usage counts
+SELECT tags.id, tags.name, tags.curated, tags.created_at, tags.updated_at,
+    (SELECT COUNT(*) FROM competency_tags usage
+        JOIN competencies ON competencies.id = usage.competency_id
+        WHERE usage.tag_id = tags.id AND competencies.archived_at IS NULL) AS usage_count
+FROM tags
+JOIN competency_tags ON competency_tags.tag_id = tags.id
+WHERE competency_tags.competency_id = @competency_id
+ORDER BY tags.name;
+
+-- name: UpdateTag :one
+UPDATE tags
+SET name = @name, curated = @curated
+WHERE id = @id
+RETURNING *;
+
+-- name: DeleteTag :execrows
+DELETE FROM tags
+WHERE id = @id;
+
+-- name: EnsureTags :many
+-- Returns the tags with the given names, creating the missing ones as free-form tags
+WITH created AS (
+    INSERT INTO tags (name)
+    SELECT DISTINCT unnest(@names::CITEXT[])
+    ON CONFLICT (name) DO NOTHING
+    RETURNING *
+)
+SELECT * FROM created
+UNION ALL
+SELECT * FROM tags WHERE name = ANY(@names::CITEXT[]);
+
+-- name: AddCompetencyTags :exec
+-- Tags already on the competency are left as they are
+INSERT INTO competency_tags (competency_id, tag_id)
+SELECT @competency_id, unnest(@tag_ids::INTEGER[])
+ON CONFLICT DO NOTHING;
+
+-- name: RemoveCompetencyTag :execrows
+DELETE FROM competency_tags
+WHERE competency_id = @competency_id AND tag_id = @tag_id;
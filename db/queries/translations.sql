@@ -0,0 +1,79 @@
+-- name: UpsertCompetencyTranslation :one
+INSERT INTO competency_translations (competency_id, locale, name, description)
+VALUES (@competency_id, @locale, @name, @description)
+ON CONFLICT (competency_id, locale) DO UPDATE
+SET name = EXCLUDED.name, description = EXCLUDED.description
+RETURNING *;
+
+-- name: DeleteCompetencyTranslation :execrows
+DELETE FROM competency_translations
+WHERE competency_id = @competency_id AND locale = @locale;
+
+-- name: InsertCompetencyLevelTranslation :exec
+INSERT INTO competency_level_translations (competency_id, level, locale, description, indicators)
+VALUES (@competency_id, @level, @locale, @description, @indicators);
+
+-- name: DeleteCompetencyLevelTranslations :exec
+DELETE FROM competency_level_translations
+WHERE competency_id = @competency_id AND locale = @locale;
+
+-- name: ListCompetencyTranslations :many
+-- Translations of the competencies of competency_ids, in every locale or only in locale when it is set
+SELECT * FROM competency_translations
+WHERE competency_id = ANY(@competency_ids::INTEGER[])
+  AND (sqlc.narg(locale)::TEXT IS NULL OR locale = sqlc.narg(locale))
+ORDER BY competency_id, locale;
+
+-- name: ListCompetencyLevelTranslations :many
+-- Level translations of the competencies of competency_ids, in every locale or only in locale when it is set
+SELECT * FROM competency_level_translations
+WHERE competency_id = ANY(@competency_ids::INTEGER[])
+  AND (sqlc.narg(locale)::TEXT IS NULL OR locale = sqlc.narg(locale))
+ORDER BY competency_id, locale, level;
+
+-- name: ListLocalizedNameConflicts :many
+-- Competencies showing name in one of locales: their translation has the name, or they have no translation
+-- there and their own (fallback) name is the name
+SELECT t.locale::TEXT AS locale, t.competency_id
+FROM competency_translations t
+WHERE t.name = @name::CITEXT
+  AND t.locale = ANY(@locales::TEXT[])
+  AND t.competency_id <> @exclude_id
+UNION ALL
+SELECT l.locale::TEXT AS locale, c.id AS competency_id
+FROM competencies c
+CROSS JOIN unnest(@locales::TEXT[]) AS l(locale)
+WHERE c.name = @name::CITEXT
+  AND c.id <> @exclude_id
+  AND NOT EXISTS (
+      SELECT 1 FROM competency_translations t
+      WHERE t.competency_id = c.id AND t.locale = l.locale
+  )
+ORDER BY locale, competency_id;
+
+-- name: ListMissingTranslations :many
+-- Competencies that aren't archived and lack a translation of their name, description or described levels in one of locales
+SELECT * FROM (
+    SELECT
+        c.id AS competency_id,
+        c.name::TEXT AS name,
+        l.locale::TEXT AS locale,
+        (t.competency_id IS NULL)::BOOLEAN AS missing_name,
+        (COALESCE(c.description, '') <> '' AND COALESCE(t.description, '') = '')::BOOLEAN AS missing_description,
+        COALESCE((
+            SELECT array_agg(cl.level ORDER BY cl.level)
+            FROM competency_levels cl
+            WHERE cl.competency_id = c.id
+              AND (cl.description <> '' OR cardinality(cl.indicators) > 0)
+              AND NOT EXISTS (
+                  SELECT 1 FROM competency_level_translations lt
+                  WHERE lt.competency_id = cl.competency_id AND lt.locale = l.locale AND lt.level = cl.level
+              )
+        ), '{}')::INTEGER[] AS missing_levels
+    FROM competencies c
+    CROSS JOIN unnest(@locales::TEXT[]) AS l(locale)
+    LEFT JOIN competency_translations t ON t.competency_id = c.id AND t.locale = l.locale
+    WHERE c.archived_at IS NULL
+) missing
+WHERE missing_name OR missing_description OR cardinality(missing_levels) > 0
+ORDER BY locale, name, competency_id;
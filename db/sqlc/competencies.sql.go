@@ -11,13 +11,89 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const archiveCompetency = `-- name: ArchiveCompetency :one
+UPDATE competencies
+SET archived_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1
+  AND archived_at IS NULL
+RETURNING id, name, description, created_at, updated_at, version, archived_at, category_id
+`
+
+type ArchiveCompetencyRow struct {
+	ID          int32            `json:"id"`
+	Name        string           `json:"name"`
+	Description pgtype.Text      `json:"description"`
+	CreatedAt   pgtype.Timestamp `json:"created_at"`
+	UpdatedAt   pgtype.Timestamp `json:"updated_at"`
+	Version     int32            `json:"version"`
+	ArchivedAt  pgtype.Timestamp `json:"archived_at"`
+	CategoryID  pgtype.Int4      `json:"category_id"`
+}
+
+// Returns no row when the competency doesn't exist or is already archived
+func (q *Queries) ArchiveCompetency(ctx context.Context, id int32) (ArchiveCompetencyRow, error) {
+	row := q.db.QueryRow(ctx, archiveCompetency, id)
+	var i ArchiveCompetencyRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Version,
+		&i.ArchivedAt,
+		&i.CategoryID,
+	)
+	return i, err
+}
+
+const countCompetencies = `-- name: CountCompetencies :one
+SELECT COUNT(*) FROM competencies
+WHERE ($1::TEXT IS NULL OR name LIKE $1::TEXT)
+  AND ($2::BOOLEAN IS NULL OR (COALESCE(description, '') <> '') = $2::BOOLEAN)
+  AND ($3::TIMESTAMP IS NULL OR created_at > $3::TIMESTAMP)
+  AND ($4::BOOLEAN OR archived_at IS NULL)
+  AND ($5::INTEGER IS NULL OR category_id IN (
+    WITH RECURSIVE subtree AS (
+        SELECT competency_categories.id FROM competency_categories WHERE competency_categories.id = $5::INTEGER
+        UNION ALL
+        SELECT child.id FROM competency_categories child JOIN subtree ON child.parent_id = subtree.id
+    )
+    SELECT subtree.id FROM subtree
+  ))
+  AND (cardinality($6::CITEXT[]) = 0 OR (
+    SELECT COUNT(*) FROM competency_tags
+    JOIN tags ON tags.id = competency_tags.tag_id
+    WHERE competency_tags.competency_id = competencies.id AND tags.name = ANY($6::CITEXT[])
+  ) >= CASE WHEN $7::BOOLEAN THEN cardinality($6::CITEXT[]) ELSE 1 END)
+`
+
+type CountCompetenciesParams struct {
+	NamePattern     pgtype.Text      `json:"name_pattern"`
+	HasDescription  pgtype.Bool      `json:"has_description"`
+	CreatedAfter    pgtype.Timestamp `json:"created_after"`
+	IncludeArchived bool             `json:"include_archived"`
+	CategoryID      pgtype.Int4      `json:"category_id"`
+	Tags            []string         `json:"tags"`
+	MatchAllTags    bool             `json:"match_all_tags"`
+}
+
+// Counts the competencies matching the filters of ListCompetencies
+func (q *Queries) CountCompetencies(ctx context.Context, arg CountCompetenciesParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countCompetencies, arg.NamePattern, arg.HasDescription, arg.CreatedAfter, arg.IncludeArchived, arg.CategoryID, arg.Tags, arg.MatchAllTags)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createCompetency = `-- name: CreateCompetency :one
 INSERT INTO competencies (
     name,
     description
 ) VALUES (
     $1, $2
-) RETURNING id, name, description, created_at, updated_at
+) RETURNING id, name, description, created_at, updated_at, version, archived_at, category_id
 `
 
 type CreateCompetencyParams struct {
@@ -25,39 +101,82 @@ type CreateCompetencyParams struct {
 	Description pgtype.Text `json:"description"`
 }
 
-func (q *Queries) CreateCompetency(ctx context.Context, arg CreateCompetencyParams) (Competency, error) {
+type CreateCompetencyRow struct {
+	ID          int32            `json:"id"`
+	Name        string           `json:"name"`
+	Description pgtype.Text      `json:"description"`
+	CreatedAt   pgtype.Timestamp `json:"created_at"`
+	UpdatedAt   pgtype.Timestamp `json:"updated_at"`
+	Version     int32            `json:"version"`
+	ArchivedAt  pgtype.Timestamp `json:"archived_at"`
+	CategoryID  pgtype.Int4      `json:"category_id"`
+}
+
+func (q *Queries) CreateCompetency(ctx context.Context, arg CreateCompetencyParams) (CreateCompetencyRow, error) {
 	row := q.db.QueryRow(ctx, createCompetency, arg.Name, arg.Description)
-	var i Competency
+	var i CreateCompetencyRow
 	err := row.Scan(
 		&i.ID,
 		&i.Name,
 		&i.Description,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.ArchivedAt,
+		&i.CategoryID,
 	)
 	return i, err
 }
 
-const getAllCompetencies = `-- name: GetAllCompetencies :many
-SELECT id, name, description, created_at, updated_at FROM competencies
-ORDER BY created_at DESC
+const deleteCompetency = `-- name: DeleteCompetency :execrows
+DELETE FROM competencies
+WHERE id = $1
 `
 
-func (q *Queries) GetAllCompetencies(ctx context.Context) ([]Competency, error) {
-	rows, err := q.db.Query(ctx, getAllCompetencies)
+// Deletes the competency with the rows referencing it (ON DELETE CASCADE); see IsCompetencyReferenced
+func (q *Queries) DeleteCompetency(ctx context.Context, id int32) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteCompetency, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getCompetenciesByNames = `-- name: GetCompetenciesByNames :many
+SELECT id, name, description, created_at, updated_at, version, archived_at, category_id FROM competencies
+WHERE name = ANY($1::CITEXT[])
+`
+
+type GetCompetenciesByNamesRow struct {
+	ID          int32            `json:"id"`
+	Name        string           `json:"name"`
+	Description pgtype.Text      `json:"description"`
+	CreatedAt   pgtype.Timestamp `json:"created_at"`
+	UpdatedAt   pgtype.Timestamp `json:"updated_at"`
+	Version     int32            `json:"version"`
+	ArchivedAt  pgtype.Timestamp `json:"archived_at"`
+	CategoryID  pgtype.Int4      `json:"category_id"`
+}
+
+// Names are compared case-insensitively (CITEXT)
+func (q *Queries) GetCompetenciesByNames(ctx context.Context, names []string) ([]GetCompetenciesByNamesRow, error) {
+	rows, err := q.db.Query(ctx, getCompetenciesByNames, names)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Competency
+	var items []GetCompetenciesByNamesRow
 	for rows.Next() {
-		var i Competency
+		var i GetCompetenciesByNamesRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.Name,
 			&i.Description,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Version,
+			&i.ArchivedAt,
+			&i.CategoryID,
 		); err != nil {
 			return nil, err
 		}
@@ -70,65 +189,512 @@ func (q *Queries) GetAllCompetencies(ctx context.Context) ([]Competency, error)
 }
 
 const getCompetencyByID = `-- name: GetCompetencyByID :one
-SELECT id, name, description, created_at, updated_at FROM competencies
+SELECT id, name, description, created_at, updated_at, version, archived_at, category_id FROM competencies
 WHERE id = $1
 LIMIT 1
 `
 
-func (q *Queries) GetCompetencyByID(ctx context.Context, id int32) (Competency, error) {
+type GetCompetencyByIDRow struct {
+	ID          int32            `json:"id"`
+	Name        string           `json:"name"`
+	Description pgtype.Text      `json:"description"`
+	CreatedAt   pgtype.Timestamp `json:"created_at"`
+	UpdatedAt   pgtype.Timestamp `json:"updated_at"`
+	Version     int32            `json:"version"`
+	ArchivedAt  pgtype.Timestamp `json:"archived_at"`
+	CategoryID  pgtype.Int4      `json:"category_id"`
+}
+
+func (q *Queries) GetCompetencyByID(ctx context.Context, id int32) (GetCompetencyByIDRow, error) {
 	row := q.db.QueryRow(ctx, getCompetencyByID, id)
-	var i Competency
+	var i GetCompetencyByIDRow
 	err := row.Scan(
 		&i.ID,
 		&i.Name,
 		&i.Description,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.ArchivedAt,
+		&i.CategoryID,
 	)
 	return i, err
 }
 
 const getCompetencyByName = `-- name: GetCompetencyByName :one
-SELECT id, name, description, created_at, updated_at FROM competencies
+SELECT id, name, description, created_at, updated_at, version, archived_at, category_id FROM competencies
 WHERE name = $1
 LIMIT 1
 `
 
-func (q *Queries) GetCompetencyByName(ctx context.Context, name string) (Competency, error) {
+type GetCompetencyByNameRow struct {
+	ID          int32            `json:"id"`
+	Name        string           `json:"name"`
+	Description pgtype.Text      `json:"description"`
+	CreatedAt   pgtype.Timestamp `json:"created_at"`
+	UpdatedAt   pgtype.Timestamp `json:"updated_at"`
+	Version     int32            `json:"version"`
+	ArchivedAt  pgtype.Timestamp `json:"archived_at"`
+	CategoryID  pgtype.Int4      `json:"category_id"`
+}
+
+func (q *Queries) GetCompetencyByName(ctx context.Context, name string) (GetCompetencyByNameRow, error) {
 	row := q.db.QueryRow(ctx, getCompetencyByName, name)
-	var i Competency
+	var i GetCompetencyByNameRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Version,
+		&i.ArchivedAt,
+		&i.CategoryID,
+	)
+	return i, err
+}
+
+const isCompetencyReferenced = `-- name: IsCompetencyReferenced :one
+SELECT (
+    EXISTS (SELECT 1 FROM competency_relations r WHERE r.competency_id = c.id OR r.related_id = c.id)
+    OR EXISTS (SELECT 1 FROM competency_merges m WHERE m.competency_id = c.id)
+    OR EXISTS (SELECT 1 FROM competency_rubrics cr WHERE cr.competency_id = c.id)
+)::BOOLEAN AS referenced
+FROM competencies c
+WHERE c.id = $1
+FOR UPDATE
+`
+
+// Locks the competency until the end of the transaction, so no reference can be added before it is deleted,
+// and tells whether prerequisite or related edges, merge redirects or a rubric reference it
+// Its tags, translations, revisions, stewards, reports and notifications are deleted with it (ON DELETE CASCADE)
+func (q *Queries) IsCompetencyReferenced(ctx context.Context, id int32) (bool, error) {
+	row := q.db.QueryRow(ctx, isCompetencyReferenced, id)
+	var referenced bool
+	err := row.Scan(&referenced)
+	return referenced, err
+}
+
+const listCompetencies = `-- name: ListCompetencies :many
+SELECT id, name, description, created_at, updated_at, version, archived_at, category_id FROM competencies
+WHERE ($1::TEXT IS NULL OR name LIKE $1::TEXT)
+  AND ($2::BOOLEAN IS NULL OR (COALESCE(description, '') <> '') = $2::BOOLEAN)
+  AND ($3::TIMESTAMP IS NULL OR created_at > $3::TIMESTAMP)
+  AND ($4::BOOLEAN OR archived_at IS NULL)
+  AND ($5::INTEGER IS NULL OR category_id IN (
+    WITH RECURSIVE subtree AS (
+        SELECT competency_categories.id FROM competency_categories WHERE competency_categories.id = $5::INTEGER
+        UNION ALL
+        SELECT child.id FROM competency_categories child JOIN subtree ON child.parent_id = subtree.id
+    )
+    SELECT subtree.id FROM subtree
+  ))
+  AND (cardinality($6::CITEXT[]) = 0 OR (
+    SELECT COUNT(*) FROM competency_tags
+    JOIN tags ON tags.id = competency_tags.tag_id
+    WHERE competency_tags.competency_id = competencies.id AND tags.name = ANY($6::CITEXT[])
+  ) >= CASE WHEN $7::BOOLEAN THEN cardinality($6::CITEXT[]) ELSE 1 END)
+  AND (
+    $8::INTEGER IS NULL
+    OR ($9::TEXT = 'name' AND NOT $10::BOOLEAN AND (name, id) > ($11::CITEXT, $8::INTEGER))
+    OR ($9::TEXT = 'name' AND $10::BOOLEAN AND (name, id) < ($11::CITEXT, $8::INTEGER))
+    OR ($9::TEXT = 'created_at' AND NOT $10::BOOLEAN AND (created_at, id) > ($12::TIMESTAMP, $8::INTEGER))
+    OR ($9::TEXT = 'created_at' AND $10::BOOLEAN AND (created_at, id) < ($12::TIMESTAMP, $8::INTEGER))
+    OR ($9::TEXT = 'updated_at' AND NOT $10::BOOLEAN AND (updated_at, id) > ($12::TIMESTAMP, $8::INTEGER))
+    OR ($9::TEXT = 'updated_at' AND $10::BOOLEAN AND (updated_at, id) < ($12::TIMESTAMP, $8::INTEGER))
+  )
+ORDER BY
+    CASE WHEN $9::TEXT = 'name' AND NOT $10::BOOLEAN THEN name END ASC,
+    CASE WHEN $9::TEXT = 'name' AND $10::BOOLEAN THEN name END DESC,
+    CASE WHEN $9::TEXT = 'created_at' AND NOT $10::BOOLEAN THEN created_at END ASC,
+    CASE WHEN $9::TEXT = 'created_at' AND $10::BOOLEAN THEN created_at END DESC,
+    CASE WHEN $9::TEXT = 'updated_at' AND NOT $10::BOOLEAN THEN updated_at END ASC,
+    CASE WHEN $9::TEXT = 'updated_at' AND $10::BOOLEAN THEN updated_at END DESC,
+    CASE WHEN NOT $10::BOOLEAN THEN id END ASC,
+    CASE WHEN $10::BOOLEAN THEN id END DESC
+LIMIT $13
+`
+
+type ListCompetenciesParams struct {
+	NamePattern     pgtype.Text      `json:"name_pattern"`
+	HasDescription  pgtype.Bool      `json:"has_description"`
+	CreatedAfter    pgtype.Timestamp `json:"created_after"`
+	IncludeArchived bool             `json:"include_archived"`
+	CategoryID      pgtype.Int4      `json:"category_id"`
+	Tags            []string         `json:"tags"`
+	MatchAllTags    bool             `json:"match_all_tags"`
+	AfterID         pgtype.Int4      `json:"after_id"`
+	SortBy          string           `json:"sort_by"`
+	Descending      bool             `json:"descending"`
+	AfterName       pgtype.Text      `json:"after_name"`
+	AfterTime       pgtype.Timestamp `json:"after_time"`
+	RowLimit        int32            `json:"row_limit"`
+}
+
+type ListCompetenciesRow struct {
+	ID          int32            `json:"id"`
+	Name        string           `json:"name"`
+	Description pgtype.Text      `json:"description"`
+	CreatedAt   pgtype.Timestamp `json:"created_at"`
+	UpdatedAt   pgtype.Timestamp `json:"updated_at"`
+	Version     int32            `json:"version"`
+	ArchivedAt  pgtype.Timestamp `json:"archived_at"`
+	CategoryID  pgtype.Int4      `json:"category_id"`
+}
+
+// Lists a page of competencies matching the filters (NULL filters are ignored); archived ones only with include_archived
+// category_id matches the competencies of the category and of all its descendants
+// tags (distinct names) match competencies carrying any of them, or all of them with match_all_tags
+// Rows are ordered by sort_by (name, created_at or updated_at) then id, ascending or descending
+// Keyset pagination: only rows after the cursor (after_id plus after_name or after_time) are returned
+func (q *Queries) ListCompetencies(ctx context.Context, arg ListCompetenciesParams) ([]ListCompetenciesRow, error) {
+	rows, err := q.db.Query(ctx, listCompetencies, arg.NamePattern, arg.HasDescription, arg.CreatedAfter, arg.IncludeArchived, arg.CategoryID, arg.Tags, arg.MatchAllTags, arg.AfterID, arg.SortBy, arg.Descending, arg.AfterName, arg.AfterTime, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCompetenciesRow
+	for rows.Next() {
+		var i ListCompetenciesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Version,
+			&i.ArchivedAt,
+			&i.CategoryID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const renameCompetency = `-- name: RenameCompetency :one
+UPDATE competencies
+SET name = $1,
+    updated_at = NOW()
+WHERE id = $2
+  AND ($3::INTEGER IS NULL OR version = $3::INTEGER)
+RETURNING id, name, description, created_at, updated_at, version, archived_at, category_id
+`
+
+type RenameCompetencyParams struct {
+	Name            string      `json:"name"`
+	ID              int32       `json:"id"`
+	ExpectedVersion pgtype.Int4 `json:"expected_version"`
+}
+
+type RenameCompetencyRow struct {
+	ID          int32            `json:"id"`
+	Name        string           `json:"name"`
+	Description pgtype.Text      `json:"description"`
+	CreatedAt   pgtype.Timestamp `json:"created_at"`
+	UpdatedAt   pgtype.Timestamp `json:"updated_at"`
+	Version     int32            `json:"version"`
+	ArchivedAt  pgtype.Timestamp `json:"archived_at"`
+	CategoryID  pgtype.Int4      `json:"category_id"`
+}
+
+// Renames only when the competency still has the expected version (any version when it is NULL)
+// Returns no row when the competency doesn't exist or has a different version
+func (q *Queries) RenameCompetency(ctx context.Context, arg RenameCompetencyParams) (RenameCompetencyRow, error) {
+	row := q.db.QueryRow(ctx, renameCompetency, arg.Name, arg.ID, arg.ExpectedVersion)
+	var i RenameCompetencyRow
 	err := row.Scan(
 		&i.ID,
 		&i.Name,
 		&i.Description,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.ArchivedAt,
+		&i.CategoryID,
 	)
 	return i, err
 }
 
+const restoreCompetency = `-- name: RestoreCompetency :one
+UPDATE competencies
+SET archived_at = NULL,
+    updated_at = NOW()
+WHERE id = $1
+  AND archived_at IS NOT NULL
+RETURNING id, name, description, created_at, updated_at, version, archived_at, category_id
+`
+
+type RestoreCompetencyRow struct {
+	ID          int32            `json:"id"`
+	Name        string           `json:"name"`
+	Description pgtype.Text      `json:"description"`
+	CreatedAt   pgtype.Timestamp `json:"created_at"`
+	UpdatedAt   pgtype.Timestamp `json:"updated_at"`
+	Version     int32            `json:"version"`
+	ArchivedAt  pgtype.Timestamp `json:"archived_at"`
+	CategoryID  pgtype.Int4      `json:"category_id"`
+}
+
+// Returns no row when the competency doesn't exist or isn't archived
+func (q *Queries) RestoreCompetency(ctx context.Context, id int32) (RestoreCompetencyRow, error) {
+	row := q.db.QueryRow(ctx, restoreCompetency, id)
+	var i RestoreCompetencyRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Version,
+		&i.ArchivedAt,
+		&i.CategoryID,
+	)
+	return i, err
+}
+
+const revertCompetency = `-- name: RevertCompetency :one
+UPDATE competencies
+SET name = $1,
+    description = $2,
+    category_id = $3,
+    archived_at = CASE WHEN $4::BOOLEAN THEN COALESCE(archived_at, NOW()) END,
+    updated_at = NOW()
+WHERE id = $5
+  AND ($6::INTEGER IS NULL OR version = $6::INTEGER)
+RETURNING id, name, description, created_at, updated_at, version, archived_at, category_id
+`
+
+type RevertCompetencyParams struct {
+	Name            string      `json:"name"`
+	Description     pgtype.Text `json:"description"`
+	CategoryID      pgtype.Int4 `json:"category_id"`
+	Archived        bool        `json:"archived"`
+	ID              int32       `json:"id"`
+	ExpectedVersion pgtype.Int4 `json:"expected_version"`
+}
+
+type RevertCompetencyRow struct {
+	ID          int32            `json:"id"`
+	Name        string           `json:"name"`
+	Description pgtype.Text      `json:"description"`
+	CreatedAt   pgtype.Timestamp `json:"created_at"`
+	UpdatedAt   pgtype.Timestamp `json:"updated_at"`
+	Version     int32            `json:"version"`
+	ArchivedAt  pgtype.Timestamp `json:"archived_at"`
+	CategoryID  pgtype.Int4      `json:"category_id"`
+}
+
+// Sets the state recorded by a revision if the competency still has the expected version (any version when it is NULL)
+// An archived competency keeps its archive date; returns no row when the competency doesn't exist or has a different version
+func (q *Queries) RevertCompetency(ctx context.Context, arg RevertCompetencyParams) (RevertCompetencyRow, error) {
+	row := q.db.QueryRow(ctx, revertCompetency, arg.Name, arg.Description, arg.CategoryID, arg.Archived, arg.ID, arg.ExpectedVersion)
+	var i RevertCompetencyRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Version,
+		&i.ArchivedAt,
+		&i.CategoryID,
+	)
+	return i, err
+}
+
+const searchCompetencies = `-- name: SearchCompetencies :many
+SELECT id, name, description, created_at, updated_at, version,
+    (ts_rank(search_vector, websearch_to_tsquery('english', $1::TEXT)) + similarity(name::TEXT, $1::TEXT))::REAL AS rank,
+    ts_headline('english', name::TEXT, websearch_to_tsquery('english', $1::TEXT),
+        'HighlightAll=true, StartSel=' || chr(57344) || ', StopSel=' || chr(57345)) AS name_highlight,
+    ts_headline('english', COALESCE(description, ''), websearch_to_tsquery('english', $1::TEXT),
+        'MaxFragments=2, MaxWords=20, MinWords=5, StartSel=' || chr(57344) || ', StopSel=' || chr(57345)) AS description_snippet
+FROM competencies
+WHERE (search_vector @@ websearch_to_tsquery('english', $1::TEXT) OR name::TEXT % $1::TEXT)
+  AND archived_at IS NULL
+ORDER BY rank DESC, id
+LIMIT $2
+`
+
+type SearchCompetenciesParams struct {
+	Query    string `json:"query"`
+	RowLimit int32  `json:"row_limit"`
+}
+
+type SearchCompetenciesRow struct {
+	ID                 int32            `json:"id"`
+	Name               string           `json:"name"`
+	Description        pgtype.Text      `json:"description"`
+	CreatedAt          pgtype.Timestamp `json:"created_at"`
+	UpdatedAt          pgtype.Timestamp `json:"updated_at"`
+	Version            int32            `json:"version"`
+	Rank               float32          `json:"rank"`
+	NameHighlight      string           `json:"name_highlight"`
+	DescriptionSnippet string           `json:"description_snippet"`
+}
+
+// Full-text search over names and descriptions, plus trigram matches on names to tolerate typos
+// Highlighted terms are wrapped in U+E000 and U+E001, so callers can escape the text before marking them up
+// Archived competencies are never found
+func (q *Queries) SearchCompetencies(ctx context.Context, arg SearchCompetenciesParams) ([]SearchCompetenciesRow, error) {
+	rows, err := q.db.Query(ctx, searchCompetencies, arg.Query, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchCompetenciesRow
+	for rows.Next() {
+		var i SearchCompetenciesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Version,
+			&i.Rank,
+			&i.NameHighlight,
+			&i.DescriptionSnippet,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setCompetencyCategory = `-- name: SetCompetencyCategory :one
+UPDATE competencies
+SET category_id = $1,
+    updated_at = NOW()
+WHERE id = $2
+RETURNING id, name, description, created_at, updated_at, version, archived_at, category_id
+`
+
+type SetCompetencyCategoryParams struct {
+	CategoryID pgtype.Int4 `json:"category_id"`
+	ID         int32       `json:"id"`
+}
+
+type SetCompetencyCategoryRow struct {
+	ID          int32            `json:"id"`
+	Name        string           `json:"name"`
+	Description pgtype.Text      `json:"description"`
+	CreatedAt   pgtype.Timestamp `json:"created_at"`
+	UpdatedAt   pgtype.Timestamp `json:"updated_at"`
+	Version     int32            `json:"version"`
+	ArchivedAt  pgtype.Timestamp `json:"archived_at"`
+	CategoryID  pgtype.Int4      `json:"category_id"`
+}
+
+// Places a competency under a category (none when category_id is NULL)
+func (q *Queries) SetCompetencyCategory(ctx context.Context, arg SetCompetencyCategoryParams) (SetCompetencyCategoryRow, error) {
+	row := q.db.QueryRow(ctx, setCompetencyCategory, arg.CategoryID, arg.ID)
+	var i SetCompetencyCategoryRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Version,
+		&i.ArchivedAt,
+		&i.CategoryID,
+	)
+	return i, err
+}
+
+const suggestCompetencies = `-- name: SuggestCompetencies :many
+SELECT id, name FROM competencies
+WHERE (name LIKE $1::TEXT OR $2::TEXT <% name::TEXT)
+  AND archived_at IS NULL
+ORDER BY name LIKE $1::TEXT DESC, word_similarity($2::TEXT, name::TEXT) DESC, length(name), name
+LIMIT $3
+`
+
+type SuggestCompetenciesParams struct {
+	NamePattern string `json:"name_pattern"`
+	Prefix      string `json:"prefix"`
+	RowLimit    int32  `json:"row_limit"`
+}
+
+type SuggestCompetenciesRow struct {
+	ID   int32  `json:"id"`
+	Name string `json:"name"`
+}
+
+// Typeahead: names starting with the prefix first, then names containing a word similar to it (archived ones excluded)
+func (q *Queries) SuggestCompetencies(ctx context.Context, arg SuggestCompetenciesParams) ([]SuggestCompetenciesRow, error) {
+	rows, err := q.db.Query(ctx, suggestCompetencies, arg.NamePattern, arg.Prefix, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SuggestCompetenciesRow
+	for rows.Next() {
+		var i SuggestCompetenciesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateCompetencyDescription = `-- name: UpdateCompetencyDescription :one
 UPDATE competencies
 SET description = $1,
     updated_at = NOW()
 WHERE id = $2
-RETURNING id, name, description, created_at, updated_at
+  AND ($3::INTEGER IS NULL OR version = $3::INTEGER)
+RETURNING id, name, description, created_at, updated_at, version, archived_at, category_id
 `
 
 type UpdateCompetencyDescriptionParams struct {
-	Description pgtype.Text `json:"description"`
-	ID          int32       `json:"id"`
+	Description     pgtype.Text `json:"description"`
+	ID              int32       `json:"id"`
+	ExpectedVersion pgtype.Int4 `json:"expected_version"`
+}
+
+type UpdateCompetencyDescriptionRow struct {
+	ID          int32            `json:"id"`
+	Name        string           `json:"name"`
+	Description pgtype.Text      `json:"description"`
+	CreatedAt   pgtype.Timestamp `json:"created_at"`
+	UpdatedAt   pgtype.Timestamp `json:"updated_at"`
+	Version     int32            `json:"version"`
+	ArchivedAt  pgtype.Timestamp `json:"archived_at"`
+	CategoryID  pgtype.Int4      `json:"category_id"`
 }
 
-func (q *Queries) UpdateCompetencyDescription(ctx context.Context, arg UpdateCompetencyDescriptionParams) (Competency, error) {
-	row := q.db.QueryRow(ctx, updateCompetencyDescription, arg.Description, arg.ID)
-	var i Competency
+// Updates only when the competency still has the expected version (any version when it is NULL)
+// Returns no row when the competency doesn't exist or has a different version
+func (q *Queries) UpdateCompetencyDescription(ctx context.Context, arg UpdateCompetencyDescriptionParams) (UpdateCompetencyDescriptionRow, error) {
+	row := q.db.QueryRow(ctx, updateCompetencyDescription, arg.Description, arg.ID, arg.ExpectedVersion)
+	var i UpdateCompetencyDescriptionRow
 	err := row.Scan(
 		&i.ID,
 		&i.Name,
 		&i.Description,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.ArchivedAt,
+		&i.CategoryID,
 	)
 	return i, err
 }
@@ -54,13 +54,181 @@ func (ns NullUserRole) Value() (driver.Value, error) {
 }
 
 type Competency struct {
+	ID           int32            `json:"id"`
+	Name         string           `json:"name"`
+	Description  pgtype.Text      `json:"description"`
+	CreatedAt    pgtype.Timestamp `json:"created_at"`
+	UpdatedAt    pgtype.Timestamp `json:"updated_at"`
+	Version      int32            `json:"version"`
+	SearchVector interface{}      `json:"search_vector"`
+	ArchivedAt   pgtype.Timestamp `json:"archived_at"`
+	CategoryID   pgtype.Int4      `json:"category_id"`
+}
+
+type CompetencyCategory struct {
+	ID        int32            `json:"id"`
+	ParentID  pgtype.Int4      `json:"parent_id"`
+	Name      string           `json:"name"`
+	Position  int32            `json:"position"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+	UpdatedAt pgtype.Timestamp `json:"updated_at"`
+}
+
+type CompetencyLevel struct {
+	CompetencyID int32    `json:"competency_id"`
+	ScaleID      int32    `json:"scale_id"`
+	Level        int32    `json:"level"`
+	Description  string   `json:"description"`
+	Indicators   []string `json:"indicators"`
+}
+
+type CompetencyLevelTranslation struct {
+	CompetencyID int32    `json:"competency_id"`
+	Level        int32    `json:"level"`
+	Locale       string   `json:"locale"`
+	Description  string   `json:"description"`
+	Indicators   []string `json:"indicators"`
+}
+
+type CompetencyMerge struct {
+	MergedID     int32            `json:"merged_id"`
+	CompetencyID int32            `json:"competency_id"`
+	Snapshot     []byte           `json:"snapshot"`
+	MergedBy     pgtype.Int4      `json:"merged_by"`
+	MergedAt     pgtype.Timestamp `json:"merged_at"`
+}
+
+type CompetencyProposal struct {
+	ID           int32            `json:"id"`
+	Kind         string           `json:"kind"`
+	CompetencyID pgtype.Int4      `json:"competency_id"`
+	ProposerID   int32            `json:"proposer_id"`
+	Name         pgtype.Text      `json:"name"`
+	Description  pgtype.Text      `json:"description"`
+	CategoryID   pgtype.Int4      `json:"category_id"`
+	Rationale    string           `json:"rationale"`
+	Status       string           `json:"status"`
+	ReviewerID   pgtype.Int4      `json:"reviewer_id"`
+	SubmittedAt  pgtype.Timestamp `json:"submitted_at"`
+	ReviewedAt   pgtype.Timestamp `json:"reviewed_at"`
+	CreatedAt    pgtype.Timestamp `json:"created_at"`
+	UpdatedAt    pgtype.Timestamp `json:"updated_at"`
+}
+
+type CompetencyRelation struct {
+	CompetencyID int32            `json:"competency_id"`
+	RelatedID    int32            `json:"related_id"`
+	Kind         string           `json:"kind"`
+	CreatedAt    pgtype.Timestamp `json:"created_at"`
+}
+
+type CompetencyReport struct {
+	ID           int32            `json:"id"`
+	CompetencyID int32            `json:"competency_id"`
+	ReporterID   pgtype.Int4      `json:"reporter_id"`
+	Reason       string           `json:"reason"`
+	CreatedAt    pgtype.Timestamp `json:"created_at"`
+}
+
+type CompetencyRevision struct {
+	CompetencyID int32            `json:"competency_id"`
+	Revision     int32            `json:"revision"`
+	Action       string           `json:"action"`
+	EditorID     pgtype.Int4      `json:"editor_id"`
+	RevertedFrom pgtype.Int4      `json:"reverted_from"`
+	Before       []byte           `json:"before"`
+	After        []byte           `json:"after"`
+	CreatedAt    pgtype.Timestamp `json:"created_at"`
+	MergedID     pgtype.Int4      `json:"merged_id"`
+}
+
+type CompetencyRubric struct {
+	CompetencyID int32            `json:"competency_id"`
+	ScaleID      int32            `json:"scale_id"`
+	CreatedAt    pgtype.Timestamp `json:"created_at"`
+	UpdatedAt    pgtype.Timestamp `json:"updated_at"`
+}
+
+type CompetencySteward struct {
+	CompetencyID int32            `json:"competency_id"`
+	UserID       int32            `json:"user_id"`
+	AssignedBy   pgtype.Int4      `json:"assigned_by"`
+	AssignedAt   pgtype.Timestamp `json:"assigned_at"`
+}
+
+type CompetencyTranslation struct {
+	CompetencyID int32            `json:"competency_id"`
+	Locale       string           `json:"locale"`
+	Name         string           `json:"name"`
+	Description  string           `json:"description"`
+	CreatedAt    pgtype.Timestamp `json:"created_at"`
+	UpdatedAt    pgtype.Timestamp `json:"updated_at"`
+}
+
+type CompetencyTag struct {
+	CompetencyID int32            `json:"competency_id"`
+	TagID        int32            `json:"tag_id"`
+	CreatedAt    pgtype.Timestamp `json:"created_at"`
+}
+
+type FrameworkVersion struct {
+	ID              int32            `json:"id"`
+	Name            string           `json:"name"`
+	Notes           string           `json:"notes"`
+	Snapshot        []byte           `json:"snapshot"`
+	CompetencyCount int32            `json:"competency_count"`
+	PublishedBy     pgtype.Int4      `json:"published_by"`
+	PublishedAt     pgtype.Timestamp `json:"published_at"`
+}
+
+type Notification struct {
+	ID           int32            `json:"id"`
+	UserID       int32            `json:"user_id"`
+	Kind         string           `json:"kind"`
+	CompetencyID int32            `json:"competency_id"`
+	ProposalID   pgtype.Int4      `json:"proposal_id"`
+	ReportID     pgtype.Int4      `json:"report_id"`
+	ReadAt       pgtype.Timestamp `json:"read_at"`
+	CreatedAt    pgtype.Timestamp `json:"created_at"`
+}
+
+type ProposalComment struct {
+	ID         int32            `json:"id"`
+	ProposalID int32            `json:"proposal_id"`
+	AuthorID   pgtype.Int4      `json:"author_id"`
+	Body       string           `json:"body"`
+	CreatedAt  pgtype.Timestamp `json:"created_at"`
+}
+
+type RateLimitCounter struct {
+	Key         string             `json:"key"`
+	WindowStart pgtype.Timestamptz `json:"window_start"`
+	Hits        int32              `json:"hits"`
+	ExpiresAt   pgtype.Timestamptz `json:"expires_at"`
+}
+
+type RatingScale struct {
 	ID          int32            `json:"id"`
 	Name        string           `json:"name"`
-	Description pgtype.Text      `json:"description"`
+	Description string           `json:"description"`
 	CreatedAt   pgtype.Timestamp `json:"created_at"`
 	UpdatedAt   pgtype.Timestamp `json:"updated_at"`
 }
 
+type RatingScaleLevel struct {
+	ScaleID int32  `json:"scale_id"`
+	Value   int32  `json:"value"`
+	Label   string `json:"label"`
+}
+
+type Tag struct {
+	ID        int32            `json:"id"`
+	Name      string           `json:"name"`
+	Curated   bool             `json:"curated"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+	UpdatedAt pgtype.Timestamp `json:"updated_at"`
+}
+
 type User struct {
 	ID             int32            `json:"id"`
 	Email          string           `json:"email"`
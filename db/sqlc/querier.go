@@ -6,16 +6,229 @@ package sqlc
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Querier interface {
-	CreateCompetency(ctx context.Context, arg CreateCompetencyParams) (Competency, error)
+	// The surviving competency keeps its texts and category, taking those of the merged one where it has none
+	AbsorbCompetency(ctx context.Context, arg AbsorbCompetencyParams) (int64, error)
+	// Adding an existing edge changes nothing
+	AddCompetencyRelation(ctx context.Context, arg AddCompetencyRelationParams) error
+	// Tags already on the competency are left as they are
+	AddCompetencyTags(ctx context.Context, arg AddCompetencyTagsParams) error
+	// Returns no row when the competency doesn't exist or is already archived
+	ArchiveCompetency(ctx context.Context, id int32) (ArchiveCompetencyRow, error)
+	// Assigning a steward twice is a no-op
+	AssignSteward(ctx context.Context, arg AssignStewardParams) (int64, error)
+	CopyCompetencyLevelTranslations(ctx context.Context, arg CopyCompetencyLevelTranslationsParams) error
+	CopyCompetencyLevels(ctx context.Context, arg CopyCompetencyLevelsParams) error
+	// Only when the surviving competency has no rubric
+	CopyCompetencyRubric(ctx context.Context, arg CopyCompetencyRubricParams) (int64, error)
+	// Counts the competencies matching the filters of ListCompetencies
+	CountCompetencies(ctx context.Context, arg CountCompetenciesParams) (int64, error)
+	// Number of competencies (archived ones excluded) placed directly in each category
+	CountCompetenciesByCategory(ctx context.Context) ([]CountCompetenciesByCategoryRow, error)
+	// The category is placed after its siblings
+	CreateCategory(ctx context.Context, arg CreateCategoryParams) (CompetencyCategory, error)
+	// Inserts competencies in one round trip; names that already exist are skipped and return no row
+	CreateCompetencies(ctx context.Context, arg []CreateCompetenciesParams) *CreateCompetenciesBatchResults
+	CreateCompetency(ctx context.Context, arg CreateCompetencyParams) (CreateCompetencyRow, error)
+	CreateCompetencyReport(ctx context.Context, arg CreateCompetencyReportParams) (CompetencyReport, error)
+	CreateProposal(ctx context.Context, arg CreateProposalParams) (CompetencyProposal, error)
+	CreateProposalComment(ctx context.Context, arg CreateProposalCommentParams) (ProposalComment, error)
+	CreateRatingScale(ctx context.Context, arg CreateRatingScaleParams) (RatingScale, error)
+	CreateTag(ctx context.Context, arg CreateTagParams) (Tag, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
-	GetAllCompetencies(ctx context.Context) ([]Competency, error)
-	GetCompetencyByID(ctx context.Context, id int32) (Competency, error)
-	GetCompetencyByName(ctx context.Context, name string) (Competency, error)
+	// Fails with a foreign key violation while the category has children or competencies
+	DeleteCategory(ctx context.Context, id int32) (int64, error)
+	// Deletes the competency with the rows referencing it (ON DELETE CASCADE); see IsCompetencyReferenced
+	DeleteCompetency(ctx context.Context, id int32) (int64, error)
+	DeleteCompetencyLevel(ctx context.Context, arg DeleteCompetencyLevelParams) (int64, error)
+	DeleteCompetencyLevelTranslations(ctx context.Context, arg DeleteCompetencyLevelTranslationsParams) error
+	DeleteCompetencyLevels(ctx context.Context, competencyID int32) error
+	// The level descriptions are deleted with the rubric
+	DeleteCompetencyRubric(ctx context.Context, competencyID int32) (int64, error)
+	DeleteCompetencyTranslation(ctx context.Context, arg DeleteCompetencyTranslationParams) (int64, error)
+	DeleteExpiredRateLimitCounters(ctx context.Context) (int64, error)
+	// Reviewed proposals are kept as the record of the review
+	DeleteProposal(ctx context.Context, id int32) (int64, error)
+	// Fails with a foreign key violation while a competency rubric uses the scale
+	DeleteRatingScale(ctx context.Context, id int32) (int64, error)
+	// Removes the levels not in level_values; fails with a foreign key violation while a competency describes one of them
+	DeleteRatingScaleLevelsExcept(ctx context.Context, arg DeleteRatingScaleLevelsExceptParams) error
+	DeleteTag(ctx context.Context, id int32) (int64, error)
+	// Returns the tags with the given names, creating the missing ones as free-form tags
+	EnsureTags(ctx context.Context, names []string) ([]Tag, error)
+	GetCategoryByID(ctx context.Context, id int32) (CompetencyCategory, error)
+	// Names are compared case-insensitively (CITEXT)
+	GetCompetenciesByNames(ctx context.Context, names []string) ([]GetCompetenciesByNamesRow, error)
+	GetCompetencyByID(ctx context.Context, id int32) (GetCompetencyByIDRow, error)
+	GetCompetencyByName(ctx context.Context, name string) (GetCompetencyByNameRow, error)
+	// The competency a merged competency now is
+	GetCompetencyMerge(ctx context.Context, mergedID int32) (int32, error)
+	GetCompetencyRevision(ctx context.Context, arg GetCompetencyRevisionParams) (CompetencyRevision, error)
+	GetCompetencyRubric(ctx context.Context, competencyID int32) (CompetencyRubric, error)
+	GetDraftFramework(ctx context.Context) ([]byte, error)
+	GetFrameworkVersion(ctx context.Context, name string) (FrameworkVersion, error)
+	GetProposal(ctx context.Context, id int32) (CompetencyProposal, error)
+	// Locks the proposal until the end of the transaction, so it is reviewed once
+	GetProposalForUpdate(ctx context.Context, id int32) (CompetencyProposal, error)
+	GetRatingScaleByID(ctx context.Context, id int32) (RatingScale, error)
+	// usage_count counts the competencies carrying the tag, archived ones excluded
+	GetTagByID(ctx context.Context, id int32) (GetTagByIDRow, error)
 	GetUserByEmail(ctx context.Context, email string) (User, error)
-	UpdateCompetencyDescription(ctx context.Context, arg UpdateCompetencyDescriptionParams) (Competency, error)
+	// Counts a request in the current fixed window of the key
+	// The window start is computed from the database clock so all instances agree on it
+	// Returns no row when the counter already reached max_hits (request rejected, not counted)
+	IncrementRateLimitCounter(ctx context.Context, arg IncrementRateLimitCounterParams) (int32, error)
+	InsertCompetencyLevelTranslation(ctx context.Context, arg InsertCompetencyLevelTranslationParams) error
+	InsertCompetencyMerge(ctx context.Context, arg InsertCompetencyMergeParams) error
+	// Reports whether candidate_id is root_id or one of its descendants
+	IsCategoryInSubtree(ctx context.Context, arg IsCategoryInSubtreeParams) (bool, error)
+	// Locks the competency until the end of the transaction, so no reference can be added before it is deleted,
+	// and tells whether prerequisite or related edges, merge redirects or a rubric reference it
+	// Its tags, translations, revisions, stewards, reports and notifications are deleted with it (ON DELETE CASCADE)
+	IsCompetencyReferenced(ctx context.Context, id int32) (bool, error)
+	// Reports whether competency_id requires candidate_id, directly or through other prerequisites (or is it)
+	IsPrerequisite(ctx context.Context, arg IsPrerequisiteParams) (bool, error)
+	IsSteward(ctx context.Context, arg IsStewardParams) (bool, error)
+	// Every category, siblings in display order
+	ListCategories(ctx context.Context) ([]CompetencyCategory, error)
+	// The children of a category (root categories when parent_id is NULL) in display order
+	ListCategoryChildren(ctx context.Context, parentID pgtype.Int4) ([]CompetencyCategory, error)
+	// The category and all its descendants, siblings in display order
+	ListCategorySubtree(ctx context.Context, id int32) ([]CompetencyCategory, error)
+	// Lists a page of competencies matching the filters (NULL filters are ignored); archived ones only with include_archived
+	// category_id matches the competencies of the category and of all its descendants
+	// tags (distinct names) match competencies carrying any of them, or all of them with match_all_tags
+	// Rows are ordered by sort_by (name, created_at or updated_at) then id, ascending or descending
+	// Keyset pagination: only rows after the cursor (after_id plus after_name or after_time) are returned
+	ListCompetencies(ctx context.Context, arg ListCompetenciesParams) ([]ListCompetenciesRow, error)
+	// Level translations of the competencies of competency_ids, in every locale or only in locale when it is set
+	ListCompetencyLevelTranslations(ctx context.Context, arg ListCompetencyLevelTranslationsParams) ([]CompetencyLevelTranslation, error)
+	ListCompetencyLevels(ctx context.Context, competencyID int32) ([]CompetencyLevel, error)
+	// The names of the competencies of ids; unknown IDs are left out
+	ListCompetencyNames(ctx context.Context, ids []int32) ([]ListCompetencyNamesRow, error)
+	// The stale-content reports of a competency, newest first
+	ListCompetencyReports(ctx context.Context, arg ListCompetencyReportsParams) ([]CompetencyReport, error)
+	// Newest first; only the revisions before before_revision when it is set
+	ListCompetencyRevisions(ctx context.Context, arg ListCompetencyRevisionsParams) ([]CompetencyRevision, error)
+	// The stewards of a competency, first assigned first
+	ListCompetencyStewards(ctx context.Context, competencyID int32) ([]CompetencySteward, error)
+	// The tags of a competency by name, with their usage counts
+	ListCompetencyTags(ctx context.Context, competencyID int32) ([]ListCompetencyTagsRow, error)
+	// Translations of the competencies of competency_ids, in every locale or only in locale when it is set
+	ListCompetencyTranslations(ctx context.Context, arg ListCompetencyTranslationsParams) ([]CompetencyTranslation, error)
+	// The competencies linked to competency_id, with the direction of the edge: requires, required_by or related
+	ListDirectRelations(ctx context.Context, competencyID int32) ([]ListDirectRelationsRow, error)
+	// Pairs of competencies that aren't archived with similar names or descriptions, most similar first
+	// Candidates are found with the trigram indexes (% uses pg_trgm.similarity_threshold, 0.3 by default), then scored:
+	// the name similarity, or the mean of the name and description similarities when that is higher
+	ListDuplicateCandidates(ctx context.Context, arg ListDuplicateCandidatesParams) ([]ListDuplicateCandidatesRow, error)
+	// Latest published first, without their snapshots
+	ListFrameworkVersions(ctx context.Context) ([]ListFrameworkVersionsRow, error)
+	// Every edge between competencies that aren't archived
+	ListGraphEdges(ctx context.Context) ([]ListGraphEdgesRow, error)
+	// Competencies showing name in one of locales: their translation has the name, or they have no translation
+	// there and their own (fallback) name is the name
+	ListLocalizedNameConflicts(ctx context.Context, arg ListLocalizedNameConflictsParams) ([]ListLocalizedNameConflictsRow, error)
+	// Competencies that aren't archived and lack a translation of their name, description or described levels in one of locales
+	ListMissingTranslations(ctx context.Context, locales []string) ([]ListMissingTranslationsRow, error)
+	// The notifications of a user, newest first, only unread ones with unread_only
+	ListNotifications(ctx context.Context, arg ListNotificationsParams) ([]Notification, error)
+	// The competencies the competencies of ids require, directly or not, with the fewest steps to reach them
+	// The competencies of ids aren't listed unless one requires another
+	ListPrerequisites(ctx context.Context, ids []int32) ([]ListPrerequisitesRow, error)
+	// Oldest first
+	ListProposalComments(ctx context.Context, proposalID int32) ([]ProposalComment, error)
+	// Newest first; only those with status when it is set
+	ListProposalsByProposer(ctx context.Context, arg ListProposalsByProposerParams) ([]CompetencyProposal, error)
+	// The levels of the given scales, ordered by scale then value
+	ListRatingScaleLevels(ctx context.Context, scaleIds []int32) ([]RatingScaleLevel, error)
+	ListRatingScales(ctx context.Context) ([]RatingScale, error)
+	// The "requires" edges leaving the competencies of ids
+	ListRequiresEdges(ctx context.Context, ids []int32) ([]ListRequiresEdgesRow, error)
+	// The competencies a user is a steward of, by name
+	ListStewardedCompetencies(ctx context.Context, userID int32) ([]ListStewardedCompetenciesRow, error)
+	// The review queue: oldest submission first
+	ListSubmittedProposals(ctx context.Context, rowLimit int32) ([]CompetencyProposal, error)
+	// Lists the tags matching the filters (NULL filters are ignored), most used first
+	// usage_count counts the competencies carrying the tag, archived ones excluded
+	ListTags(ctx context.Context, arg ListTagsParams) ([]ListTagsRow, error)
+	// Serializes changes to the shape of the tree until the end of the transaction; reads aren't blocked
+	LockCategories(ctx context.Context) error
+	// Serializes changes to the relations until the end of the transaction; reads aren't blocked
+	LockCompetencyRelations(ctx context.Context) error
+	// Marking a notification read twice keeps the first time
+	MarkNotificationRead(ctx context.Context, arg MarkNotificationReadParams) (Notification, error)
+	MoveCompetencyNotifications(ctx context.Context, arg MoveCompetencyNotificationsParams) error
+	// Proposals about the merged competency now are about the surviving one
+	MoveCompetencyProposals(ctx context.Context, arg MoveCompetencyProposalsParams) error
+	// Edges of the merged competency are copied to the surviving one; edges between the two disappear,
+	// and related edges keep the lower ID first
+	MoveCompetencyRelations(ctx context.Context, arg MoveCompetencyRelationsParams) error
+	// Stale-content reports about the merged competency now are about the surviving one
+	MoveCompetencyReports(ctx context.Context, arg MoveCompetencyReportsParams) error
+	// Stewards of both competencies are kept once
+	MoveCompetencyStewards(ctx context.Context, arg MoveCompetencyStewardsParams) error
+	// Tags both competencies have are kept once
+	MoveCompetencyTags(ctx context.Context, arg MoveCompetencyTagsParams) error
+	// Only the locales the surviving competency has no translation in; moving the rows keeps translated names unique
+	MoveCompetencyTranslations(ctx context.Context, arg MoveCompetencyTranslationsParams) error
+	// Notifies every steward of a competency, except the user causing the notification
+	NotifyStewards(ctx context.Context, arg NotifyStewardsParams) (int64, error)
+	// Snapshots the current framework in one statement, so the version is consistent even while it is edited
+	PublishFrameworkVersion(ctx context.Context, arg PublishFrameworkVersionParams) (PublishFrameworkVersionRow, error)
+	// Records the current state of the competencies as their next revision, the previous one being its before value
+	// Competencies whose state didn't change since their last revision are skipped, unless the change is a merge
+	RecordCompetencyRevisions(ctx context.Context, arg RecordCompetencyRevisionsParams) (int64, error)
+	// Competencies merged into the merged one now redirect to the surviving one
+	RedirectCompetencyMerges(ctx context.Context, arg RedirectCompetencyMergesParams) error
+	RemoveCompetencyRelation(ctx context.Context, arg RemoveCompetencyRelationParams) (int64, error)
+	RemoveCompetencyTag(ctx context.Context, arg RemoveCompetencyTagParams) (int64, error)
+	RenameCategory(ctx context.Context, arg RenameCategoryParams) (CompetencyCategory, error)
+	// Renames only when the competency still has the expected version (any version when it is NULL)
+	// Returns no row when the competency doesn't exist or has a different version
+	RenameCompetency(ctx context.Context, arg RenameCompetencyParams) (RenameCompetencyRow, error)
+	// Returns no row when the competency doesn't exist or isn't archived
+	RestoreCompetency(ctx context.Context, id int32) (RestoreCompetencyRow, error)
+	// Sets the state recorded by a revision if the competency still has the expected version (any version when it is NULL)
+	// An archived competency keeps its archive date; returns no row when the competency doesn't exist or has a different version
+	RevertCompetency(ctx context.Context, arg RevertCompetencyParams) (RevertCompetencyRow, error)
+	// Approves or rejects a submitted proposal; an approved creation records the competency it created
+	ReviewProposal(ctx context.Context, arg ReviewProposalParams) (CompetencyProposal, error)
+	// Full-text search over names and descriptions, plus trigram matches on names to tolerate typos
+	// Highlighted terms are wrapped in U+E000 and U+E001, so callers can escape the text before marking them up
+	// Archived competencies are never found
+	SearchCompetencies(ctx context.Context, arg SearchCompetenciesParams) ([]SearchCompetenciesRow, error)
+	SetCategoryParent(ctx context.Context, arg SetCategoryParentParams) (CompetencyCategory, error)
+	// Sets the position of each category to its index in ids
+	SetCategoryPositions(ctx context.Context, ids []int32) error
+	// Places a competency under a category (none when category_id is NULL)
+	SetCompetencyCategory(ctx context.Context, arg SetCompetencyCategoryParams) (SetCompetencyCategoryRow, error)
+	SubmitProposal(ctx context.Context, id int32) (CompetencyProposal, error)
+	// Typeahead: names starting with the prefix first, then names containing a word similar to it (archived ones excluded)
+	SuggestCompetencies(ctx context.Context, arg SuggestCompetenciesParams) ([]SuggestCompetenciesRow, error)
+	// Bumps the version of the competencies whose rubric uses the scale
+	TouchCompetenciesByScale(ctx context.Context, scaleID int32) error
+	// Bumps the version of a competency (see the version trigger) when data embedded in it changes
+	TouchCompetency(ctx context.Context, id int32) error
+	UnassignSteward(ctx context.Context, arg UnassignStewardParams) (int64, error)
+	// Updates only when the competency still has the expected version (any version when it is NULL)
+	// Returns no row when the competency doesn't exist or has a different version
+	UpdateCompetencyDescription(ctx context.Context, arg UpdateCompetencyDescriptionParams) (UpdateCompetencyDescriptionRow, error)
+	// Only drafts can be edited; the kind and competency of a proposal don't change
+	UpdateProposalDraft(ctx context.Context, arg UpdateProposalDraftParams) (CompetencyProposal, error)
+	UpdateRatingScale(ctx context.Context, arg UpdateRatingScaleParams) (RatingScale, error)
+	UpdateTag(ctx context.Context, arg UpdateTagParams) (Tag, error)
+	// Fails with a foreign key violation when the rubric doesn't use scale_id or the scale has no such level
+	UpsertCompetencyLevel(ctx context.Context, arg UpsertCompetencyLevelParams) error
+	// Sets the scale of a competency rubric; its level descriptions must be removed first when the scale changes
+	UpsertCompetencyRubric(ctx context.Context, arg UpsertCompetencyRubricParams) (CompetencyRubric, error)
+	UpsertCompetencyTranslation(ctx context.Context, arg UpsertCompetencyTranslationParams) (CompetencyTranslation, error)
+	// Adds the levels of level_values with their labels, or relabels them when they exist
+	UpsertRatingScaleLevels(ctx context.Context, arg UpsertRatingScaleLevelsParams) error
 }
 
 var _ Querier = (*Queries)(nil)
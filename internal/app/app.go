@@ -8,20 +8,16 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/mehrnoosh-hk/devnorth-back/config"
 	httpDelivery "github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http"
-	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
-	"github.com/mehrnoosh-hk/devnorth-back/internal/repository"
 )
 
 // App holds the application state and dependencies
 type App struct {
-	config            *config.Config
-	Logger            *slog.Logger
-	db                *pgxpool.Pool
-	userRepo          domain.UserRepository
-	competencyRepo    domain.CompetencyRepository
-	userUseCase       domain.UserUseCase
-	competencyUseCase domain.CompetencyUseCase
-	server            *httpDelivery.Server
+	config   *config.Config
+	Logger   *slog.Logger
+	db       *pgxpool.Pool
+	repos    repositories
+	useCases httpDelivery.UseCases
+	server   *httpDelivery.Server
 }
 
 // NewApp initializes and returns a new App instance with all dependencies
@@ -36,8 +32,11 @@ func NewApp(ctx context.Context, cfg *config.Config) (*App, error) {
 	}
 
 	// Initialize repositories
-	userRepo := repository.NewUserRepository(db, logger)
-	competencyRepo := repository.NewCompetencyRepository(db, logger)
+	repos, err := initRepositories(db, logger)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
 
 	// Initialize security dependencies
 	passwordHasher, tokenGenerator, err := initSecurity(cfg.JWT, logger)
@@ -48,28 +47,33 @@ func NewApp(ctx context.Context, cfg *config.Config) (*App, error) {
 	logger.Info("Security dependencies initialized")
 
 	// Initialize use cases
-	userUseCase, competencyUseCase, err := initUseCases(userRepo, competencyRepo, passwordHasher, tokenGenerator, logger)
+	useCases, err := initUseCases(repos, passwordHasher, tokenGenerator, logger)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	// Initialize rate limiter
+	limiter, err := initRateLimiter(ctx, cfg.RateLimit, db, logger)
 	if err != nil {
 		db.Close()
 		return nil, err
 	}
 
 	// Initialize HTTP server
-	server, err := initServer(cfg.Server, userUseCase, competencyUseCase, logger)
+	server, err := initServer(cfg.Server, cfg.RateLimit, cfg.CORS, useCases, tokenGenerator, limiter, logger)
 	if err != nil {
 		db.Close()
 		return nil, err
 	}
 
 	return &App{
-		config:            cfg,
-		Logger:            logger,
-		db:                db,
-		userRepo:          userRepo,
-		competencyRepo:    competencyRepo,
-		userUseCase:       userUseCase,
-		competencyUseCase: competencyUseCase,
-		server:            server,
+		config:   cfg,
+		Logger:   logger,
+		db:       db,
+		repos:    repos,
+		useCases: useCases,
+		server:   server,
 	}, nil
 }
 
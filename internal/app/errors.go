@@ -4,9 +4,20 @@ import "errors"
 
 var (
 	// Initialization errors
-	ErrInitDB                = errors.New("failed to initialize database connection")
-	ErrInitPasswordHasher    = errors.New("failed to initialize password hasher")
-	ErrInitTokenGenerator    = errors.New("failed to initialize token generator")
-	ErrInitUserUseCase       = errors.New("failed to initialize user use case")
-	ErrInitCompetencyUseCase = errors.New("failed to initialize competency use case")
+	ErrInitDB                  = errors.New("failed to initialize database connection")
+	ErrInitPasswordHasher      = errors.New("failed to initialize password hasher")
+	ErrInitTokenGenerator      = errors.New("failed to initialize token generator")
+	ErrInitUserUseCase         = errors.New("failed to initialize user use case")
+	ErrInitCompetencyUseCase   = errors.New("failed to initialize competency use case")
+	ErrInitCategoryUseCase     = errors.New("failed to initialize category use case")
+	ErrInitRubricUseCase       = errors.New("failed to initialize rubric use case")
+	ErrInitTagUseCase          = errors.New("failed to initialize tag use case")
+	ErrInitRelationUseCase     = errors.New("failed to initialize relation use case")
+	ErrInitTranslationUseCase  = errors.New("failed to initialize translation use case")
+	ErrInitMergeUseCase        = errors.New("failed to initialize merge use case")
+	ErrInitProposalUseCase     = errors.New("failed to initialize proposal use case")
+	ErrInitStewardUseCase      = errors.New("failed to initialize steward use case")
+	ErrInitNotificationUseCase = errors.New("failed to initialize notification use case")
+	ErrInitFrameworkUseCase    = errors.New("failed to initialize framework use case")
+	ErrInitRateLimiter         = errors.New("failed to initialize rate limiter")
 )
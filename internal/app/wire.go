@@ -13,8 +13,11 @@ import (
 	"github.com/mehrnoosh-hk/devnorth-back/internal/database"
 	httpDelivery "github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http"
 	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/logging"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/repository"
 	"github.com/mehrnoosh-hk/devnorth-back/internal/security"
 	"github.com/mehrnoosh-hk/devnorth-back/internal/usecase"
+	"github.com/mehrnoosh-hk/devnorth-back/pkg/ratelimit"
 )
 
 // initLogger initializes the structured logger
@@ -33,7 +36,8 @@ func initLogger(env string) *slog.Logger {
 		})
 	}
 
-	return slog.New(handler)
+	// Enrich context-aware log calls with request_id, user_id and route
+	return slog.New(logging.NewContextHandler(handler))
 }
 
 // initDatabase initializes the database connection
@@ -65,40 +69,235 @@ func initSecurity(cfg config.JWTConfig, logger *slog.Logger) (domain.PasswordHas
 	return passwordHasher, tokenGenerator, nil
 }
 
+// repositories holds the data access dependencies of the use cases
+type repositories struct {
+	user         domain.UserRepository
+	competency   domain.CompetencyRepository
+	category     domain.CategoryRepository
+	rubric       domain.RubricRepository
+	tag          domain.TagRepository
+	revision     domain.RevisionRepository
+	relation     domain.RelationRepository
+	translation  domain.TranslationRepository
+	merge        domain.MergeRepository
+	proposal     domain.ProposalRepository
+	steward      domain.StewardRepository
+	notification domain.NotificationRepository
+	framework    domain.FrameworkRepository
+	transactor   domain.Transactor
+}
+
+// initRepositories initializes the repositories on the database pool
+func initRepositories(db *pgxpool.Pool, logger *slog.Logger) (repositories, error) {
+	repos := repositories{user: repository.NewUserRepository(db, logger)}
+	var err error
+	if repos.competency, err = repository.NewCompetencyRepository(db, logger); err != nil {
+		return repositories{}, err
+	}
+	if repos.category, err = repository.NewCategoryRepository(db, logger); err != nil {
+		return repositories{}, err
+	}
+	if repos.rubric, err = repository.NewRubricRepository(db, logger); err != nil {
+		return repositories{}, err
+	}
+	if repos.tag, err = repository.NewTagRepository(db, logger); err != nil {
+		return repositories{}, err
+	}
+	if repos.revision, err = repository.NewRevisionRepository(db, logger); err != nil {
+		return repositories{}, err
+	}
+	if repos.relation, err = repository.NewRelationRepository(db, logger); err != nil {
+		return repositories{}, err
+	}
+	if repos.translation, err = repository.NewTranslationRepository(db, logger); err != nil {
+		return repositories{}, err
+	}
+	if repos.merge, err = repository.NewMergeRepository(db, logger); err != nil {
+		return repositories{}, err
+	}
+	if repos.proposal, err = repository.NewProposalRepository(db, logger); err != nil {
+		return repositories{}, err
+	}
+	if repos.steward, err = repository.NewStewardRepository(db, logger); err != nil {
+		return repositories{}, err
+	}
+	if repos.notification, err = repository.NewNotificationRepository(db, logger); err != nil {
+		return repositories{}, err
+	}
+	if repos.framework, err = repository.NewFrameworkRepository(db, logger); err != nil {
+		return repositories{}, err
+	}
+	if repos.transactor, err = repository.NewTransactor(db, logger); err != nil {
+		return repositories{}, err
+	}
+	return repos, nil
+}
+
 // initUseCases initializes application use cases
 func initUseCases(
-	userRepo domain.UserRepository,
-	competencyRepo domain.CompetencyRepository,
+	repos repositories,
 	passwordHasher domain.PasswordHasher,
 	tokenGenerator domain.TokenGenerator,
 	logger *slog.Logger,
-) (domain.UserUseCase, domain.CompetencyUseCase, error) {
-	userUseCase, err := usecase.NewUserUseCase(userRepo, passwordHasher, tokenGenerator, logger)
+) (httpDelivery.UseCases, error) {
+	var useCases httpDelivery.UseCases
+	var err error
+
+	useCases.User, err = usecase.NewUserUseCase(repos.user, passwordHasher, tokenGenerator, logger)
 	if err != nil {
 		logger.Error("Failed to wire dependency: user use case", "Error", err)
-		return nil, nil, fmt.Errorf("%w: %w", ErrInitUserUseCase, err)
+		return httpDelivery.UseCases{}, fmt.Errorf("%w: %w", ErrInitUserUseCase, err)
 	}
 	logger.Info("User use case initialized")
 
-	competencyUseCase, err := usecase.NewCompetencyUseCase(competencyRepo, logger)
+	useCases.Competency, err = usecase.NewCompetencyUseCase(repos.competency, repos.rubric, repos.revision, repos.translation, repos.merge, repos.steward, repos.transactor, logger)
 	if err != nil {
 		logger.Error("Failed to wire dependency: competency use case", "Error", err)
-		return nil, nil, fmt.Errorf("%w: %w", ErrInitCompetencyUseCase, err)
+		return httpDelivery.UseCases{}, fmt.Errorf("%w: %w", ErrInitCompetencyUseCase, err)
 	}
 	logger.Info("Competency use case initialized")
 
-	return userUseCase, competencyUseCase, nil
+	useCases.Category, err = usecase.NewCategoryUseCase(repos.category, repos.transactor, logger)
+	if err != nil {
+		logger.Error("Failed to wire dependency: category use case", "Error", err)
+		return httpDelivery.UseCases{}, fmt.Errorf("%w: %w", ErrInitCategoryUseCase, err)
+	}
+	logger.Info("Category use case initialized")
+
+	useCases.Rubric, err = usecase.NewRubricUseCase(repos.rubric, repos.competency, repos.translation, repos.steward, repos.transactor, logger)
+	if err != nil {
+		logger.Error("Failed to wire dependency: rubric use case", "Error", err)
+		return httpDelivery.UseCases{}, fmt.Errorf("%w: %w", ErrInitRubricUseCase, err)
+	}
+	logger.Info("Rubric use case initialized")
+
+	useCases.Tag, err = usecase.NewTagUseCase(repos.tag, repos.competency, repos.steward, repos.transactor, logger)
+	if err != nil {
+		logger.Error("Failed to wire dependency: tag use case", "Error", err)
+		return httpDelivery.UseCases{}, fmt.Errorf("%w: %w", ErrInitTagUseCase, err)
+	}
+	logger.Info("Tag use case initialized")
+
+	useCases.Relation, err = usecase.NewRelationUseCase(repos.relation, repos.competency, repos.steward, repos.transactor, logger)
+	if err != nil {
+		logger.Error("Failed to wire dependency: relation use case", "Error", err)
+		return httpDelivery.UseCases{}, fmt.Errorf("%w: %w", ErrInitRelationUseCase, err)
+	}
+	logger.Info("Relation use case initialized")
+
+	useCases.Translation, err = usecase.NewTranslationUseCase(repos.translation, repos.competency, repos.rubric, repos.steward, repos.transactor, logger)
+	if err != nil {
+		logger.Error("Failed to wire dependency: translation use case", "Error", err)
+		return httpDelivery.UseCases{}, fmt.Errorf("%w: %w", ErrInitTranslationUseCase, err)
+	}
+	logger.Info("Translation use case initialized")
+
+	useCases.Merge, err = usecase.NewMergeUseCase(repos.merge, repos.competency, repos.relation, repos.revision, repos.transactor, logger)
+	if err != nil {
+		logger.Error("Failed to wire dependency: merge use case", "Error", err)
+		return httpDelivery.UseCases{}, fmt.Errorf("%w: %w", ErrInitMergeUseCase, err)
+	}
+	logger.Info("Merge use case initialized")
+
+	useCases.Proposal, err = usecase.NewProposalUseCase(repos.proposal, useCases.Competency, repos.notification, repos.transactor, logger)
+	if err != nil {
+		logger.Error("Failed to wire dependency: proposal use case", "Error", err)
+		return httpDelivery.UseCases{}, fmt.Errorf("%w: %w", ErrInitProposalUseCase, err)
+	}
+	logger.Info("Proposal use case initialized")
+
+	useCases.Steward, err = usecase.NewStewardUseCase(repos.steward, repos.competency, repos.notification, repos.translation, repos.transactor, logger)
+	if err != nil {
+		logger.Error("Failed to wire dependency: steward use case", "Error", err)
+		return httpDelivery.UseCases{}, fmt.Errorf("%w: %w", ErrInitStewardUseCase, err)
+	}
+	logger.Info("Steward use case initialized")
+
+	useCases.Notification, err = usecase.NewNotificationUseCase(repos.notification, logger)
+	if err != nil {
+		logger.Error("Failed to wire dependency: notification use case", "Error", err)
+		return httpDelivery.UseCases{}, fmt.Errorf("%w: %w", ErrInitNotificationUseCase, err)
+	}
+	logger.Info("Notification use case initialized")
+
+	useCases.Framework, err = usecase.NewFrameworkUseCase(repos.framework, logger)
+	if err != nil {
+		logger.Error("Failed to wire dependency: framework use case", "Error", err)
+		return httpDelivery.UseCases{}, fmt.Errorf("%w: %w", ErrInitFrameworkUseCase, err)
+	}
+	logger.Info("Framework use case initialized")
+
+	return useCases, nil
+}
+
+// initRateLimiter initializes the rate limiter with the configured store backend
+// The limiter's expired state is cleaned up in the background until ctx is cancelled
+func initRateLimiter(ctx context.Context, cfg config.RateLimitConfig, db *pgxpool.Pool, logger *slog.Logger) (*ratelimit.Limiter, error) {
+	var store ratelimit.Store
+	switch cfg.Backend {
+	case config.RateLimitBackendMemory:
+		store = ratelimit.NewMemoryStore()
+	case config.RateLimitBackendPostgres:
+		pgStore, err := repository.NewRateLimitStore(db, logger)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInitRateLimiter, err)
+		}
+		store = pgStore
+	default:
+		return nil, fmt.Errorf("%w: unknown backend '%s'", ErrInitRateLimiter, cfg.Backend)
+	}
+
+	limiter, err := ratelimit.NewLimiter(store)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInitRateLimiter, err)
+	}
+
+	cleanupInterval := time.Duration(cfg.CleanupInterval) * time.Second
+	go limiter.RunCleanup(ctx, cleanupInterval, func(err error) {
+		logger.Error("Failed to clean up rate limit state", "error", err)
+	})
+
+	logger.Info("Rate limiter initialized", "backend", cfg.Backend)
+	return limiter, nil
 }
 
 // initServer initializes the HTTP server
-func initServer(cfg config.ServerConfig, userUseCase domain.UserUseCase, competencyUseCase domain.CompetencyUseCase, logger *slog.Logger) (*httpDelivery.Server, error) {
-	// Setup HTTP router with timeout from config
-	handlerTimeout := time.Duration(cfg.HandlerTimeout) * time.Second
-	router, err := httpDelivery.NewRouter(userUseCase, competencyUseCase, logger, handlerTimeout)
+func initServer(cfg config.ServerConfig, rateLimitCfg config.RateLimitConfig, corsCfg config.CORSConfig, useCases httpDelivery.UseCases, tokenGenerator domain.TokenGenerator, limiter *ratelimit.Limiter, logger *slog.Logger) (*httpDelivery.Server, error) {
+	// Setup HTTP router with timeout, proxies, rate limits and CORS policy from config
+	routerCfg := httpDelivery.RouterConfig{
+		Timeouts: httpDelivery.RouteTimeouts{
+			Health:      time.Duration(cfg.HealthTimeout) * time.Second,
+			Auth:        time.Duration(cfg.AuthTimeout) * time.Second,
+			Standard:    time.Duration(cfg.HandlerTimeout) * time.Second,
+			LongRunning: time.Duration(cfg.LongRunningTimeout) * time.Second,
+			Streaming:   time.Duration(cfg.StreamingTimeout) * time.Second,
+		},
+		MaxBodyBytes:   int64(cfg.MaxBodyBytes),
+		MaxImportBytes: int64(cfg.MaxImportBytes),
+		TrustedProxies: cfg.TrustedProxies,
+		AuthRateLimit: ratelimit.RateLimit{
+			MaxRequests: rateLimitCfg.AuthMaxRequests,
+			Window:      time.Duration(rateLimitCfg.AuthWindow) * time.Second,
+		},
+		LoginEmailRateLimit: ratelimit.RateLimit{
+			MaxRequests: rateLimitCfg.LoginEmailMaxRequests,
+			Window:      time.Duration(rateLimitCfg.LoginEmailWindow) * time.Second,
+		},
+		APIIPRateLimit: ratelimit.RateLimit{
+			MaxRequests: rateLimitCfg.APIIPMaxRequests,
+			Window:      time.Duration(rateLimitCfg.APIIPWindow) * time.Second,
+		},
+		APIRateLimit: ratelimit.RateLimit{
+			MaxRequests: rateLimitCfg.APIMaxRequests,
+			Window:      time.Duration(rateLimitCfg.APIWindow) * time.Second,
+		},
+		CORS: corsCfg,
+	}
+	router, err := httpDelivery.NewRouter(useCases, tokenGenerator, limiter, logger, routerCfg)
 	if err != nil {
 		return nil, err
 	}
 
 	// Create HTTP server
 	return httpDelivery.NewServer(cfg, router, logger), nil
-}
\ No newline at end of file
+}
@@ -0,0 +1,1381 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/dto"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/handler"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/middleware"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/openapi"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/request"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/response"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+)
+
+// bearerAuth is the name of the JWT security scheme in the spec
+const bearerAuth = "bearerAuth"
+
+// routeSpec describes one route of NewRouter for the OpenAPI document
+type routeSpec struct {
+	Method      string
+	Path        string
+	Tag         string
+	Summary     string
+	Description string
+	Parameters  []openapi.Parameter
+	Body        any      // Request DTO, nil when the route takes no body
+	Status      int      // Success status code
+	Result      any      // Response DTO, nil when the success response has no body
+	FileBody    any      // Schema of a request body uploaded as a CSV, JSON or YAML file, instead of Body
+	FileResult  any      // Schema of a success response downloaded as a CSV, JSON or YAML file, instead of Result
+	TextResult  []string // Media types of a success response sent as text, instead of Result
+	Auth        bool     // Accepts a bearer token (anonymous requests are allowed too)
+	ETag        bool     // Success responses carry an ETag; GET honours If-None-Match, updates require If-Match
+	IfMatch     bool     // POST action requiring If-Match like updates do (with ETag)
+	Localized   bool     // Reads competencies in the locale of the request (?lang= or Accept-Language)
+	Redirect    bool     // GET answers 301 with the Location of the competency a merged ID was merged into
+	Errors      []error  // Errors the route responds with, besides those implied by Body
+}
+
+// apiSpec builds the OpenAPI document of the routes of NewRouter
+// Error responses are derived from the problem registry, so they stay in sync with response.Writer
+type apiSpec struct {
+	builder  *openapi.Builder
+	problems *response.Registry
+}
+
+// newAPISpec creates the spec builder with the document metadata and shared components
+func newAPISpec(problems *response.Registry) *apiSpec {
+	builder := openapi.NewBuilder(openapi.Info{
+		Title:       "DevNorth API",
+		Version:     "1.0.0",
+		Description: "Competency catalogue and authentication API. Errors are RFC 7807 problem details (application/problem+json).",
+	})
+	builder.AddServer(openapi.Server{URL: "/"})
+	builder.AddTag(openapi.Tag{Name: "system", Description: "Health, metrics and documentation"})
+	builder.AddTag(openapi.Tag{Name: "auth", Description: "Registration and login"})
+	builder.AddTag(openapi.Tag{Name: "competencies", Description: "Competency catalogue"})
+	builder.AddTag(openapi.Tag{Name: "categories", Description: "Competency taxonomy (tree of categories)"})
+	builder.AddTag(openapi.Tag{Name: "rubrics", Description: "Rating scales and what their levels mean for each competency"})
+	builder.AddTag(openapi.Tag{Name: "tags", Description: "Free-form and curated tags on competencies"})
+	builder.AddTag(openapi.Tag{Name: "relations", Description: "Prerequisites and related competencies"})
+	builder.AddTag(openapi.Tag{Name: "translations", Description: "Competency texts in other locales than the fallback one (en)"})
+	builder.AddTag(openapi.Tag{Name: "merges", Description: "Near-duplicate detection and competency merges"})
+	builder.AddTag(openapi.Tag{Name: "proposals", Description: "Suggested competencies and edits, applied when an admin approves them"})
+	builder.AddTag(openapi.Tag{Name: "stewards", Description: "Users keeping competencies current, and reports of outdated competencies"})
+	builder.AddTag(openapi.Tag{Name: "notifications", Description: "Inbox of the user"})
+	builder.AddTag(openapi.Tag{Name: "frameworks", Description: "Immutable published versions of the competency framework"})
+	builder.AddSecurityScheme(bearerAuth, openapi.SecurityScheme{
+		Type:         "http",
+		Scheme:       "bearer",
+		BearerFormat: "JWT",
+		Description:  "Access token returned by /api/v1/auth/login",
+	})
+
+	return &apiSpec{builder: builder, problems: problems}
+}
+
+// add adds a route to the document
+func (s *apiSpec) add(route routeSpec) {
+	op := &openapi.Operation{
+		Tags:        []string{route.Tag},
+		Summary:     route.Summary,
+		Description: route.Description,
+		OperationID: operationID(route.Method, route.Path),
+		Parameters:  route.Parameters,
+		Responses:   make(map[string]*openapi.Response),
+	}
+
+	if route.Auth {
+		op.Security = []map[string][]string{{}, {bearerAuth: {}}}
+	}
+	if route.Localized {
+		op.Parameters = append(op.Parameters, localeParameters()...)
+	}
+
+	errs := slices.Clone(route.Errors)
+	if route.Body != nil {
+		op.RequestBody = &openapi.RequestBody{
+			Required: true,
+			Content:  map[string]openapi.MediaType{"application/json": {Schema: s.builder.Schema(route.Body)}},
+		}
+		errs = append(errs, request.ErrUnsupportedMediaType, request.ErrBodyTooLarge, request.ErrInvalidJSON)
+	}
+	if route.FileBody != nil {
+		op.RequestBody = &openapi.RequestBody{Required: true, Content: s.fileContent(route.FileBody)}
+		errs = append(errs, request.ErrBodyTooLarge, request.ErrEmptyBody)
+	}
+	errs = append(errs, middleware.ErrPanicRecovered)
+
+	success := &openapi.Response{Description: http.StatusText(route.Status)}
+	if route.Result != nil {
+		success.Content = map[string]openapi.MediaType{"application/json": {Schema: s.builder.Schema(route.Result)}}
+	}
+	if route.FileResult != nil {
+		success.Content = s.fileContent(route.FileResult)
+		success.Headers = map[string]openapi.Header{"Content-Disposition": {Description: "Attachment file name", Schema: &openapi.Schema{Type: "string"}}}
+	}
+	if route.TextResult != nil {
+		success.Content = make(map[string]openapi.MediaType, len(route.TextResult))
+		for _, mediaType := range route.TextResult {
+			success.Content[mediaType] = openapi.MediaType{Schema: &openapi.Schema{Type: "string"}}
+		}
+	}
+	op.Responses[strconv.Itoa(route.Status)] = success
+
+	if route.Redirect {
+		op.Responses[strconv.Itoa(http.StatusMovedPermanently)] = &openapi.Response{
+			Description: http.StatusText(http.StatusMovedPermanently),
+			Headers:     map[string]openapi.Header{"Location": {Description: "URL of the competency the requested one was merged into", Schema: &openapi.Schema{Type: "string"}}},
+		}
+	}
+
+	if route.ETag {
+		etag := map[string]openapi.Header{"ETag": {Description: "Version of the resource", Schema: &openapi.Schema{Type: "string"}}}
+		success.Headers = etag
+		if route.Method == http.MethodGet {
+			op.Parameters = append(op.Parameters, openapi.Parameter{
+				Name:        "If-None-Match",
+				In:          "header",
+				Description: "ETag(s) the client has; 304 is returned when one matches",
+				Schema:      &openapi.Schema{Type: "string"},
+			})
+			op.Responses[strconv.Itoa(http.StatusNotModified)] = &openapi.Response{Description: http.StatusText(http.StatusNotModified), Headers: etag}
+		} else if route.Method != http.MethodPost || route.IfMatch {
+			op.Parameters = append(op.Parameters, openapi.Parameter{
+				Name:        "If-Match",
+				In:          "header",
+				Description: `ETag of the version being updated, or "*" for any version`,
+				Required:    true,
+				Schema:      &openapi.Schema{Type: "string"},
+			})
+			errs = append(errs, handler.ErrPreconditionRequired, domain.ErrCompetencyVersionConflict)
+		}
+	}
+
+	// Group the problem codes of the route by status
+	codes := make(map[int][]string)
+	for _, err := range errs {
+		problem, _ := s.problems.Resolve(err)
+		if !slices.Contains(codes[problem.Status], problem.Code) {
+			codes[problem.Status] = append(codes[problem.Status], problem.Code)
+		}
+	}
+	problemSchema := s.builder.Schema(dto.ProblemDetails{})
+	for status, statusCodes := range codes {
+		slices.Sort(statusCodes)
+		op.Responses[strconv.Itoa(status)] = &openapi.Response{
+			Description: fmt.Sprintf("%s (error: %s)", http.StatusText(status), strings.Join(statusCodes, ", ")),
+			Content:     map[string]openapi.MediaType{"application/problem+json": {Schema: problemSchema}},
+		}
+	}
+
+	s.builder.Add(route.Method, route.Path, op)
+}
+
+// fileContent describes a file exchanged as CSV, JSON or YAML, the latter two holding v
+// CSV files have a header row naming the fields of v's items
+func (s *apiSpec) fileContent(v any) map[string]openapi.MediaType {
+	schema := s.builder.Schema(v)
+	return map[string]openapi.MediaType{
+		"text/csv":         {Schema: &openapi.Schema{Type: "string"}},
+		"application/json": {Schema: schema},
+		"application/yaml": {Schema: schema},
+	}
+}
+
+// document returns the document, listing every problem code the writer can emit on ProblemDetails.error
+func (s *apiSpec) document() *openapi.Document {
+	s.builder.Schema(dto.ProblemDetails{})
+	doc := s.builder.Document()
+
+	problemSchema := doc.Components.Schemas["ProblemDetails"]
+	codes := problemSchema.Properties["error"]
+	codes.Description = "Error code of the previous error format, kept for existing clients"
+	codes.Enum = nil
+	for _, problem := range s.problems.Problems() {
+		codes.Enum = append(codes.Enum, problem.Code)
+	}
+	problemSchema.Properties["type"].Format = "uri"
+	problemSchema.Properties["instance"].Format = "uri-reference"
+
+	return doc
+}
+
+// operationID derives a stable operation ID from the method and path, e.g. get_api_v1_competencies_id
+func operationID(method, path string) string {
+	replacer := strings.NewReplacer("/", "_", "{", "", "}", "", "-", "_", ":", "_", ".", "_")
+	return strings.ToLower(method) + strings.TrimRight(replacer.Replace(path), "_")
+}
+
+// idParameter is the numeric {id} path parameter
+func idParameter(description string) openapi.Parameter {
+	return openapi.Parameter{
+		Name:        "id",
+		In:          "path",
+		Description: description,
+		Required:    true,
+		Schema:      &openapi.Schema{Type: "integer", Format: "int32"},
+	}
+}
+
+// levelParameter documents the {level} path parameter of competency level routes
+func levelParameter() openapi.Parameter {
+	return openapi.Parameter{
+		Name:        "level",
+		In:          "path",
+		Description: "Value of the level in the rating scale",
+		Required:    true,
+		Schema:      &openapi.Schema{Type: "integer", Format: "int32"},
+	}
+}
+
+// revisionParameter documents the {rev} path parameter of competency history routes
+func revisionParameter() openapi.Parameter {
+	return openapi.Parameter{
+		Name:        "rev",
+		In:          "path",
+		Description: "Revision number, from 1 for the creation of the competency",
+		Required:    true,
+		Schema:      &openapi.Schema{Type: "integer", Format: "int32"},
+	}
+}
+
+// localeParameters documents how localized routes select their locale (see middleware.Locale)
+func localeParameters() []openapi.Parameter {
+	locales := make([]any, len(domain.SupportedLocales))
+	for i, locale := range domain.SupportedLocales {
+		locales[i] = string(locale)
+	}
+	return []openapi.Parameter{
+		{
+			Name:        "lang",
+			In:          "query",
+			Description: "Locale to read competencies in; takes precedence over Accept-Language",
+			Schema:      &openapi.Schema{Type: "string", Enum: locales},
+		},
+		{
+			Name:        "Accept-Language",
+			In:          "header",
+			Description: "Preferred locales; untranslated texts fall back to " + string(domain.FallbackLocale),
+			Schema:      &openapi.Schema{Type: "string"},
+		},
+	}
+}
+
+// localeParameter documents the {locale} path parameter of translation routes
+func localeParameter() openapi.Parameter {
+	return openapi.Parameter{
+		Name:        "locale",
+		In:          "path",
+		Description: "Locale of the translation",
+		Required:    true,
+		Schema:      &openapi.Schema{Type: "string", Enum: []any{string(domain.LocaleGerman), string(domain.LocalePersian)}},
+	}
+}
+
+// otherIDParameter documents the {other_id} path parameter of competency relation routes
+func otherIDParameter(description string) openapi.Parameter {
+	return openapi.Parameter{
+		Name:        "other_id",
+		In:          "path",
+		Description: description,
+		Required:    true,
+		Schema:      &openapi.Schema{Type: "integer", Format: "int32"},
+	}
+}
+
+// userIDParameter documents the {user_id} path parameter of competency steward routes
+func userIDParameter() openapi.Parameter {
+	return openapi.Parameter{
+		Name:        "user_id",
+		In:          "path",
+		Description: "User ID",
+		Required:    true,
+		Schema:      &openapi.Schema{Type: "integer", Format: "int32"},
+	}
+}
+
+// versionNameParameter documents the {name} path parameter of framework version routes
+func versionNameParameter() openapi.Parameter {
+	return openapi.Parameter{
+		Name:        "name",
+		In:          "path",
+		Description: "Version name, or \"draft\" for the current framework",
+		Required:    true,
+		Schema:      &openapi.Schema{Type: "string"},
+	}
+}
+
+// tagIDParameter documents the {tag_id} path parameter of competency tag routes
+func tagIDParameter() openapi.Parameter {
+	return openapi.Parameter{
+		Name:        "tag_id",
+		In:          "path",
+		Description: "Tag ID",
+		Required:    true,
+		Schema:      &openapi.Schema{Type: "integer", Format: "int32"},
+	}
+}
+
+// buildAPISpec describes every route registered by NewRouter and returns the JSON encoded document
+// Keep it in sync with the router: TestAPISpecCoversRoutes fails when a route is missing
+func buildAPISpec(problems *response.Registry) ([]byte, error) {
+	spec := newAPISpec(problems)
+
+	// Errors of the middleware chains
+	apiErrors := []error{domain.ErrInvalidToken, middleware.ErrRateLimitExceeded, middleware.ErrRequestTimeout, domain.ErrUnsupportedLocale}
+	authErrors := []error{middleware.ErrRateLimitExceeded, middleware.ErrRequestTimeout}
+
+	// Errors of the routes restricted to admins, or to admins and the stewards of the competency
+	adminErrors := []error{domain.ErrAuthenticationRequired, domain.ErrForbidden}
+
+	// System
+	spec.add(routeSpec{
+		Method:      http.MethodGet,
+		Path:        "/debug/vars",
+		Tag:         "system",
+		Summary:     "Process metrics (expvar)",
+		Description: "Admins only.",
+		Status:      http.StatusOK,
+		Result:      map[string]any{},
+		Auth:        true,
+		Errors:      append([]error{domain.ErrInvalidToken}, adminErrors...),
+	})
+	spec.add(routeSpec{
+		Method:  http.MethodGet,
+		Path:    "/api/openapi.json",
+		Tag:     "system",
+		Summary: "This OpenAPI document",
+		Status:  http.StatusOK,
+		Result:  map[string]any{},
+	})
+	spec.add(routeSpec{
+		Method:  http.MethodGet,
+		Path:    "/api/docs",
+		Tag:     "system",
+		Summary: "API docs UI (HTML)",
+		Status:  http.StatusOK,
+	})
+	spec.add(routeSpec{
+		Method:  http.MethodGet,
+		Path:    "/api/v1/health",
+		Tag:     "system",
+		Summary: "Health check",
+		Status:  http.StatusOK,
+		Result:  dto.HealthResponse{},
+		Errors:  []error{middleware.ErrRequestTimeout},
+	})
+
+	// Authentication
+	spec.add(routeSpec{
+		Method:      http.MethodPost,
+		Path:        "/api/v1/auth/register",
+		Tag:         "auth",
+		Summary:     "Register a user",
+		Description: "Creates the account and logs the user in. If the automatic login fails, the response has no token and a message asking to log in.",
+		Body:        dto.RegisterRequest{},
+		Status:      http.StatusCreated,
+		Result:      dto.AuthResponse{},
+		Errors:      append([]error{domain.ErrInvalidEmail, domain.ErrInvalidPassword, domain.ErrEmailAlreadyExists}, authErrors...),
+	})
+	spec.add(routeSpec{
+		Method:  http.MethodPost,
+		Path:    "/api/v1/auth/login",
+		Tag:     "auth",
+		Summary: "Log in",
+		Body:    dto.LoginRequest{},
+		Status:  http.StatusOK,
+		Result:  dto.AuthResponse{},
+		Errors:  append([]error{domain.ErrInvalidCredentials}, authErrors...),
+	})
+
+	// Competencies
+	spec.add(routeSpec{
+		Method:      http.MethodPost,
+		Path:        "/api/v1/competencies",
+		Tag:         "competencies",
+		Summary:     "Create a competency",
+		Description: "Admins only; other users propose competencies through /proposals.",
+		Body:        dto.CreateCompetencyRequest{},
+		Status:      http.StatusCreated,
+		Result:      dto.CompetencyDTO{},
+		Auth:        true,
+		ETag:        true,
+		Errors:      append(append([]error{domain.ErrInvalidCompetencyName, domain.ErrCompetencyAlreadyExists}, adminErrors...), apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodPost,
+		Path:        "/api/v1/competencies:batch",
+		Tag:         "competencies",
+		Summary:     "Create competencies in bulk",
+		Description: "Admins only. Creates up to 500 competencies in one transaction. mode=all_or_nothing (default) creates nothing when an item is a duplicate or invalid and lists those items in the problem's errors; mode=best_effort creates the valid items. The response has one result per item, in request order.",
+		Parameters:  spec.builder.QueryParameters(dto.CreateCompetenciesBatchQuery{}),
+		Body:        dto.CreateCompetenciesBatchRequest{},
+		Status:      http.StatusOK,
+		Result:      dto.CompetencyBatchResponse{},
+		Auth:        true,
+		Errors:      append(append([]error{dto.ValidationError{}, domain.ErrInvalidCompetencyBatch, domain.ErrCompetencyBatchRejected}, adminErrors...), apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/competencies",
+		Tag:         "competencies",
+		Summary:     "List competencies",
+		Description: "Keyset paginated: pass next_cursor as cursor, with the same sort and direction, to get the next page. total counts every competency matching the filters. category_id also matches the competencies of its descendant categories. tag can be repeated (?tag=go&tag=cloud): competencies carrying any of the tags match, or all of them with tag_match=all; names are case-insensitive.",
+		Parameters:  spec.builder.QueryParameters(dto.ListCompetenciesQuery{}),
+		Status:      http.StatusOK,
+		Result:      dto.CompetenciesResponse{},
+		Auth:        true,
+		Localized:   true,
+		Errors:      append([]error{dto.ValidationError{}, domain.ErrInvalidCompetencySort, domain.ErrInvalidCompetencyCursor, domain.ErrInvalidTagName, domain.ErrInvalidTagMatch}, apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/competencies/search",
+		Tag:         "competencies",
+		Summary:     "Search competencies",
+		Description: "Full-text search over names and descriptions (web search syntax: \"phrases\", OR, -word) that also finds names with typos. Results are ranked by relevance; highlights are HTML-escaped with matches in <mark>.",
+		Parameters:  spec.builder.QueryParameters(dto.SearchCompetenciesQuery{}),
+		Status:      http.StatusOK,
+		Result:      dto.CompetencySearchResponse{},
+		Auth:        true,
+		Errors:      append([]error{dto.ValidationError{}, domain.ErrInvalidSearchQuery}, apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/competencies/suggest",
+		Tag:         "competencies",
+		Summary:     "Suggest competency names (typeahead)",
+		Description: "Names starting with the prefix come first, then names containing a word similar to it.",
+		Parameters:  spec.builder.QueryParameters(dto.SuggestCompetenciesQuery{}),
+		Status:      http.StatusOK,
+		Result:      dto.CompetencySuggestionsResponse{},
+		Auth:        true,
+		Errors:      append([]error{dto.ValidationError{}, domain.ErrInvalidSearchQuery}, apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/competencies/export",
+		Tag:         "competencies",
+		Summary:     "Export the competency catalogue",
+		Description: "Streams every competency that isn't archived, ordered by name, as a CSV, JSON or YAML attachment. A failure after the download started aborts the connection.",
+		Parameters:  spec.builder.QueryParameters(dto.ExportCompetenciesQuery{}),
+		Status:      http.StatusOK,
+		FileResult:  []dto.CompetencyRecord{},
+		Auth:        true,
+		Errors:      append([]error{dto.ValidationError{}}, apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodPost,
+		Path:        "/api/v1/competencies/import",
+		Tag:         "competencies",
+		Summary:     "Import a competency catalogue",
+		Description: "Admins only. Imports a CSV, JSON or YAML file in the export format (timestamps are ignored) in one transaction. on_existing tells what to do with names that already exist: skip (default), update the description, or fail. The import is rejected when a record is invalid or conflicts; dry_run=true reports the creates, updates and conflicts without changing anything.",
+		Parameters:  spec.builder.QueryParameters(dto.ImportCompetenciesQuery{}),
+		FileBody:    []dto.CompetencyRecord{},
+		Status:      http.StatusOK,
+		Result:      dto.CompetencyImportResponse{},
+		Auth:        true,
+		Errors:      append(append([]error{dto.ValidationError{}, handler.ErrUnsupportedImportFormat, domain.ErrInvalidCompetencyImport, domain.ErrCompetencyImportRejected}, adminErrors...), apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/competencies/{id}",
+		Tag:         "competencies",
+		Summary:     "Get a competency",
+		Description: "include=levels embeds the rubric of the competency (null without one). Rubric changes bump the version, and the ETag tells the representations with and without levels apart (\"7-levels\"). The ID of a merged competency redirects to the competency it was merged into.",
+		Parameters:  append([]openapi.Parameter{idParameter("Competency ID")}, spec.builder.QueryParameters(dto.GetCompetencyQuery{})...),
+		Status:      http.StatusOK,
+		Result:      dto.CompetencyDTO{},
+		Auth:        true,
+		ETag:        true,
+		Localized:   true,
+		Redirect:    true,
+		Errors:      append([]error{dto.ValidationError{}, domain.ErrCompetencyNotFound}, apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodPatch,
+		Path:        "/api/v1/competencies/{id}/description",
+		Tag:         "competencies",
+		Summary:     "Update the description of a competency",
+		Description: "Admins and stewards of the competency only.",
+		Parameters:  []openapi.Parameter{idParameter("Competency ID")},
+		Body:        dto.UpdateCompetencyDescriptionRequest{},
+		Status:      http.StatusOK,
+		Result:      dto.CompetencyDTO{},
+		Auth:        true,
+		ETag:        true,
+		Errors:      append(append([]error{dto.ValidationError{}, domain.ErrCompetencyNotFound}, adminErrors...), apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodPatch,
+		Path:        "/api/v1/competencies/{id}/name",
+		Tag:         "competencies",
+		Summary:     "Rename a competency",
+		Description: "Admins and stewards of the competency only.",
+		Parameters:  []openapi.Parameter{idParameter("Competency ID")},
+		Body:        dto.RenameCompetencyRequest{},
+		Status:      http.StatusOK,
+		Result:      dto.CompetencyDTO{},
+		Auth:        true,
+		ETag:        true,
+		Errors:      append(append([]error{dto.ValidationError{}, domain.ErrInvalidCompetencyName, domain.ErrCompetencyNotFound, domain.ErrCompetencyAlreadyExists}, adminErrors...), apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodPost,
+		Path:        "/api/v1/competencies/{id}/archive",
+		Tag:         "competencies",
+		Summary:     "Archive a competency",
+		Description: "Admins and stewards of the competency only. Archived competencies stay readable by ID but are hidden from listings (unless include_archived=true), search and exports. Archiving an archived competency changes nothing.",
+		Parameters:  []openapi.Parameter{idParameter("Competency ID")},
+		Status:      http.StatusOK,
+		Result:      dto.CompetencyDTO{},
+		Auth:        true,
+		ETag:        true,
+		Errors:      append(append([]error{dto.ValidationError{}, domain.ErrCompetencyNotFound}, adminErrors...), apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodPost,
+		Path:        "/api/v1/competencies/{id}/restore",
+		Tag:         "competencies",
+		Summary:     "Restore an archived competency",
+		Description: "Admins and stewards of the competency only. Restoring a competency that isn't archived changes nothing.",
+		Parameters:  []openapi.Parameter{idParameter("Competency ID")},
+		Status:      http.StatusOK,
+		Result:      dto.CompetencyDTO{},
+		Auth:        true,
+		ETag:        true,
+		Errors:      append(append([]error{dto.ValidationError{}, domain.ErrCompetencyNotFound}, adminErrors...), apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodDelete,
+		Path:        "/api/v1/competencies/{id}",
+		Tag:         "competencies",
+		Summary:     "Delete a competency permanently",
+		Description: "Admins only. Refused while prerequisite or related edges, IDs merged into it or a rubric reference the competency; archive it instead. Its tags, translations and history are deleted with it.",
+		Parameters:  []openapi.Parameter{idParameter("Competency ID")},
+		Status:      http.StatusNoContent,
+		Auth:        true,
+		Errors:      append(append([]error{dto.ValidationError{}, domain.ErrCompetencyNotFound, domain.ErrCompetencyInUse}, adminErrors...), apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodPut,
+		Path:        "/api/v1/competencies/{id}/category",
+		Tag:         "competencies",
+		Summary:     "Place a competency under a category",
+		Description: "Admins and stewards of the competency only. A null category_id makes the competency uncategorized.",
+		Parameters:  []openapi.Parameter{idParameter("Competency ID")},
+		Body:        dto.SetCompetencyCategoryRequest{},
+		Status:      http.StatusOK,
+		Result:      dto.CompetencyDTO{},
+		Auth:        true,
+		Errors:      append(append([]error{dto.ValidationError{}, domain.ErrCompetencyNotFound, domain.ErrCategoryNotFound}, adminErrors...), apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/competencies/{id}/history",
+		Tag:         "competencies",
+		Summary:     "Get the change history of a competency",
+		Description: "One revision per change (create, update, rename, archive, restore, categorize, revert), newest first, with its editor and the state before and after it. Pass next_before as before to get the next page.",
+		Parameters:  append([]openapi.Parameter{idParameter("Competency ID")}, spec.builder.QueryParameters(dto.CompetencyHistoryQuery{})...),
+		Status:      http.StatusOK,
+		Result:      dto.CompetencyHistoryResponse{},
+		Auth:        true,
+		Errors:      append([]error{dto.ValidationError{}, domain.ErrCompetencyNotFound}, apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/competencies/{id}/history/diff",
+		Tag:         "competencies",
+		Summary:     "Compare two revisions of a competency",
+		Description: "The fields whose value differs from the state after revision from to the state after revision to; either may be the older one.",
+		Parameters:  append([]openapi.Parameter{idParameter("Competency ID")}, spec.builder.QueryParameters(dto.CompetencyDiffQuery{})...),
+		Status:      http.StatusOK,
+		Result:      dto.CompetencyDiffResponse{},
+		Auth:        true,
+		Errors:      append([]error{dto.ValidationError{}, domain.ErrCompetencyNotFound, domain.ErrRevisionNotFound}, apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodPost,
+		Path:        "/api/v1/competencies/{id}/revert/{rev}",
+		Tag:         "competencies",
+		Summary:     "Revert a competency to a revision",
+		Description: "Admins and stewards of the competency only. Sets the name, description, category and archive state recorded by the revision. The revert is recorded as a new revision, so it can be undone too.",
+		Parameters:  []openapi.Parameter{idParameter("Competency ID"), revisionParameter()},
+		Status:      http.StatusOK,
+		Result:      dto.CompetencyDTO{},
+		Auth:        true,
+		ETag:        true,
+		IfMatch:     true,
+		Errors:      append(append([]error{dto.ValidationError{}, domain.ErrCompetencyNotFound, domain.ErrRevisionNotFound, domain.ErrCompetencyAlreadyExists, domain.ErrCategoryNotFound}, adminErrors...), apiErrors...),
+	})
+
+	// Categories
+	spec.add(routeSpec{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/categories",
+		Tag:         "categories",
+		Summary:     "Get the category tree",
+		Description: "The root categories with their descendants, siblings in order. competency_count counts the competencies placed directly under each category, archived ones excluded.",
+		Status:      http.StatusOK,
+		Result:      dto.CategoryTreeResponse{},
+		Auth:        true,
+		Errors:      apiErrors,
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodPost,
+		Path:        "/api/v1/categories",
+		Tag:         "categories",
+		Summary:     "Create a category",
+		Description: "Admins only. Without parent_id the category is a root category. It is placed after its siblings.",
+		Body:        dto.CreateCategoryRequest{},
+		Status:      http.StatusCreated,
+		Result:      dto.CategoryDTO{},
+		Auth:        true,
+		Errors:      append(append([]error{domain.ErrInvalidCategoryName, domain.ErrCategoryParentNotFound, domain.ErrCategoryAlreadyExists}, adminErrors...), apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodPost,
+		Path:        "/api/v1/categories/reorder",
+		Tag:         "categories",
+		Summary:     "Reorder sibling categories",
+		Description: "Admins only. ids lists every child of parent_id (the root categories without it) exactly once, in the new order.",
+		Body:        dto.ReorderCategoriesRequest{},
+		Status:      http.StatusOK,
+		Result:      dto.CategoriesResponse{},
+		Auth:        true,
+		Errors:      append(append([]error{domain.ErrCategoryParentNotFound, domain.ErrInvalidCategoryOrder}, adminErrors...), apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/categories/{id}",
+		Tag:         "categories",
+		Summary:     "Get a category subtree",
+		Description: "The category with its descendants, siblings in order, and their competency counts.",
+		Parameters:  []openapi.Parameter{idParameter("Category ID")},
+		Status:      http.StatusOK,
+		Result:      dto.CategoryNodeDTO{},
+		Auth:        true,
+		Errors:      append([]error{dto.ValidationError{}, domain.ErrCategoryNotFound}, apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodPatch,
+		Path:        "/api/v1/categories/{id}",
+		Tag:         "categories",
+		Summary:     "Rename a category",
+		Description: "Admins only.",
+		Parameters:  []openapi.Parameter{idParameter("Category ID")},
+		Body:        dto.RenameCategoryRequest{},
+		Status:      http.StatusOK,
+		Result:      dto.CategoryDTO{},
+		Auth:        true,
+		Errors:      append(append([]error{dto.ValidationError{}, domain.ErrInvalidCategoryName, domain.ErrCategoryNotFound, domain.ErrCategoryAlreadyExists}, adminErrors...), apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodDelete,
+		Path:        "/api/v1/categories/{id}",
+		Tag:         "categories",
+		Summary:     "Delete a category",
+		Description: "Admins only. Only empty categories can be deleted: move or delete their subcategories and competencies first.",
+		Parameters:  []openapi.Parameter{idParameter("Category ID")},
+		Status:      http.StatusNoContent,
+		Auth:        true,
+		Errors:      append(append([]error{dto.ValidationError{}, domain.ErrCategoryNotFound, domain.ErrCategoryInUse}, adminErrors...), apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodPost,
+		Path:        "/api/v1/categories/{id}/move",
+		Tag:         "categories",
+		Summary:     "Move a category",
+		Description: "Admins only. Moves the category, with its descendants, under parent_id (null for the root) at position among its new siblings (after them without position). Moving a category under itself or one of its descendants is refused.",
+		Parameters:  []openapi.Parameter{idParameter("Category ID")},
+		Body:        dto.MoveCategoryRequest{},
+		Status:      http.StatusOK,
+		Result:      dto.CategoryDTO{},
+		Auth:        true,
+		Errors:      append(append([]error{dto.ValidationError{}, domain.ErrCategoryNotFound, domain.ErrCategoryParentNotFound, domain.ErrCategoryCycle, domain.ErrCategoryAlreadyExists}, adminErrors...), apiErrors...),
+	})
+
+	// Rating scales and rubrics
+	spec.add(routeSpec{
+		Method:  http.MethodGet,
+		Path:    "/api/v1/rating-scales",
+		Tag:     "rubrics",
+		Summary: "List rating scales",
+		Status:  http.StatusOK,
+		Result:  dto.RatingScalesResponse{},
+		Auth:    true,
+		Errors:  apiErrors,
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodPost,
+		Path:        "/api/v1/rating-scales",
+		Tag:         "rubrics",
+		Summary:     "Create a rating scale",
+		Description: "Admins only. A scale has 1 to 10 levels with distinct positive values; higher values are more proficient.",
+		Body:        dto.RatingScaleRequest{},
+		Status:      http.StatusCreated,
+		Result:      dto.RatingScaleDTO{},
+		Auth:        true,
+		Errors:      append(append([]error{domain.ErrInvalidRatingScale, domain.ErrRatingScaleAlreadyExists}, adminErrors...), apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:     http.MethodGet,
+		Path:       "/api/v1/rating-scales/{id}",
+		Tag:        "rubrics",
+		Summary:    "Get a rating scale",
+		Parameters: []openapi.Parameter{idParameter("Rating scale ID")},
+		Status:     http.StatusOK,
+		Result:     dto.RatingScaleDTO{},
+		Auth:       true,
+		Errors:     append([]error{dto.ValidationError{}, domain.ErrRatingScaleNotFound}, apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodPut,
+		Path:        "/api/v1/rating-scales/{id}",
+		Tag:         "rubrics",
+		Summary:     "Replace a rating scale",
+		Description: "Admins only. Levels can be relabelled, added or removed; removing a level a competency describes is refused.",
+		Parameters:  []openapi.Parameter{idParameter("Rating scale ID")},
+		Body:        dto.RatingScaleRequest{},
+		Status:      http.StatusOK,
+		Result:      dto.RatingScaleDTO{},
+		Auth:        true,
+		Errors:      append(append([]error{dto.ValidationError{}, domain.ErrInvalidRatingScale, domain.ErrRatingScaleNotFound, domain.ErrRatingScaleAlreadyExists, domain.ErrRatingScaleInUse}, adminErrors...), apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodDelete,
+		Path:        "/api/v1/rating-scales/{id}",
+		Tag:         "rubrics",
+		Summary:     "Delete a rating scale",
+		Description: "Admins only. Refused while a competency rubric uses the scale.",
+		Parameters:  []openapi.Parameter{idParameter("Rating scale ID")},
+		Status:      http.StatusNoContent,
+		Auth:        true,
+		Errors:      append(append([]error{dto.ValidationError{}, domain.ErrRatingScaleNotFound, domain.ErrRatingScaleInUse}, adminErrors...), apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/competencies/{id}/levels",
+		Tag:         "rubrics",
+		Summary:     "Get the rubric of a competency",
+		Description: "levels lists every level of the scale in order; undescribed levels have no description and no indicators.",
+		Parameters:  []openapi.Parameter{idParameter("Competency ID")},
+		Status:      http.StatusOK,
+		Result:      dto.CompetencyRubricDTO{},
+		Auth:        true,
+		Localized:   true,
+		Errors:      append([]error{dto.ValidationError{}, domain.ErrCompetencyNotFound, domain.ErrRubricNotFound}, apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodPut,
+		Path:        "/api/v1/competencies/{id}/levels",
+		Tag:         "rubrics",
+		Summary:     "Replace the rubric of a competency",
+		Description: "Admins and stewards of the competency only. Sets the rating scale of the competency and replaces every level description; levels not given are left undescribed. Indicators keep their order.",
+		Parameters:  []openapi.Parameter{idParameter("Competency ID")},
+		Body:        dto.SetRubricRequest{},
+		Status:      http.StatusOK,
+		Result:      dto.CompetencyRubricDTO{},
+		Auth:        true,
+		Errors:      append(append([]error{dto.ValidationError{}, domain.ErrInvalidCompetencyLevel, domain.ErrCompetencyNotFound, domain.ErrRatingScaleNotFound, domain.ErrUnknownRatingLevel}, adminErrors...), apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodDelete,
+		Path:        "/api/v1/competencies/{id}/levels",
+		Tag:         "rubrics",
+		Summary:     "Delete the rubric of a competency",
+		Description: "Admins and stewards of the competency only.",
+		Parameters:  []openapi.Parameter{idParameter("Competency ID")},
+		Status:      http.StatusNoContent,
+		Auth:        true,
+		Errors:      append(append([]error{dto.ValidationError{}, domain.ErrCompetencyNotFound, domain.ErrRubricNotFound}, adminErrors...), apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodPut,
+		Path:        "/api/v1/competencies/{id}/levels/{level}",
+		Tag:         "rubrics",
+		Summary:     "Describe one level of a competency",
+		Description: "Admins and stewards of the competency only. The level must belong to the scale of the rubric. Returns the whole rubric.",
+		Parameters:  []openapi.Parameter{idParameter("Competency ID"), levelParameter()},
+		Body:        dto.CompetencyLevelRequest{},
+		Status:      http.StatusOK,
+		Result:      dto.CompetencyRubricDTO{},
+		Auth:        true,
+		Errors:      append(append([]error{dto.ValidationError{}, domain.ErrInvalidCompetencyLevel, domain.ErrCompetencyNotFound, domain.ErrRubricNotFound, domain.ErrUnknownRatingLevel}, adminErrors...), apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodDelete,
+		Path:        "/api/v1/competencies/{id}/levels/{level}",
+		Tag:         "rubrics",
+		Summary:     "Delete the description of one level",
+		Description: "Admins and stewards of the competency only. The level stays in the rubric, undescribed.",
+		Parameters:  []openapi.Parameter{idParameter("Competency ID"), levelParameter()},
+		Status:      http.StatusNoContent,
+		Auth:        true,
+		Errors:      append(append([]error{dto.ValidationError{}, domain.ErrCompetencyNotFound, domain.ErrRubricNotFound, domain.ErrCompetencyLevelNotFound}, adminErrors...), apiErrors...),
+	})
+
+	// Tags
+	spec.add(routeSpec{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/tags",
+		Tag:         "tags",
+		Summary:     "List tags",
+		Description: "Most used first. usage_count counts the competencies carrying the tag, archived ones excluded.",
+		Parameters:  spec.builder.QueryParameters(dto.ListTagsQuery{}),
+		Status:      http.StatusOK,
+		Result:      dto.TagsResponse{},
+		Auth:        true,
+		Errors:      append([]error{dto.ValidationError{}}, apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodPost,
+		Path:        "/api/v1/tags",
+		Tag:         "tags",
+		Summary:     "Create a tag",
+		Description: "Admins only. Other users create free-form tags by tagging competencies.",
+		Body:        dto.CreateTagRequest{},
+		Status:      http.StatusCreated,
+		Result:      dto.TagDTO{},
+		Auth:        true,
+		Errors:      append(append([]error{domain.ErrInvalidTagName, domain.ErrTagAlreadyExists}, adminErrors...), apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:     http.MethodGet,
+		Path:       "/api/v1/tags/{id}",
+		Tag:        "tags",
+		Summary:    "Get a tag",
+		Parameters: []openapi.Parameter{idParameter("Tag ID")},
+		Status:     http.StatusOK,
+		Result:     dto.TagDTO{},
+		Auth:       true,
+		Errors:     append([]error{dto.ValidationError{}, domain.ErrTagNotFound}, apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodPatch,
+		Path:        "/api/v1/tags/{id}",
+		Tag:         "tags",
+		Summary:     "Rename or (un)curate a tag",
+		Description: "Admins only. Omitted fields are left as they are.",
+		Parameters:  []openapi.Parameter{idParameter("Tag ID")},
+		Body:        dto.UpdateTagRequest{},
+		Status:      http.StatusOK,
+		Result:      dto.TagDTO{},
+		Auth:        true,
+		Errors:      append(append([]error{dto.ValidationError{}, domain.ErrInvalidTagName, domain.ErrTagNotFound, domain.ErrTagAlreadyExists}, adminErrors...), apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodDelete,
+		Path:        "/api/v1/tags/{id}",
+		Tag:         "tags",
+		Summary:     "Delete a tag",
+		Description: "Admins only. The tag is removed from its competencies.",
+		Parameters:  []openapi.Parameter{idParameter("Tag ID")},
+		Status:      http.StatusNoContent,
+		Auth:        true,
+		Errors:      append(append([]error{dto.ValidationError{}, domain.ErrTagNotFound}, adminErrors...), apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:     http.MethodGet,
+		Path:       "/api/v1/competencies/{id}/tags",
+		Tag:        "tags",
+		Summary:    "Get the tags of a competency",
+		Parameters: []openapi.Parameter{idParameter("Competency ID")},
+		Status:     http.StatusOK,
+		Result:     dto.TagsResponse{},
+		Auth:       true,
+		Errors:     append([]error{dto.ValidationError{}, domain.ErrCompetencyNotFound}, apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodPost,
+		Path:        "/api/v1/competencies/{id}/tags",
+		Tag:         "tags",
+		Summary:     "Tag a competency",
+		Description: "Adds tags by name; unknown names are created as free-form tags, and tags the competency already carries are ignored. Curated tags are attached by admins and stewards of the competency only. Returns every tag of the competency.",
+		Parameters:  []openapi.Parameter{idParameter("Competency ID")},
+		Body:        dto.TagCompetencyRequest{},
+		Status:      http.StatusOK,
+		Result:      dto.TagsResponse{},
+		Auth:        true,
+		Errors:      append([]error{dto.ValidationError{}, domain.ErrAuthenticationRequired, domain.ErrForbidden, domain.ErrInvalidTagName, domain.ErrCompetencyNotFound}, apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodDelete,
+		Path:        "/api/v1/competencies/{id}/tags/{tag_id}",
+		Tag:         "tags",
+		Summary:     "Remove a tag from a competency",
+		Description: "The tag itself is kept. Curated tags are removed by admins and stewards of the competency only.",
+		Parameters:  []openapi.Parameter{idParameter("Competency ID"), tagIDParameter()},
+		Status:      http.StatusNoContent,
+		Auth:        true,
+		Errors:      append([]error{dto.ValidationError{}, domain.ErrAuthenticationRequired, domain.ErrForbidden, domain.ErrCompetencyNotFound, domain.ErrTagNotFound}, apiErrors...),
+	})
+
+	// Relations
+	spec.add(routeSpec{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/competencies/{id}/relations",
+		Tag:         "relations",
+		Summary:     "Get the competencies linked to a competency",
+		Description: "The competencies it requires, those requiring it and those related to it, ordered by name.",
+		Parameters:  []openapi.Parameter{idParameter("Competency ID")},
+		Status:      http.StatusOK,
+		Result:      dto.CompetencyRelationsResponse{},
+		Auth:        true,
+		Errors:      append([]error{dto.ValidationError{}, domain.ErrCompetencyNotFound}, apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/competencies/{id}/prerequisites",
+		Tag:         "relations",
+		Summary:     "Get the transitive prerequisites of a competency",
+		Description: "Everything the competency requires, directly or through other prerequisites, nearest first. depth is the fewest steps to reach each one.",
+		Parameters:  []openapi.Parameter{idParameter("Competency ID")},
+		Status:      http.StatusOK,
+		Result:      dto.PrerequisitesResponse{},
+		Auth:        true,
+		Errors:      append([]error{dto.ValidationError{}, domain.ErrCompetencyNotFound}, apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodPut,
+		Path:        "/api/v1/competencies/{id}/prerequisites/{other_id}",
+		Tag:         "relations",
+		Summary:     "Make a competency require another one",
+		Description: "Admins and stewards of the competency only. Refused when the other competency already requires this one, directly or not, since prerequisites can't form a cycle. Adding an existing prerequisite changes nothing.",
+		Parameters:  []openapi.Parameter{idParameter("Competency ID"), otherIDParameter("ID of the required competency")},
+		Status:      http.StatusOK,
+		Result:      dto.CompetencyRelationsResponse{},
+		Auth:        true,
+		Errors:      append(append([]error{dto.ValidationError{}, domain.ErrInvalidRelation, domain.ErrCompetencyNotFound, domain.ErrRelationCycle}, adminErrors...), apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodDelete,
+		Path:        "/api/v1/competencies/{id}/prerequisites/{other_id}",
+		Tag:         "relations",
+		Summary:     "Remove a prerequisite of a competency",
+		Description: "Admins and stewards of the competency only.",
+		Parameters:  []openapi.Parameter{idParameter("Competency ID"), otherIDParameter("ID of the required competency")},
+		Status:      http.StatusNoContent,
+		Auth:        true,
+		Errors:      append(append([]error{dto.ValidationError{}, domain.ErrInvalidRelation, domain.ErrRelationNotFound}, adminErrors...), apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodPut,
+		Path:        "/api/v1/competencies/{id}/related/{other_id}",
+		Tag:         "relations",
+		Summary:     "Mark two competencies as related",
+		Description: "Admins and stewards of the competency only. The relation is symmetric. Relating competencies that already are changes nothing.",
+		Parameters:  []openapi.Parameter{idParameter("Competency ID"), otherIDParameter("ID of the related competency")},
+		Status:      http.StatusOK,
+		Result:      dto.CompetencyRelationsResponse{},
+		Auth:        true,
+		Errors:      append(append([]error{dto.ValidationError{}, domain.ErrInvalidRelation, domain.ErrCompetencyNotFound}, adminErrors...), apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodDelete,
+		Path:        "/api/v1/competencies/{id}/related/{other_id}",
+		Tag:         "relations",
+		Summary:     "Unmark two competencies as related",
+		Description: "Admins and stewards of the competency only.",
+		Parameters:  []openapi.Parameter{idParameter("Competency ID"), otherIDParameter("ID of the related competency")},
+		Status:      http.StatusNoContent,
+		Auth:        true,
+		Errors:      append(append([]error{dto.ValidationError{}, domain.ErrInvalidRelation, domain.ErrRelationNotFound}, adminErrors...), apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/competencies/learning-order",
+		Tag:         "relations",
+		Summary:     "Get a learning order for target competencies",
+		Description: "The targets and everything they require, ordered so every competency comes after its prerequisites. Among competencies that could come next, names are in alphabetical order.",
+		Parameters:  spec.builder.QueryParameters(dto.LearningOrderQuery{}),
+		Status:      http.StatusOK,
+		Result:      dto.LearningOrderResponse{},
+		Auth:        true,
+		Errors:      append([]error{dto.ValidationError{}, domain.ErrInvalidLearningTargets, domain.ErrCompetencyNotFound}, apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/competencies/graph",
+		Tag:         "relations",
+		Summary:     "Export the prerequisite graph",
+		Description: "The competencies that aren't archived and have a relation, in Graphviz DOT (default) or Mermaid syntax. Arrows point from a competency to its prerequisite; related competencies are linked by dashed lines.",
+		Parameters:  spec.builder.QueryParameters(dto.CompetencyGraphQuery{}),
+		Status:      http.StatusOK,
+		TextResult:  []string{"text/vnd.graphviz", "text/plain"},
+		Auth:        true,
+		Errors:      append([]error{dto.ValidationError{}}, apiErrors...),
+	})
+
+	// Translations
+	spec.add(routeSpec{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/competencies/{id}/translations",
+		Tag:         "translations",
+		Summary:     "Get the translations of a competency",
+		Description: "Admins and stewards of the competency only. The fallback locale isn't listed: its texts are those of the competency itself.",
+		Parameters:  []openapi.Parameter{idParameter("Competency ID")},
+		Status:      http.StatusOK,
+		Result:      dto.CompetencyTranslationsResponse{},
+		Auth:        true,
+		Errors:      append([]error{dto.ValidationError{}, domain.ErrAuthenticationRequired, domain.ErrForbidden, domain.ErrCompetencyNotFound}, apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodPut,
+		Path:        "/api/v1/competencies/{id}/translations/{locale}",
+		Tag:         "translations",
+		Summary:     "Create or replace the translation of a competency",
+		Description: "Admins and stewards of the competency only. The name must not be shown by another competency in the locale. Levels translate described levels of the rubric; texts left out show in the fallback locale.",
+		Parameters:  []openapi.Parameter{idParameter("Competency ID"), localeParameter()},
+		Body:        dto.SetTranslationRequest{},
+		Status:      http.StatusOK,
+		Result:      dto.CompetencyTranslationDTO{},
+		Auth:        true,
+		Errors: append([]error{dto.ValidationError{}, domain.ErrAuthenticationRequired, domain.ErrForbidden, domain.ErrInvalidTranslation,
+			domain.ErrInvalidCompetencyLevel, domain.ErrCompetencyNotFound, domain.ErrRubricNotFound, domain.ErrCompetencyLevelNotFound,
+			domain.ErrCompetencyAlreadyExists}, apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodDelete,
+		Path:        "/api/v1/competencies/{id}/translations/{locale}",
+		Tag:         "translations",
+		Summary:     "Delete the translation of a competency",
+		Description: "Admins and stewards of the competency only.",
+		Parameters:  []openapi.Parameter{idParameter("Competency ID"), localeParameter()},
+		Status:      http.StatusNoContent,
+		Auth:        true,
+		Errors:      append([]error{dto.ValidationError{}, domain.ErrAuthenticationRequired, domain.ErrForbidden, domain.ErrTranslationNotFound}, apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/translations/missing",
+		Tag:         "translations",
+		Summary:     "Report missing translations",
+		Description: "Admins only. Competencies that aren't archived and lack a translation of their name, description or described rubric levels, in every locale but the fallback one unless locale is given.",
+		Parameters:  spec.builder.QueryParameters(dto.MissingTranslationsQuery{}),
+		Status:      http.StatusOK,
+		Result:      dto.MissingTranslationsResponse{},
+		Auth:        true,
+		Errors:      append([]error{dto.ValidationError{}, domain.ErrAuthenticationRequired, domain.ErrForbidden}, apiErrors...),
+	})
+
+	// Merges
+	spec.add(routeSpec{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/competencies/duplicates",
+		Tag:         "merges",
+		Summary:     "Report near-duplicate competencies",
+		Description: "Admins only. Pairs of competencies that aren't archived with similar names or descriptions (trigram similarity), highest score first. The score is the name similarity, or the mean of the name and description similarities when that is higher.",
+		Parameters:  spec.builder.QueryParameters(dto.DuplicateCompetenciesQuery{}),
+		Status:      http.StatusOK,
+		Result:      dto.DuplicateCompetenciesResponse{},
+		Auth:        true,
+		Errors:      append([]error{dto.ValidationError{}, domain.ErrAuthenticationRequired, domain.ErrForbidden, domain.ErrInvalidDuplicateThreshold}, apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodPost,
+		Path:        "/api/v1/competencies/{id}/merge",
+		Tag:         "merges",
+		Summary:     "Merge a competency into another one",
+		Description: "Admins only. Moves the tags, relations, translations, proposals, stewards, stale-content reports and rubric of merged_id to the competency of the URL (keeping its own where both have one), deletes merged_id and redirects its ID. The merge is recorded in the history of the surviving competency.",
+		Parameters:  []openapi.Parameter{idParameter("ID of the surviving competency")},
+		Body:        dto.MergeCompetencyRequest{},
+		Status:      http.StatusOK,
+		Result:      dto.CompetencyDTO{},
+		Auth:        true,
+		ETag:        true,
+		Errors: append([]error{dto.ValidationError{}, domain.ErrAuthenticationRequired, domain.ErrForbidden, domain.ErrInvalidMerge,
+			domain.ErrCompetencyNotFound, domain.ErrRelationCycle}, apiErrors...),
+	})
+
+	// Proposals
+	spec.add(routeSpec{
+		Method:      http.MethodPost,
+		Path:        "/api/v1/proposals",
+		Tag:         "proposals",
+		Summary:     "Propose a competency or an edit",
+		Description: "Creates a draft, seen only by the user until it is submitted. Without competency_id it proposes a new competency and needs a name; with it, it proposes an edit of the fields that are set.",
+		Body:        dto.CreateProposalRequest{},
+		Status:      http.StatusCreated,
+		Result:      dto.ProposalDTO{},
+		Auth:        true,
+		Errors: append([]error{dto.ValidationError{}, domain.ErrAuthenticationRequired, domain.ErrInvalidProposal,
+			domain.ErrCompetencyNotFound, domain.ErrCategoryNotFound}, apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/proposals",
+		Tag:         "proposals",
+		Summary:     "List the proposals of the user",
+		Description: "Newest first, without their comments.",
+		Parameters:  spec.builder.QueryParameters(dto.ListProposalsQuery{}),
+		Status:      http.StatusOK,
+		Result:      dto.ProposalsResponse{},
+		Auth:        true,
+		Errors:      append([]error{dto.ValidationError{}, domain.ErrAuthenticationRequired, domain.ErrInvalidProposal}, apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/proposals/queue",
+		Tag:         "proposals",
+		Summary:     "List the proposals waiting for review",
+		Description: "Admins only. Submitted proposals, oldest submission first, without their comments.",
+		Parameters:  spec.builder.QueryParameters(dto.ProposalQueueQuery{}),
+		Status:      http.StatusOK,
+		Result:      dto.ProposalsResponse{},
+		Auth:        true,
+		Errors:      append(append([]error{dto.ValidationError{}}, adminErrors...), apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/proposals/{id}",
+		Tag:         "proposals",
+		Summary:     "Get a proposal with its comments",
+		Description: "Proposals are visible to their proposer, and to admins once submitted.",
+		Parameters:  []openapi.Parameter{idParameter("Proposal ID")},
+		Status:      http.StatusOK,
+		Result:      dto.ProposalDTO{},
+		Auth:        true,
+		Errors:      append([]error{dto.ValidationError{}, domain.ErrAuthenticationRequired, domain.ErrProposalNotFound}, apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodPut,
+		Path:        "/api/v1/proposals/{id}",
+		Tag:         "proposals",
+		Summary:     "Replace the change of a draft",
+		Description: "Only the proposer can edit a draft, and its competency can't change.",
+		Parameters:  []openapi.Parameter{idParameter("Proposal ID")},
+		Body:        dto.UpdateProposalRequest{},
+		Status:      http.StatusOK,
+		Result:      dto.ProposalDTO{},
+		Auth:        true,
+		Errors: append([]error{dto.ValidationError{}, domain.ErrAuthenticationRequired, domain.ErrProposalNotFound,
+			domain.ErrProposalStatusConflict, domain.ErrInvalidProposal, domain.ErrCategoryNotFound}, apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodDelete,
+		Path:        "/api/v1/proposals/{id}",
+		Tag:         "proposals",
+		Summary:     "Withdraw a proposal",
+		Description: "The proposer can withdraw a proposal until it is reviewed.",
+		Parameters:  []openapi.Parameter{idParameter("Proposal ID")},
+		Status:      http.StatusNoContent,
+		Auth:        true,
+		Errors: append([]error{dto.ValidationError{}, domain.ErrAuthenticationRequired, domain.ErrProposalNotFound,
+			domain.ErrProposalStatusConflict}, apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodPost,
+		Path:        "/api/v1/proposals/{id}/submit",
+		Tag:         "proposals",
+		Summary:     "Submit a draft for review",
+		Description: "The stewards of the competency an edit proposal changes are notified.",
+		Parameters:  []openapi.Parameter{idParameter("Proposal ID")},
+		Status:      http.StatusOK,
+		Result:      dto.ProposalDTO{},
+		Auth:        true,
+		Errors: append([]error{dto.ValidationError{}, domain.ErrAuthenticationRequired, domain.ErrProposalNotFound,
+			domain.ErrProposalStatusConflict}, apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodPost,
+		Path:        "/api/v1/proposals/{id}/comments",
+		Tag:         "proposals",
+		Summary:     "Comment a proposal",
+		Description: "The proposer and the admins who can see the proposal can comment it, in any status.",
+		Parameters:  []openapi.Parameter{idParameter("Proposal ID")},
+		Body:        dto.ProposalCommentRequest{},
+		Status:      http.StatusCreated,
+		Result:      dto.ProposalCommentDTO{},
+		Auth:        true,
+		Errors: append([]error{dto.ValidationError{}, domain.ErrAuthenticationRequired, domain.ErrProposalNotFound,
+			domain.ErrInvalidProposalComment}, apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodPost,
+		Path:        "/api/v1/proposals/{id}/approve",
+		Tag:         "proposals",
+		Summary:     "Approve a proposal",
+		Description: "Admins only. Applies the change to the catalogue, validated and recorded in the competency history like a direct edit but credited to the proposer, and marks the proposal approved in the same transaction. A change that can't be applied leaves the proposal submitted. The comment is optional.",
+		Parameters:  []openapi.Parameter{idParameter("Proposal ID")},
+		Body:        dto.ReviewProposalRequest{},
+		Status:      http.StatusOK,
+		Result:      dto.ProposalDTO{},
+		Auth:        true,
+		Errors: append(append([]error{dto.ValidationError{}, domain.ErrProposalNotFound, domain.ErrProposalStatusConflict,
+			domain.ErrInvalidProposalComment, domain.ErrInvalidCompetencyName, domain.ErrCompetencyAlreadyExists,
+			domain.ErrCompetencyNotFound, domain.ErrCategoryNotFound}, adminErrors...), apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodPost,
+		Path:        "/api/v1/proposals/{id}/reject",
+		Tag:         "proposals",
+		Summary:     "Reject a proposal",
+		Description: "Admins only. The comment telling the proposer why is required.",
+		Parameters:  []openapi.Parameter{idParameter("Proposal ID")},
+		Body:        dto.ReviewProposalRequest{},
+		Status:      http.StatusOK,
+		Result:      dto.ProposalDTO{},
+		Auth:        true,
+		Errors: append(append([]error{dto.ValidationError{}, domain.ErrProposalNotFound, domain.ErrProposalStatusConflict,
+			domain.ErrInvalidProposalComment}, adminErrors...), apiErrors...),
+	})
+
+	// Stewards
+	spec.add(routeSpec{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/competencies/stewarded",
+		Tag:         "stewards",
+		Summary:     "List the competencies the user is a steward of",
+		Description: "Ordered by name.",
+		Status:      http.StatusOK,
+		Result:      dto.CompetenciesResponse{},
+		Auth:        true,
+		Localized:   true,
+		Errors:      append([]error{domain.ErrAuthenticationRequired}, apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/competencies/{id}/stewards",
+		Tag:         "stewards",
+		Summary:     "List the stewards of a competency",
+		Description: "First assigned first.",
+		Parameters:  []openapi.Parameter{idParameter("Competency ID")},
+		Status:      http.StatusOK,
+		Result:      dto.StewardsResponse{},
+		Auth:        true,
+		Errors:      append([]error{dto.ValidationError{}, domain.ErrCompetencyNotFound}, apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodPut,
+		Path:        "/api/v1/competencies/{id}/stewards/{user_id}",
+		Tag:         "stewards",
+		Summary:     "Make a user a steward of a competency",
+		Description: "Admins only. Stewards can edit the competency, its rubric and its translations, and are notified of edit proposals and reports. Assigning a steward twice changes nothing. Returns the stewards of the competency.",
+		Parameters:  []openapi.Parameter{idParameter("Competency ID"), userIDParameter()},
+		Status:      http.StatusOK,
+		Result:      dto.StewardsResponse{},
+		Auth:        true,
+		Errors:      append(append([]error{dto.ValidationError{}, domain.ErrCompetencyNotFound, domain.ErrUserNotFound}, adminErrors...), apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodDelete,
+		Path:        "/api/v1/competencies/{id}/stewards/{user_id}",
+		Tag:         "stewards",
+		Summary:     "Remove a steward of a competency",
+		Description: "Admins only.",
+		Parameters:  []openapi.Parameter{idParameter("Competency ID"), userIDParameter()},
+		Status:      http.StatusNoContent,
+		Auth:        true,
+		Errors:      append(append([]error{dto.ValidationError{}, domain.ErrStewardNotFound}, adminErrors...), apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodPost,
+		Path:        "/api/v1/competencies/{id}/reports",
+		Tag:         "stewards",
+		Summary:     "Report a competency as outdated",
+		Description: "The stewards of the competency are notified.",
+		Parameters:  []openapi.Parameter{idParameter("Competency ID")},
+		Body:        dto.ReportCompetencyRequest{},
+		Status:      http.StatusCreated,
+		Result:      dto.CompetencyReportDTO{},
+		Auth:        true,
+		Errors:      append([]error{dto.ValidationError{}, domain.ErrAuthenticationRequired, domain.ErrInvalidReport, domain.ErrCompetencyNotFound}, apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/competencies/{id}/reports",
+		Tag:         "stewards",
+		Summary:     "List the reports of a competency",
+		Description: "Admins and stewards of the competency only. Newest first.",
+		Parameters:  append([]openapi.Parameter{idParameter("Competency ID")}, spec.builder.QueryParameters(dto.CompetencyReportsQuery{})...),
+		Status:      http.StatusOK,
+		Result:      dto.CompetencyReportsResponse{},
+		Auth:        true,
+		Errors:      append(append([]error{dto.ValidationError{}}, adminErrors...), apiErrors...),
+	})
+
+	// Notifications
+	spec.add(routeSpec{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/notifications",
+		Tag:         "notifications",
+		Summary:     "List the notifications of the user",
+		Description: "Newest first; only unread ones with unread=true.",
+		Parameters:  spec.builder.QueryParameters(dto.NotificationsQuery{}),
+		Status:      http.StatusOK,
+		Result:      dto.NotificationsResponse{},
+		Auth:        true,
+		Errors:      append([]error{dto.ValidationError{}, domain.ErrAuthenticationRequired}, apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodPost,
+		Path:        "/api/v1/notifications/{id}/read",
+		Tag:         "notifications",
+		Summary:     "Mark a notification read",
+		Description: "Marking a notification read twice keeps the first time.",
+		Parameters:  []openapi.Parameter{idParameter("Notification ID")},
+		Status:      http.StatusOK,
+		Result:      dto.NotificationDTO{},
+		Auth:        true,
+		Errors:      append([]error{dto.ValidationError{}, domain.ErrAuthenticationRequired, domain.ErrNotificationNotFound}, apiErrors...),
+	})
+
+	// Framework versions
+	spec.add(routeSpec{
+		Method:      http.MethodPost,
+		Path:        "/api/v1/framework/versions",
+		Tag:         "frameworks",
+		Summary:     "Publish the framework as a version",
+		Description: "Admins only. Snapshots the categories, the non-archived competencies and their rubrics as an immutable version; editing continues on the draft, which becomes the next version when published.",
+		Body:        dto.PublishFrameworkRequest{},
+		Status:      http.StatusCreated,
+		Result:      dto.FrameworkVersionDTO{},
+		Auth:        true,
+		Errors:      append(append([]error{dto.ValidationError{}, domain.ErrInvalidFrameworkVersion, domain.ErrFrameworkVersionAlreadyExists}, adminErrors...), apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/framework/versions",
+		Tag:         "frameworks",
+		Summary:     "List the published framework versions",
+		Description: "Latest first, without their content.",
+		Status:      http.StatusOK,
+		Result:      dto.FrameworkVersionsResponse{},
+		Auth:        true,
+		Errors:      apiErrors,
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/framework/versions/{name}",
+		Tag:         "frameworks",
+		Summary:     "Get a framework version",
+		Description: "Categories and competencies by ID, in the fallback locale. \"draft\" is the current, unpublished framework.",
+		Parameters:  []openapi.Parameter{versionNameParameter()},
+		Status:      http.StatusOK,
+		Result:      dto.FrameworkVersionResponse{},
+		Auth:        true,
+		Errors:      append([]error{domain.ErrFrameworkVersionNotFound}, apiErrors...),
+	})
+	spec.add(routeSpec{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/framework/diff",
+		Tag:         "frameworks",
+		Summary:     "Compare two framework versions",
+		Description: "Categories and competencies added, removed or changed from one version to the other; to defaults to the draft.",
+		Parameters:  spec.builder.QueryParameters(dto.FrameworkDiffQuery{}),
+		Status:      http.StatusOK,
+		Result:      dto.FrameworkDiffResponse{},
+		Auth:        true,
+		Errors:      append([]error{dto.ValidationError{}, domain.ErrFrameworkVersionNotFound}, apiErrors...),
+	})
+
+	return json.Marshal(spec.document())
+}
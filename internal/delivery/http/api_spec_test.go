@@ -0,0 +1,108 @@
+package http
+
+import (
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/openapi"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+	"github.com/mehrnoosh-hk/devnorth-back/pkg/ratelimit"
+)
+
+// Stubs satisfying the router dependencies; the routes are only walked, never called
+type (
+	stubUserUseCase         struct{ domain.UserUseCase }
+	stubCompetencyUseCase   struct{ domain.CompetencyUseCase }
+	stubCategoryUseCase     struct{ domain.CategoryUseCase }
+	stubRubricUseCase       struct{ domain.RubricUseCase }
+	stubTagUseCase          struct{ domain.TagUseCase }
+	stubRelationUseCase     struct{ domain.RelationUseCase }
+	stubTranslationUseCase  struct{ domain.TranslationUseCase }
+	stubMergeUseCase        struct{ domain.MergeUseCase }
+	stubProposalUseCase     struct{ domain.ProposalUseCase }
+	stubStewardUseCase      struct{ domain.StewardUseCase }
+	stubNotificationUseCase struct{ domain.NotificationUseCase }
+	stubFrameworkUseCase    struct{ domain.FrameworkUseCase }
+	stubTokenGenerator      struct{ domain.TokenGenerator }
+)
+
+// TestAPISpecCoversRoutes checks that every route of NewRouter is documented in /api/openapi.json
+// and that the document has no operation the router doesn't serve
+func TestAPISpecCoversRoutes(t *testing.T) {
+	limiter, err := ratelimit.NewLimiter(ratelimit.NewMemoryStore())
+	if err != nil {
+		t.Fatalf("NewLimiter: %v", err)
+	}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	router, err := NewRouter(UseCases{
+		User:         stubUserUseCase{},
+		Competency:   stubCompetencyUseCase{},
+		Category:     stubCategoryUseCase{},
+		Rubric:       stubRubricUseCase{},
+		Tag:          stubTagUseCase{},
+		Relation:     stubRelationUseCase{},
+		Translation:  stubTranslationUseCase{},
+		Merge:        stubMergeUseCase{},
+		Proposal:     stubProposalUseCase{},
+		Steward:      stubStewardUseCase{},
+		Notification: stubNotificationUseCase{},
+		Framework:    stubFrameworkUseCase{},
+	}, stubTokenGenerator{}, limiter, logger, RouterConfig{MaxBodyBytes: 1 << 20, MaxImportBytes: 10 << 20})
+	if err != nil {
+		t.Fatalf("NewRouter: %v", err)
+	}
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/openapi.json", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GET /api/openapi.json: status %d", rec.Code)
+	}
+	var doc openapi.Document
+	if err := json.Unmarshal(rec.Body.Bytes(), &doc); err != nil {
+		t.Fatalf("decode spec: %v", err)
+	}
+	if doc.OpenAPI != openapi.Version {
+		t.Errorf("openapi version = %q, want %q", doc.OpenAPI, openapi.Version)
+	}
+
+	documented := make(map[string]bool)
+	for path, item := range doc.Paths {
+		for method, op := range *item {
+			documented[strings.ToUpper(method)+" "+path] = true
+			if len(op.Responses) == 0 {
+				t.Errorf("%s %s: no responses documented", strings.ToUpper(method), path)
+			}
+		}
+	}
+
+	routed := make(map[string]bool)
+	err = chi.Walk(router, func(method, route string, _ http.Handler, _ ...func(http.Handler) http.Handler) error {
+		// Index routes of subrouters are registered as "/prefix/"
+		if route != "/" {
+			route = strings.TrimSuffix(route, "/")
+		}
+		routed[method+" "+route] = true
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("walk routes: %v", err)
+	}
+
+	for route := range routed {
+		if !documented[route] {
+			t.Errorf("route %s is missing from the OpenAPI document (see buildAPISpec)", route)
+		}
+	}
+	for route := range documented {
+		if !routed[route] {
+			t.Errorf("OpenAPI document has %s, which the router doesn't serve", route)
+		}
+	}
+}
@@ -1,15 +1,17 @@
 package dto
 
 // RegisterRequest represents the registration request payload
+// Password strength is checked by the use case
 type RegisterRequest struct {
-	Email    string `json:"email"`
-	Password string `json:"password"`
+	Email    string `json:"email" validate:"required,email,max=254"`
+	Password string `json:"password" validate:"required"`
 }
 
 // LoginRequest represents the login request payload
+// The email format is not checked, so malformed emails get the same response as wrong credentials
 type LoginRequest struct {
-	Email    string `json:"email"`
-	Password string `json:"password"`
+	Email    string `json:"email" validate:"required,max=254"`
+	Password string `json:"password" validate:"required"`
 }
 
 // AuthResponse represents a successful authentication response
@@ -19,27 +21,6 @@ type AuthResponse struct {
 	Message string  `json:"message,omitempty"`
 }
 
-// validateAuthRequest is a shared helper for validating email and password
-func validateAuthRequest(email, password string) error {
-	if email == "" {
-		return ErrFieldRequired("email")
-	}
-	if password == "" {
-		return ErrFieldRequired("password")
-	}
-	return nil
-}
-
-// Validate performs basic validation on RegisterRequest
-func (r *RegisterRequest) Validate() error {
-	return validateAuthRequest(r.Email, r.Password)
-}
-
-// Validate performs basic validation on LoginRequest
-func (r *LoginRequest) Validate() error {
-	return validateAuthRequest(r.Email, r.Password)
-}
-
 // Implement JSONSerializable for all auth DTOs
 func (RegisterRequest) isJSONSerializable() {}
 func (LoginRequest) isJSONSerializable()    {}
@@ -0,0 +1,74 @@
+package dto
+
+import "time"
+
+// CreateCategoryRequest represents the request to create a category
+// A category without parent_id is a root category; it is placed after its siblings
+type CreateCategoryRequest struct {
+	ParentID *int32 `json:"parent_id,omitempty"`
+	Name     string `json:"name" validate:"required,min=2,max=100"`
+}
+
+// RenameCategoryRequest represents the request to rename a category
+type RenameCategoryRequest struct {
+	Name string `json:"name" validate:"required,min=2,max=100"`
+}
+
+// MoveCategoryRequest represents the request to move a category
+// A null parent_id moves it to the root; without position it is placed after its new siblings
+type MoveCategoryRequest struct {
+	ParentID *int32 `json:"parent_id"`
+	Position *int32 `json:"position,omitempty"`
+}
+
+// ReorderCategoriesRequest represents the request to order the children of a category (root categories without parent_id)
+// IDs must list every child exactly once, in the new order
+type ReorderCategoriesRequest struct {
+	ParentID *int32  `json:"parent_id,omitempty"`
+	IDs      []int32 `json:"ids"`
+}
+
+// SetCompetencyCategoryRequest represents the request to place a competency under a category
+// A null category_id makes the competency uncategorized
+type SetCompetencyCategoryRequest struct {
+	CategoryID *int32 `json:"category_id"`
+}
+
+// CategoryDTO represents category data in API responses
+type CategoryDTO struct {
+	ID        int32     `json:"id"`
+	ParentID  *int32    `json:"parent_id"`
+	Name      string    `json:"name"`
+	Position  int32     `json:"position"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// CategoryNodeDTO represents a category with its descendants in tree responses
+// CompetencyCount counts the competencies placed directly under the category
+type CategoryNodeDTO struct {
+	CategoryDTO
+	CompetencyCount int64             `json:"competency_count"`
+	Children        []CategoryNodeDTO `json:"children"`
+}
+
+// CategoryTreeResponse represents the whole taxonomy: the root categories with their descendants
+type CategoryTreeResponse struct {
+	Categories []CategoryNodeDTO `json:"categories"`
+}
+
+// CategoriesResponse represents a list of sibling categories in order
+type CategoriesResponse struct {
+	Categories []CategoryDTO `json:"categories"`
+}
+
+// Implement JSONSerializable for all category DTOs
+func (CreateCategoryRequest) isJSONSerializable()        {}
+func (RenameCategoryRequest) isJSONSerializable()        {}
+func (MoveCategoryRequest) isJSONSerializable()          {}
+func (ReorderCategoriesRequest) isJSONSerializable()     {}
+func (SetCompetencyCategoryRequest) isJSONSerializable() {}
+func (CategoryDTO) isJSONSerializable()                  {}
+func (CategoryNodeDTO) isJSONSerializable()              {}
+func (CategoryTreeResponse) isJSONSerializable()         {}
+func (CategoriesResponse) isJSONSerializable()           {}
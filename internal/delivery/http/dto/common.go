@@ -2,6 +2,7 @@ package dto
 
 import (
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -21,18 +22,35 @@ func (e ValidationError) Error() string {
 	return fmt.Sprintf("%s: %s", e.Field, e.Message)
 }
 
-// ErrFieldRequired creates a validation error for required fields
-func ErrFieldRequired(field string) error {
-	return ValidationError{
-		Field:   field,
-		Message: "field is required",
+// ValidationErrors collects the validation errors of several fields, so they can be reported at once
+type ValidationErrors []ValidationError
+
+func (e ValidationErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
 	}
+	return strings.Join(messages, "; ")
+}
+
+// FieldProblem describes one invalid field of a request in ProblemDetails
+type FieldProblem struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
 }
 
-// ErrorResponse represents an error response
-type ErrorResponse struct {
-	Error   string `json:"error"`
-	Message string `json:"message,omitempty"`
+// ProblemDetails represents an RFC 7807 error response (application/problem+json)
+// Error and Message keep the error code and message of the previous error format for existing clients
+type ProblemDetails struct {
+	Type      string         `json:"type"`
+	Title     string         `json:"title"`
+	Status    int            `json:"status"`
+	Detail    string         `json:"detail,omitempty"`
+	Instance  string         `json:"instance,omitempty"`
+	Errors    []FieldProblem `json:"errors,omitempty"`
+	Error     string         `json:"error"`
+	Message   string         `json:"message,omitempty"` // Same as Detail
+	RequestID string         `json:"request_id,omitempty"`
 }
 
 // HealthResponse represents health check response
@@ -43,5 +61,5 @@ type HealthResponse struct {
 
 // Implement JSONSerializable for all response types
 func (ValidationError) isJSONSerializable()  {}
-func (ErrorResponse) isJSONSerializable()    {}
+func (ProblemDetails) isJSONSerializable()   {}
 func (HealthResponse) isJSONSerializable()   {}
@@ -4,46 +4,207 @@ import "time"
 
 // CreateCompetencyRequest represents the request to create a new competency
 type CreateCompetencyRequest struct {
-	Name        string `json:"name"`
+	Name        string `json:"name" validate:"required,min=2,max=100"`
 	Description string `json:"description,omitempty"`
 }
 
+// RenameCompetencyRequest represents the request to rename a competency
+type RenameCompetencyRequest struct {
+	Name string `json:"name" validate:"required,min=2,max=100"`
+}
+
 // UpdateCompetencyDescriptionRequest represents the request to update a competency's description
+// Description can be empty (to clear it)
 type UpdateCompetencyDescriptionRequest struct {
 	Description string `json:"description"`
 }
 
 // CompetencyDTO represents competency data in API responses
 type CompetencyDTO struct {
-	ID          int32     `json:"id"`
-	Name        string    `json:"name"`
-	Description string    `json:"description,omitempty"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID          int32      `json:"id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	Version     int32      `json:"version"`
+	ArchivedAt  *time.Time `json:"archived_at,omitempty"`
+	CategoryID  *int32     `json:"category_id,omitempty"`
+	Locale      string     `json:"locale,omitempty"` // Locale of name, when read localized: the requested one if translated, else the fallback one
+
+	// Rubric is only set when requested with include=levels, and null if the competency has none
+	Rubric *CompetencyRubricDTO `json:"rubric,omitempty"`
+}
+
+// GetCompetencyQuery represents the query parameters of a competency read
+type GetCompetencyQuery struct {
+	Include string `query:"include" validate:"oneof=levels"`
 }
 
-// CompetenciesResponse represents a list of competencies in API responses
+// CreateCompetenciesBatchRequest represents the request to create competencies in bulk
+type CreateCompetenciesBatchRequest struct {
+	Items []CompetencyBatchItem `json:"items" validate:"required,max=500"`
+}
+
+// CompetencyBatchItem represents one competency of a batch
+// Items aren't validated with the request, so a best-effort batch can report invalid ones and create the others
+type CompetencyBatchItem struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// CreateCompetenciesBatchQuery represents the query parameters of the bulk creation
+// Mode defaults to all_or_nothing
+type CreateCompetenciesBatchQuery struct {
+	Mode string `query:"mode" validate:"oneof=all_or_nothing best_effort"`
+}
+
+// CompetencyBatchResponse represents the outcome of a bulk creation, with one result per item in request order
+type CompetencyBatchResponse struct {
+	Mode    string                     `json:"mode"`
+	Created int                        `json:"created"`
+	Failed  int                        `json:"failed"`
+	Results []CompetencyBatchResultDTO `json:"results"`
+}
+
+// CompetencyBatchResultDTO represents the outcome of one item of a batch
+// Status is created, duplicate or invalid; Reason explains why a duplicate or invalid item wasn't created
+type CompetencyBatchResultDTO struct {
+	Index      int            `json:"index"`
+	Status     string         `json:"status"`
+	Competency *CompetencyDTO `json:"competency,omitempty"`
+	Reason     string         `json:"reason,omitempty"`
+}
+
+// ListCompetenciesQuery represents the query parameters of the competency listing
+// Sort defaults to created_at; direction defaults to desc for time sorts and asc for name
+// Archived competencies are left out unless include_archived is true
+// category_id keeps the competencies of that category and of its descendants
+// tag (repeatable) keeps the competencies carrying any of the tags, or all of them with tag_match=all
+type ListCompetenciesQuery struct {
+	Limit           *int32     `query:"limit" validate:"min=1,max=100"`
+	Cursor          string     `query:"cursor"`
+	Sort            string     `query:"sort" validate:"oneof=name created_at updated_at"`
+	Direction       string     `query:"direction" validate:"oneof=asc desc"`
+	NamePrefix      string     `query:"name_prefix" validate:"max=100"`
+	HasDescription  *bool      `query:"has_description"`
+	CreatedAfter    *time.Time `query:"created_after"`
+	IncludeArchived bool       `query:"include_archived"`
+	CategoryID      *int32     `query:"category_id"`
+	Tags            []string   `query:"tag" validate:"max=20"`
+	TagMatch        string     `query:"tag_match" validate:"oneof=any all"`
+}
+
+// CompetenciesResponse represents a page of competencies in API responses
+// Count is the number of competencies in this page, Total the number matching the filters
+// NextCursor is omitted on the last page
 type CompetenciesResponse struct {
 	Competencies []CompetencyDTO `json:"competencies"`
 	Count        int             `json:"count"`
+	Total        int64           `json:"total"`
+	NextCursor   string          `json:"next_cursor,omitempty"`
+}
+
+// SearchCompetenciesQuery represents the query parameters of the competency search
+// Q uses web search syntax: "quoted phrases", OR, and -excluded words
+type SearchCompetenciesQuery struct {
+	Q     string `query:"q" validate:"required,max=200"`
+	Limit *int32 `query:"limit" validate:"min=1,max=100"`
+}
+
+// SuggestCompetenciesQuery represents the query parameters of the competency typeahead
+type SuggestCompetenciesQuery struct {
+	Prefix string `query:"prefix" validate:"required,max=100"`
+	Limit  *int32 `query:"limit" validate:"min=1,max=20"`
+}
+
+// CompetencySearchResultDTO represents a search hit in API responses
+// Highlights are HTML-escaped text with the matched terms wrapped in <mark> elements
+type CompetencySearchResultDTO struct {
+	Competency CompetencyDTO          `json:"competency"`
+	Rank       float32                `json:"rank"`
+	Highlight  CompetencyHighlightDTO `json:"highlight"`
+}
+
+// CompetencyHighlightDTO represents the highlighted name and description fragments of a search hit
+type CompetencyHighlightDTO struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// CompetencySearchResponse represents search results in API responses, most relevant first
+type CompetencySearchResponse struct {
+	Results []CompetencySearchResultDTO `json:"results"`
+	Count   int                         `json:"count"`
+}
+
+// CompetencySuggestionDTO represents a typeahead suggestion in API responses
+type CompetencySuggestionDTO struct {
+	ID   int32  `json:"id"`
+	Name string `json:"name"`
+}
+
+// CompetencySuggestionsResponse represents typeahead suggestions in API responses
+type CompetencySuggestionsResponse struct {
+	Suggestions []CompetencySuggestionDTO `json:"suggestions"`
+}
+
+// CompetencyRecord represents one competency of an exported or imported catalogue
+// The timestamps are exported for reference and ignored on import
+type CompetencyRecord struct {
+	Name        string     `json:"name" yaml:"name"`
+	Description string     `json:"description,omitempty" yaml:"description,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty" yaml:"created_at,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty" yaml:"updated_at,omitempty"`
+}
+
+// ExportCompetenciesQuery represents the query parameters of the catalogue export
+// Format defaults to csv
+type ExportCompetenciesQuery struct {
+	Format string `query:"format" validate:"oneof=csv json yaml"`
+}
+
+// ImportCompetenciesQuery represents the query parameters of the catalogue import
+// Format defaults to the one of the Content-Type; OnExisting defaults to skip
+type ImportCompetenciesQuery struct {
+	Format     string `query:"format" validate:"oneof=csv json yaml"`
+	OnExisting string `query:"on_existing" validate:"oneof=skip update fail"`
+	DryRun     bool   `query:"dry_run"`
 }
 
-// Validate performs basic validation on CreateCompetencyRequest
-func (r *CreateCompetencyRequest) Validate() error {
-	if r.Name == "" {
-		return ErrFieldRequired("name")
-	}
-	return nil
+// CompetencyImportResponse represents the outcome of an import, with one result per record in file order
+// A dry run reports what the import would do; Applied tells whether the changes were committed
+type CompetencyImportResponse struct {
+	DryRun     bool                        `json:"dry_run"`
+	Applied    bool                        `json:"applied"`
+	Rejected   bool                        `json:"rejected"`
+	OnExisting string                      `json:"on_existing"`
+	Created    int                         `json:"created"`
+	Updated    int                         `json:"updated"`
+	Unchanged  int                         `json:"unchanged"`
+	Skipped    int                         `json:"skipped"`
+	Conflicts  int                         `json:"conflicts"`
+	Invalid    int                         `json:"invalid"`
+	Results    []CompetencyImportResultDTO `json:"results"`
 }
 
-// Validate performs basic validation on UpdateCompetencyDescriptionRequest
-// Description can be empty (to clear it), so no validation needed
-func (r *UpdateCompetencyDescriptionRequest) Validate() error {
-	return nil
+// CompetencyImportResultDTO represents the outcome of one record of an import
+// Action is create, update, unchanged, skip, conflict or invalid; Reason explains conflicts and invalid records
+type CompetencyImportResultDTO struct {
+	Index      int            `json:"index"`
+	Name       string         `json:"name"`
+	Action     string         `json:"action"`
+	Competency *CompetencyDTO `json:"competency,omitempty"`
+	Reason     string         `json:"reason,omitempty"`
 }
 
 // Implement JSONSerializable for all competency DTOs
-func (CreateCompetencyRequest) isJSONSerializable()              {}
-func (UpdateCompetencyDescriptionRequest) isJSONSerializable()   {}
-func (CompetencyDTO) isJSONSerializable()                        {}
-func (CompetenciesResponse) isJSONSerializable()                 {}
+func (CreateCompetencyRequest) isJSONSerializable()            {}
+func (UpdateCompetencyDescriptionRequest) isJSONSerializable() {}
+func (RenameCompetencyRequest) isJSONSerializable()            {}
+func (CompetencyDTO) isJSONSerializable()                      {}
+func (CompetenciesResponse) isJSONSerializable()               {}
+func (CreateCompetenciesBatchRequest) isJSONSerializable()     {}
+func (CompetencyBatchResponse) isJSONSerializable()            {}
+func (CompetencySearchResponse) isJSONSerializable()           {}
+func (CompetencySuggestionsResponse) isJSONSerializable()      {}
+func (CompetencyImportResponse) isJSONSerializable()           {}
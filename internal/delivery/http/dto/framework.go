@@ -0,0 +1,99 @@
+package dto
+
+import "time"
+
+// PublishFrameworkRequest represents the request to publish the current framework as a version
+type PublishFrameworkRequest struct {
+	Name  string `json:"name" validate:"required,max=32"`
+	Notes string `json:"notes,omitempty" validate:"max=2000"`
+}
+
+// FrameworkDiffQuery represents the query parameters of a comparison of two framework versions
+// to defaults to the draft
+type FrameworkDiffQuery struct {
+	From string `query:"from" validate:"required,max=32"`
+	To   string `query:"to" validate:"max=32"`
+}
+
+// FrameworkVersionDTO represents a published framework version, or the draft
+// id, published_by and published_at are null for the draft; published_by is null once the admin is deleted
+type FrameworkVersionDTO struct {
+	ID              *int32     `json:"id"`
+	Name            string     `json:"name"`
+	Notes           string     `json:"notes"`
+	CompetencyCount int32      `json:"competency_count"`
+	PublishedBy     *int32     `json:"published_by"`
+	PublishedAt     *time.Time `json:"published_at"`
+}
+
+// FrameworkVersionsResponse represents the published framework versions
+type FrameworkVersionsResponse struct {
+	Versions []FrameworkVersionDTO `json:"versions"`
+}
+
+// FrameworkCategoryDTO represents a category of a framework version
+type FrameworkCategoryDTO struct {
+	ID       int32  `json:"id"`
+	ParentID *int32 `json:"parent_id"`
+	Name     string `json:"name"`
+	Position int32  `json:"position"`
+}
+
+// FrameworkLevelDTO represents a level of the rubric of a framework competency
+type FrameworkLevelDTO struct {
+	Value       int32    `json:"value"`
+	Label       string   `json:"label"`
+	Description string   `json:"description"`
+	Indicators  []string `json:"indicators"`
+}
+
+// FrameworkRubricDTO represents the rubric of a framework competency, with every level of its scale
+type FrameworkRubricDTO struct {
+	ScaleID   int32               `json:"scale_id"`
+	ScaleName string              `json:"scale_name"`
+	Levels    []FrameworkLevelDTO `json:"levels"`
+}
+
+// FrameworkCompetencyDTO represents a competency of a framework version
+type FrameworkCompetencyDTO struct {
+	ID          int32               `json:"id"`
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	CategoryID  *int32              `json:"category_id"`
+	Rubric      *FrameworkRubricDTO `json:"rubric"`
+}
+
+// FrameworkVersionResponse represents a framework version with its content
+type FrameworkVersionResponse struct {
+	Version      FrameworkVersionDTO      `json:"version"`
+	Categories   []FrameworkCategoryDTO   `json:"categories"`
+	Competencies []FrameworkCompetencyDTO `json:"competencies"`
+}
+
+// FrameworkChangeDTO represents a category or competency added, removed or changed between two versions
+type FrameworkChangeDTO struct {
+	Change string           `json:"change"`
+	ID     int32            `json:"id"`
+	Name   string           `json:"name"`
+	Fields []FieldChangeDTO `json:"fields,omitempty"`
+}
+
+// FrameworkDiffResponse represents the changes from one framework version to another
+type FrameworkDiffResponse struct {
+	From         string               `json:"from"`
+	To           string               `json:"to"`
+	Categories   []FrameworkChangeDTO `json:"categories"`
+	Competencies []FrameworkChangeDTO `json:"competencies"`
+}
+
+// Implement JSONSerializable for all framework DTOs
+func (PublishFrameworkRequest) isJSONSerializable()   {}
+func (FrameworkVersionDTO) isJSONSerializable()       {}
+func (FrameworkVersionsResponse) isJSONSerializable() {}
+func (FrameworkCategoryDTO) isJSONSerializable()      {}
+func (FrameworkLevelDTO) isJSONSerializable()         {}
+func (FrameworkRubricDTO) isJSONSerializable()        {}
+func (FrameworkCompetencyDTO) isJSONSerializable()    {}
+func (FrameworkVersionResponse) isJSONSerializable()  {}
+func (FrameworkChangeDTO) isJSONSerializable()        {}
+func (FrameworkDiffResponse) isJSONSerializable()     {}
@@ -0,0 +1,33 @@
+package dto
+
+// DuplicateCompetenciesQuery represents the query parameters of the duplicate report
+// threshold is the minimum score of the reported pairs (0.5 by default)
+type DuplicateCompetenciesQuery struct {
+	Threshold *float32 `query:"threshold" validate:"min=0.3,max=1"`
+	Limit     *int32   `query:"limit" validate:"min=1,max=200"`
+}
+
+// MergeCompetencyRequest represents the request to merge a competency into the one of the URL
+type MergeCompetencyRequest struct {
+	MergedID int32 `json:"merged_id" validate:"required,min=1"`
+}
+
+// DuplicateCandidateDTO represents a pair of competencies that may describe the same thing
+// Similarities are trigram similarities from 0 to 1; description_similarity is 0 when either has no description
+type DuplicateCandidateDTO struct {
+	Competency            CompetencyNodeDTO `json:"competency"`
+	Other                 CompetencyNodeDTO `json:"other"`
+	NameSimilarity        float32           `json:"name_similarity"`
+	DescriptionSimilarity float32           `json:"description_similarity"`
+	Score                 float32           `json:"score"`
+}
+
+// DuplicateCompetenciesResponse represents the duplicate report, highest score first
+type DuplicateCompetenciesResponse struct {
+	Duplicates []DuplicateCandidateDTO `json:"duplicates"`
+}
+
+// Implement JSONSerializable for all merge DTOs
+func (MergeCompetencyRequest) isJSONSerializable()        {}
+func (DuplicateCandidateDTO) isJSONSerializable()         {}
+func (DuplicateCompetenciesResponse) isJSONSerializable() {}
@@ -0,0 +1,85 @@
+package dto
+
+import "time"
+
+// CreateProposalRequest represents the request to propose a competency, or an edit of the one of competency_id
+// A new competency needs a name; an edit changes the fields that are set
+type CreateProposalRequest struct {
+	CompetencyID *int32  `json:"competency_id,omitempty" validate:"min=1"`
+	Name         *string `json:"name,omitempty" validate:"max=100"`
+	Description  *string `json:"description,omitempty"`
+	CategoryID   *int32  `json:"category_id,omitempty" validate:"min=1"`
+	Rationale    string  `json:"rationale,omitempty" validate:"max=2000"`
+}
+
+// UpdateProposalRequest represents the request to replace the change of a draft; its competency can't change
+type UpdateProposalRequest struct {
+	Name        *string `json:"name,omitempty" validate:"max=100"`
+	Description *string `json:"description,omitempty"`
+	CategoryID  *int32  `json:"category_id,omitempty" validate:"min=1"`
+	Rationale   string  `json:"rationale,omitempty" validate:"max=2000"`
+}
+
+// ProposalCommentRequest represents the request to comment a proposal
+type ProposalCommentRequest struct {
+	Body string `json:"body" validate:"required,max=2000"`
+}
+
+// ReviewProposalRequest represents the request to approve or reject a proposal
+// The comment is required to reject a proposal
+type ReviewProposalRequest struct {
+	Comment string `json:"comment,omitempty" validate:"max=2000"`
+}
+
+// ListProposalsQuery represents the query parameters of the proposals of the user
+type ListProposalsQuery struct {
+	Status string `query:"status" validate:"oneof=draft submitted approved rejected"`
+}
+
+// ProposalQueueQuery represents the query parameters of the review queue
+type ProposalQueueQuery struct {
+	Limit *int32 `query:"limit" validate:"min=1,max=200"`
+}
+
+// ProposalCommentDTO represents a comment of the proposer or a reviewer
+// AuthorID is null once the author is deleted
+type ProposalCommentDTO struct {
+	ID        int32     `json:"id"`
+	AuthorID  *int32    `json:"author_id"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ProposalDTO represents a proposed change to the catalogue
+// competency_id of an approved creation is the competency it created; comments are omitted in listings
+type ProposalDTO struct {
+	ID           int32                `json:"id"`
+	Kind         string               `json:"kind"`
+	Status       string               `json:"status"`
+	ProposerID   int32                `json:"proposer_id"`
+	CompetencyID *int32               `json:"competency_id"`
+	Name         *string              `json:"name,omitempty"`
+	Description  *string              `json:"description,omitempty"`
+	CategoryID   *int32               `json:"category_id,omitempty"`
+	Rationale    string               `json:"rationale"`
+	ReviewerID   *int32               `json:"reviewer_id,omitempty"`
+	Comments     []ProposalCommentDTO `json:"comments,omitempty"`
+	SubmittedAt  *time.Time           `json:"submitted_at,omitempty"`
+	ReviewedAt   *time.Time           `json:"reviewed_at,omitempty"`
+	CreatedAt    time.Time            `json:"created_at"`
+	UpdatedAt    time.Time            `json:"updated_at"`
+}
+
+// ProposalsResponse represents a list of proposals
+type ProposalsResponse struct {
+	Proposals []ProposalDTO `json:"proposals"`
+}
+
+// Implement JSONSerializable for all proposal DTOs
+func (CreateProposalRequest) isJSONSerializable()  {}
+func (UpdateProposalRequest) isJSONSerializable()  {}
+func (ProposalCommentRequest) isJSONSerializable() {}
+func (ReviewProposalRequest) isJSONSerializable()  {}
+func (ProposalCommentDTO) isJSONSerializable()     {}
+func (ProposalDTO) isJSONSerializable()            {}
+func (ProposalsResponse) isJSONSerializable()      {}
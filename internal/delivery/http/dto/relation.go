@@ -0,0 +1,59 @@
+package dto
+
+// LearningOrderQuery represents the query parameters of a learning order
+// target is repeatable: ?target=1&target=2
+type LearningOrderQuery struct {
+	Target []int32 `query:"target" validate:"required,max=50"`
+}
+
+// CompetencyGraphQuery represents the query parameters of the prerequisite graph export
+type CompetencyGraphQuery struct {
+	Format string `query:"format" validate:"oneof=dot mermaid"`
+}
+
+// CompetencyNodeDTO represents a competency linked to another one
+type CompetencyNodeDTO struct {
+	ID   int32  `json:"id"`
+	Name string `json:"name"`
+}
+
+// CompetencyRelationsResponse represents the competencies directly linked to a competency, ordered by name
+type CompetencyRelationsResponse struct {
+	Requires   []CompetencyNodeDTO `json:"requires"`
+	RequiredBy []CompetencyNodeDTO `json:"required_by"`
+	Related    []CompetencyNodeDTO `json:"related"`
+}
+
+// PrerequisiteDTO represents a competency required by another one
+// Depth is the fewest "requires" steps to reach it: 1 for direct prerequisites
+type PrerequisiteDTO struct {
+	ID    int32  `json:"id"`
+	Name  string `json:"name"`
+	Depth int32  `json:"depth"`
+}
+
+// PrerequisitesResponse represents the transitive prerequisites of a competency, nearest first
+type PrerequisitesResponse struct {
+	Prerequisites []PrerequisiteDTO `json:"prerequisites"`
+}
+
+// LearningStepDTO represents a competency of a learning order
+// Target tells whether it was asked for, rather than only required by a target
+type LearningStepDTO struct {
+	ID     int32  `json:"id"`
+	Name   string `json:"name"`
+	Target bool   `json:"target"`
+}
+
+// LearningOrderResponse represents competencies ordered so every one comes after its prerequisites
+type LearningOrderResponse struct {
+	Steps []LearningStepDTO `json:"steps"`
+}
+
+// Implement JSONSerializable for all relation DTOs
+func (CompetencyNodeDTO) isJSONSerializable()           {}
+func (CompetencyRelationsResponse) isJSONSerializable() {}
+func (PrerequisiteDTO) isJSONSerializable()             {}
+func (PrerequisitesResponse) isJSONSerializable()       {}
+func (LearningStepDTO) isJSONSerializable()             {}
+func (LearningOrderResponse) isJSONSerializable()       {}
@@ -0,0 +1,65 @@
+package dto
+
+import "time"
+
+// CompetencyHistoryQuery represents the query parameters of a competency history
+// before pages through the history: only revisions older than it are returned
+type CompetencyHistoryQuery struct {
+	Before *int32 `query:"before" validate:"min=1"`
+	Limit  *int32 `query:"limit" validate:"min=1,max=200"`
+}
+
+// CompetencyDiffQuery represents the query parameters of a comparison of two competency revisions
+type CompetencyDiffQuery struct {
+	From *int32 `query:"from" validate:"required,min=1"`
+	To   *int32 `query:"to" validate:"required,min=1"`
+}
+
+// CompetencySnapshotDTO represents the state of a competency recorded by a revision
+type CompetencySnapshotDTO struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	CategoryID  *int32 `json:"category_id"`
+	Archived    bool   `json:"archived"`
+}
+
+// CompetencyRevisionDTO represents one change in the history of a competency
+// Before is null for the first revision; EditorID is null for anonymous changes
+type CompetencyRevisionDTO struct {
+	Revision     int32                  `json:"revision"`
+	Action       string                 `json:"action"`
+	EditorID     *int32                 `json:"editor_id"`
+	RevertedFrom *int32                 `json:"reverted_from,omitempty"`
+	MergedID     *int32                 `json:"merged_id,omitempty"`
+	Before       *CompetencySnapshotDTO `json:"before"`
+	After        CompetencySnapshotDTO  `json:"after"`
+	CreatedAt    time.Time              `json:"created_at"`
+}
+
+// CompetencyHistoryResponse represents a page of the history of a competency, newest first
+// NextBefore is the before parameter of the next page, omitted on the last one
+type CompetencyHistoryResponse struct {
+	Revisions  []CompetencyRevisionDTO `json:"revisions"`
+	NextBefore *int32                  `json:"next_before,omitempty"`
+}
+
+// FieldChangeDTO represents a field whose value differs between two revisions
+type FieldChangeDTO struct {
+	Field string `json:"field"`
+	From  any    `json:"from"`
+	To    any    `json:"to"`
+}
+
+// CompetencyDiffResponse represents the changes from one revision of a competency to another
+type CompetencyDiffResponse struct {
+	From    CompetencyRevisionDTO `json:"from"`
+	To      CompetencyRevisionDTO `json:"to"`
+	Changes []FieldChangeDTO      `json:"changes"`
+}
+
+// Implement JSONSerializable for all revision DTOs
+func (CompetencySnapshotDTO) isJSONSerializable()     {}
+func (CompetencyRevisionDTO) isJSONSerializable()     {}
+func (CompetencyHistoryResponse) isJSONSerializable() {}
+func (FieldChangeDTO) isJSONSerializable()            {}
+func (CompetencyDiffResponse) isJSONSerializable()    {}
@@ -0,0 +1,73 @@
+package dto
+
+import "time"
+
+// RatingScaleRequest represents the request to create or replace a rating scale
+// Levels are ordered by value in responses
+type RatingScaleRequest struct {
+	Name        string           `json:"name" validate:"required,min=2,max=100"`
+	Description string           `json:"description,omitempty" validate:"max=1000"`
+	Levels      []RatingLevelDTO `json:"levels" validate:"required,max=10"`
+}
+
+// RatingLevelDTO represents a level of a rating scale
+type RatingLevelDTO struct {
+	Value int32  `json:"value"`
+	Label string `json:"label"`
+}
+
+// RatingScaleDTO represents rating scale data in API responses
+type RatingScaleDTO struct {
+	ID          int32            `json:"id"`
+	Name        string           `json:"name"`
+	Description string           `json:"description,omitempty"`
+	Levels      []RatingLevelDTO `json:"levels"`
+	CreatedAt   time.Time        `json:"created_at"`
+	UpdatedAt   time.Time        `json:"updated_at"`
+}
+
+// RatingScalesResponse represents the list of rating scales
+type RatingScalesResponse struct {
+	Scales []RatingScaleDTO `json:"scales"`
+}
+
+// SetRubricRequest represents the request to replace the rubric of a competency
+// Levels describe some levels of the scale; the others are left undescribed
+type SetRubricRequest struct {
+	ScaleID int32                    `json:"scale_id" validate:"required,min=1"`
+	Levels  []CompetencyLevelRequest `json:"levels,omitempty" validate:"max=10"`
+}
+
+// CompetencyLevelRequest represents the description of one level of a rubric
+// Value is taken from the URL when a single level is set
+type CompetencyLevelRequest struct {
+	Value       int32    `json:"value,omitempty"`
+	Description string   `json:"description,omitempty" validate:"max=2000"`
+	Indicators  []string `json:"indicators,omitempty" validate:"max=20"`
+}
+
+// CompetencyRubricDTO represents the rubric of a competency in API responses
+// Levels lists every level of the scale in order; undescribed ones have no description and no indicators
+type CompetencyRubricDTO struct {
+	Scale     RatingScaleDTO       `json:"scale"`
+	Levels    []CompetencyLevelDTO `json:"levels"`
+	UpdatedAt time.Time            `json:"updated_at"`
+}
+
+// CompetencyLevelDTO represents what a level of the scale means for a competency
+type CompetencyLevelDTO struct {
+	Value       int32    `json:"value"`
+	Label       string   `json:"label"`
+	Description string   `json:"description,omitempty"`
+	Indicators  []string `json:"indicators"`
+}
+
+// Implement JSONSerializable for all rubric DTOs
+func (RatingScaleRequest) isJSONSerializable()     {}
+func (RatingLevelDTO) isJSONSerializable()         {}
+func (RatingScaleDTO) isJSONSerializable()         {}
+func (RatingScalesResponse) isJSONSerializable()   {}
+func (SetRubricRequest) isJSONSerializable()       {}
+func (CompetencyLevelRequest) isJSONSerializable() {}
+func (CompetencyRubricDTO) isJSONSerializable()    {}
+func (CompetencyLevelDTO) isJSONSerializable()     {}
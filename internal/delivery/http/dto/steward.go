@@ -0,0 +1,74 @@
+package dto
+
+import "time"
+
+// ReportCompetencyRequest represents the request to report a competency as outdated
+type ReportCompetencyRequest struct {
+	Reason string `json:"reason" validate:"required,max=1000"`
+}
+
+// CompetencyReportsQuery represents the query parameters of the reports of a competency
+type CompetencyReportsQuery struct {
+	Limit *int32 `query:"limit" validate:"min=1,max=200"`
+}
+
+// NotificationsQuery represents the query parameters of the inbox of the user
+type NotificationsQuery struct {
+	Unread bool   `query:"unread"`
+	Limit  *int32 `query:"limit" validate:"min=1,max=200"`
+}
+
+// StewardDTO represents a steward of a competency
+// AssignedBy is null once the admin who assigned the steward is deleted
+type StewardDTO struct {
+	UserID     int32     `json:"user_id"`
+	AssignedBy *int32    `json:"assigned_by"`
+	AssignedAt time.Time `json:"assigned_at"`
+}
+
+// StewardsResponse represents the stewards of a competency
+type StewardsResponse struct {
+	CompetencyID int32        `json:"competency_id"`
+	Stewards     []StewardDTO `json:"stewards"`
+}
+
+// CompetencyReportDTO represents a report that a competency is outdated
+// ReporterID is null once the reporter is deleted
+type CompetencyReportDTO struct {
+	ID           int32     `json:"id"`
+	CompetencyID int32     `json:"competency_id"`
+	ReporterID   *int32    `json:"reporter_id"`
+	Reason       string    `json:"reason"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// CompetencyReportsResponse represents a list of reports of a competency
+type CompetencyReportsResponse struct {
+	Reports []CompetencyReportDTO `json:"reports"`
+}
+
+// NotificationDTO represents a message in the inbox of the user
+// proposal_id is set for proposal_submitted, report_id for stale_report
+type NotificationDTO struct {
+	ID           int32      `json:"id"`
+	Kind         string     `json:"kind"`
+	CompetencyID int32      `json:"competency_id"`
+	ProposalID   *int32     `json:"proposal_id,omitempty"`
+	ReportID     *int32     `json:"report_id,omitempty"`
+	ReadAt       *time.Time `json:"read_at"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+// NotificationsResponse represents a list of notifications
+type NotificationsResponse struct {
+	Notifications []NotificationDTO `json:"notifications"`
+}
+
+// Implement JSONSerializable for all steward DTOs
+func (ReportCompetencyRequest) isJSONSerializable()   {}
+func (StewardDTO) isJSONSerializable()                {}
+func (StewardsResponse) isJSONSerializable()          {}
+func (CompetencyReportDTO) isJSONSerializable()       {}
+func (CompetencyReportsResponse) isJSONSerializable() {}
+func (NotificationDTO) isJSONSerializable()           {}
+func (NotificationsResponse) isJSONSerializable()     {}
@@ -0,0 +1,52 @@
+package dto
+
+import "time"
+
+// CreateTagRequest represents the request to create a tag
+type CreateTagRequest struct {
+	Name    string `json:"name" validate:"required,max=50"`
+	Curated bool   `json:"curated,omitempty"`
+}
+
+// UpdateTagRequest represents the request to rename a tag or change whether it is curated
+// Omitted fields are left as they are
+type UpdateTagRequest struct {
+	Name    *string `json:"name,omitempty" validate:"max=50"`
+	Curated *bool   `json:"curated,omitempty"`
+}
+
+// TagCompetencyRequest represents the request to add tags to a competency by name
+// Unknown names are created as free-form tags
+type TagCompetencyRequest struct {
+	Tags []string `json:"tags" validate:"required,max=20"`
+}
+
+// ListTagsQuery represents the query parameters of the tag listing
+type ListTagsQuery struct {
+	NamePrefix string `query:"name_prefix" validate:"max=50"`
+	Curated    *bool  `query:"curated"`
+	Limit      *int32 `query:"limit" validate:"min=1,max=500"`
+}
+
+// TagDTO represents tag data in API responses
+// UsageCount counts the competencies carrying the tag, archived ones excluded
+type TagDTO struct {
+	ID         int32     `json:"id"`
+	Name       string    `json:"name"`
+	Curated    bool      `json:"curated"`
+	UsageCount int64     `json:"usage_count"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// TagsResponse represents a list of tags
+type TagsResponse struct {
+	Tags []TagDTO `json:"tags"`
+}
+
+// Implement JSONSerializable for all tag DTOs
+func (CreateTagRequest) isJSONSerializable()     {}
+func (UpdateTagRequest) isJSONSerializable()     {}
+func (TagCompetencyRequest) isJSONSerializable() {}
+func (TagDTO) isJSONSerializable()               {}
+func (TagsResponse) isJSONSerializable()         {}
@@ -0,0 +1,63 @@
+package dto
+
+import "time"
+
+// SetTranslationRequest represents the request to create or replace the translation of a competency into a locale
+// Levels translate described levels of the competency rubric; levels left out show their fallback text
+type SetTranslationRequest struct {
+	Name        string                   `json:"name" validate:"required,min=2,max=100"`
+	Description string                   `json:"description,omitempty"`
+	Levels      []CompetencyLevelRequest `json:"levels,omitempty" validate:"max=10"`
+}
+
+// CompetencyTranslationDTO represents the translation of a competency into a locale in API responses
+type CompetencyTranslationDTO struct {
+	Locale      string                `json:"locale"`
+	Name        string                `json:"name"`
+	Description string                `json:"description,omitempty"`
+	Levels      []LevelTranslationDTO `json:"levels"`
+	UpdatedAt   time.Time             `json:"updated_at"`
+}
+
+// LevelTranslationDTO represents the translation of one rubric level in API responses
+type LevelTranslationDTO struct {
+	Value       int32    `json:"value"`
+	Description string   `json:"description,omitempty"`
+	Indicators  []string `json:"indicators"`
+}
+
+// CompetencyTranslationsResponse represents the translations of a competency, ordered by locale
+type CompetencyTranslationsResponse struct {
+	FallbackLocale string                     `json:"fallback_locale"`
+	Translations   []CompetencyTranslationDTO `json:"translations"`
+}
+
+// MissingTranslationsQuery represents the query parameters of the missing translations report
+// Every locale but the fallback one is reported when locale is empty
+type MissingTranslationsQuery struct {
+	Locale string `query:"locale" validate:"oneof=de fa"`
+}
+
+// MissingTranslationDTO represents the texts of a competency without translation into a locale
+// Fields lists the untranslated fields among name and description; Levels the untranslated described levels
+type MissingTranslationDTO struct {
+	CompetencyID int32    `json:"competency_id"`
+	Name         string   `json:"name"`
+	Locale       string   `json:"locale"`
+	Fields       []string `json:"fields"`
+	Levels       []int32  `json:"levels"`
+}
+
+// MissingTranslationsResponse represents the missing translations report, ordered by locale then name
+type MissingTranslationsResponse struct {
+	Missing []MissingTranslationDTO `json:"missing"`
+	Count   int                     `json:"count"`
+}
+
+// Implement JSONSerializable for all translation DTOs
+func (SetTranslationRequest) isJSONSerializable()          {}
+func (CompetencyTranslationDTO) isJSONSerializable()       {}
+func (LevelTranslationDTO) isJSONSerializable()            {}
+func (CompetencyTranslationsResponse) isJSONSerializable() {}
+func (MissingTranslationDTO) isJSONSerializable()          {}
+func (MissingTranslationsResponse) isJSONSerializable()    {}
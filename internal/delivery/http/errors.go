@@ -0,0 +1,11 @@
+package http
+
+import "errors"
+
+var (
+	// ErrInvalidDependencies is returned when the router dependencies are nil
+	ErrInvalidDependencies = errors.New("invalid dependencies")
+
+	// ErrBuildAPISpec is returned when the OpenAPI document can't be built
+	ErrBuildAPISpec = errors.New("failed to build API spec")
+)
@@ -1,12 +1,12 @@
 package handler
 
 import (
-	"encoding/json"
 	"fmt"
 	"log/slog"
 	"net/http"
 
 	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/dto"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/request"
 	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/response"
 	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
 )
@@ -14,16 +14,20 @@ import (
 // AuthHandler handles authentication-related HTTP requests
 type AuthHandler struct {
 	userUseCase    domain.UserUseCase
+	binder         *request.Binder
 	logger         *slog.Logger
 	responseWriter *response.Writer
 }
 
 // NewAuthHandler creates a new auth handler instance
-func NewAuthHandler(userUseCase domain.UserUseCase, logger *slog.Logger, responseWriter *response.Writer) (*AuthHandler, error) {
+func NewAuthHandler(userUseCase domain.UserUseCase, binder *request.Binder, logger *slog.Logger, responseWriter *response.Writer) (*AuthHandler, error) {
 	// Check if dependencies are nil
 	if userUseCase == nil {
 		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "userUseCase can not be nil")
 	}
+	if binder == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "binder can not be nil")
+	}
 	if logger == nil {
 		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "logger can not be nil")
 	}
@@ -32,6 +36,7 @@ func NewAuthHandler(userUseCase domain.UserUseCase, logger *slog.Logger, respons
 	}
 	return &AuthHandler{
 		userUseCase:    userUseCase,
+		binder:         binder,
 		logger:         logger,
 		responseWriter: responseWriter,
 	}, nil
@@ -48,42 +53,35 @@ func NewAuthHandler(userUseCase domain.UserUseCase, logger *slog.Logger, respons
 func (h *AuthHandler) Register(w http.ResponseWriter, r *http.Request) {
 	var req dto.RegisterRequest
 
-	// Parse request body
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Warn("Failed to decode register request", "error", err)
-		h.responseWriter.Error(w, ErrInvalidJSON)
-		return
-	}
-
-	// Validate request
-	if err := req.Validate(); err != nil {
-		h.logger.Warn("Register request validation failed", "error", err)
-		h.responseWriter.Error(w, err)
+	// Decode and validate request body
+	if err := h.binder.Bind(w, r, &req); err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid register request", "error", err)
+		h.responseWriter.Error(w, r, err)
 		return
 	}
 
 	// Step 1: Register the user
 	user, err := h.userUseCase.Register(r.Context(), req.Email, req.Password)
 	if err != nil {
-		h.logger.Error("Failed to register user", "error", err)
-		h.responseWriter.Error(w, err)
+		h.logger.ErrorContext(r.Context(), "Failed to register user", "error", err)
+		h.responseWriter.Error(w, r, err)
 		return
 	}
 
-	h.logger.Info("User registered successfully", "user_id", user.ID)
+	h.logger.InfoContext(r.Context(), "User registered successfully", "user_id", user.ID)
 
 	// Step 2: Attempt automatic login
 	token, _, err := h.userUseCase.Login(r.Context(), req.Email, req.Password)
 	userDTO, dtoErr := ToUserDTO(user, h.logger)
 	if dtoErr != nil {
-		h.responseWriter.Error(w, dtoErr)
+		h.responseWriter.Error(w, r, dtoErr)
 		return
 	}
 
 	if err != nil {
 		// Registration succeeded but auto-login failed
 		// Still return 201 Created (resource was created) but without token
-		h.logger.Warn("User registered but auto-login failed", "error", err, "user_id", user.ID)
+		h.logger.WarnContext(r.Context(), "User registered but auto-login failed", "error", err, "user_id", user.ID)
 		h.responseWriter.Created(w, dto.AuthResponse{
 			User:    userDTO,
 			Message: "Account created successfully. Please try logging in.",
@@ -92,7 +90,7 @@ func (h *AuthHandler) Register(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Both registration and login succeeded
-	h.logger.Info("User registered and logged in successfully", "user_id", user.ID)
+	h.logger.InfoContext(r.Context(), "User registered and logged in successfully", "user_id", user.ID)
 	h.responseWriter.Created(w, dto.AuthResponse{
 		Token: token,
 		User:  userDTO,
@@ -104,34 +102,27 @@ func (h *AuthHandler) Register(w http.ResponseWriter, r *http.Request) {
 func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 	var req dto.LoginRequest
 
-	// Parse request body
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Warn("Failed to decode login request", "error", err)
-		h.responseWriter.Error(w, ErrInvalidJSON)
-		return
-	}
-
-	// Validate request
-	if err := req.Validate(); err != nil {
-		h.logger.Warn("Login request validation failed", "error", err)
-		h.responseWriter.Error(w, err)
+	// Decode and validate request body
+	if err := h.binder.Bind(w, r, &req); err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid login request", "error", err)
+		h.responseWriter.Error(w, r, err)
 		return
 	}
 
 	// Call use case
 	token, user, err := h.userUseCase.Login(r.Context(), req.Email, req.Password)
 	if err != nil {
-		h.logger.Warn("Login failed",
+		h.logger.WarnContext(r.Context(), "Login failed",
 			"error", err,
 		)
-		h.responseWriter.Error(w, err)
+		h.responseWriter.Error(w, r, err)
 		return
 	}
 
 	// Build response
 	dtoUser, err := ToUserDTO(user, h.logger)
 	if err != nil {
-		h.responseWriter.Error(w, err)
+		h.responseWriter.Error(w, r, err)
 		return
 	}
 	resp := dto.AuthResponse{
@@ -139,9 +130,57 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 		User:  dtoUser,
 	}
 
-	h.logger.Info("User logged in successfully",
+	h.logger.InfoContext(r.Context(), "User logged in successfully",
 		"user_id", user.ID,
 	)
 
 	h.responseWriter.Success(w, resp)
 }
+
+// registerAuthProblems registers the problem details of the authentication and authorization errors
+func registerAuthProblems(reg *response.Registry) {
+	reg.Register(domain.ErrEmailAlreadyExists, response.Problem{
+		Status: http.StatusConflict,
+		Code:   "email_already_exists",
+		Title:  "Email already exists",
+		Detail: "An account with this email already exists",
+	})
+	reg.Register(domain.ErrInvalidCredentials, response.Problem{
+		Status: http.StatusUnauthorized,
+		Code:   "invalid_credentials",
+		Title:  "Invalid credentials",
+		Detail: "Invalid email or password",
+	})
+	reg.Register(domain.ErrInvalidEmail, response.Problem{
+		Status: http.StatusBadRequest,
+		Code:   "invalid_email",
+		Title:  "Invalid email",
+		Detail: "Invalid email format",
+	})
+	reg.Register(domain.ErrInvalidPassword, response.Problem{
+		Status: http.StatusBadRequest,
+		Code:   "invalid_password",
+		Title:  "Invalid password",
+		Detail: "Password must be at least 8 characters",
+	})
+	reg.Register(domain.ErrInvalidToken, response.Problem{
+		Status: http.StatusUnauthorized,
+		Code:   "invalid_token",
+		Title:  "Invalid token",
+		Detail: "Invalid or expired token",
+	})
+
+	// Authorization
+	reg.Register(domain.ErrAuthenticationRequired, response.Problem{
+		Status: http.StatusUnauthorized,
+		Code:   "authentication_required",
+		Title:  "Authentication required",
+		Detail: "Send a bearer token to perform this operation",
+	})
+	reg.Register(domain.ErrForbidden, response.Problem{
+		Status: http.StatusForbidden,
+		Code:   "forbidden",
+		Title:  "Forbidden",
+		Detail: "You aren't allowed to perform this operation",
+	})
+}
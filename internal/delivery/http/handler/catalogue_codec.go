@@ -0,0 +1,271 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/dto"
+	"gopkg.in/yaml.v3"
+)
+
+// Catalogue file formats
+const (
+	catalogueCSV  = "csv"
+	catalogueJSON = "json"
+	catalogueYAML = "yaml"
+)
+
+// catalogueContentTypes are the media types of the catalogue formats in responses
+var catalogueContentTypes = map[string]string{
+	catalogueCSV:  "text/csv; charset=utf-8",
+	catalogueJSON: "application/json",
+	catalogueYAML: "application/yaml",
+}
+
+// catalogueCSVHeader is the header row of CSV catalogues
+var catalogueCSVHeader = []string{"name", "description", "created_at", "updated_at"}
+
+// catalogueFormatOf returns the catalogue format of a Content-Type, or "" if it is none of them
+func catalogueFormatOf(contentType string) string {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return ""
+	}
+	switch {
+	case mediaType == "text/csv":
+		return catalogueCSV
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		return catalogueJSON
+	case mediaType == "application/yaml" || mediaType == "application/x-yaml" || mediaType == "text/yaml":
+		return catalogueYAML
+	}
+	return ""
+}
+
+// catalogueEncoder writes catalogue records one at a time, so exports can be streamed
+type catalogueEncoder interface {
+	// Encode writes one record
+	Encode(record dto.CompetencyRecord) error
+	// Close terminates the document; nothing can be encoded afterwards
+	Close() error
+}
+
+// newCatalogueEncoder creates the encoder of a catalogue format writing to w
+func newCatalogueEncoder(format string, w io.Writer) catalogueEncoder {
+	switch format {
+	case catalogueJSON:
+		return &jsonCatalogueEncoder{w: w}
+	case catalogueYAML:
+		return &yamlCatalogueEncoder{w: w}
+	default:
+		return &csvCatalogueEncoder{w: csv.NewWriter(w)}
+	}
+}
+
+// csvCatalogueEncoder writes a header row followed by one row per record
+type csvCatalogueEncoder struct {
+	w             *csv.Writer
+	headerWritten bool
+}
+
+func (e *csvCatalogueEncoder) writeHeader() error {
+	if e.headerWritten {
+		return nil
+	}
+	e.headerWritten = true
+	return e.w.Write(catalogueCSVHeader)
+}
+
+func (e *csvCatalogueEncoder) Encode(record dto.CompetencyRecord) error {
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+	if err := e.w.Write([]string{record.Name, record.Description, formatRecordTime(record.CreatedAt), formatRecordTime(record.UpdatedAt)}); err != nil {
+		return err
+	}
+	e.w.Flush()
+	return e.w.Error()
+}
+
+func (e *csvCatalogueEncoder) Close() error {
+	// An empty catalogue still has its header
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+	e.w.Flush()
+	return e.w.Error()
+}
+
+// jsonCatalogueEncoder writes a JSON array with one record per line
+type jsonCatalogueEncoder struct {
+	w       io.Writer
+	started bool
+}
+
+func (e *jsonCatalogueEncoder) Encode(record dto.CompetencyRecord) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	separator := ",\n"
+	if !e.started {
+		separator = "[\n"
+		e.started = true
+	}
+	if _, err := io.WriteString(e.w, separator); err != nil {
+		return err
+	}
+	_, err = e.w.Write(data)
+	return err
+}
+
+func (e *jsonCatalogueEncoder) Close() error {
+	end := "\n]\n"
+	if !e.started {
+		end = "[]\n"
+	}
+	_, err := io.WriteString(e.w, end)
+	return err
+}
+
+// yamlCatalogueEncoder writes a YAML sequence, one item per record
+type yamlCatalogueEncoder struct {
+	w       io.Writer
+	started bool
+}
+
+func (e *yamlCatalogueEncoder) Encode(record dto.CompetencyRecord) error {
+	// A one-item sequence is exactly the lines of that item in the whole sequence
+	data, err := yaml.Marshal([]dto.CompetencyRecord{record})
+	if err != nil {
+		return err
+	}
+	e.started = true
+	_, err = e.w.Write(data)
+	return err
+}
+
+func (e *yamlCatalogueEncoder) Close() error {
+	if e.started {
+		return nil
+	}
+	_, err := io.WriteString(e.w, "[]\n")
+	return err
+}
+
+// decodeCatalogue parses an imported catalogue file
+// Malformed files are reported as a dto.ValidationError of the body
+func decodeCatalogue(format string, body []byte) ([]dto.CompetencyRecord, error) {
+	var records []dto.CompetencyRecord
+	var err error
+	switch format {
+	case catalogueJSON:
+		records, err = decodeJSONCatalogue(body)
+	case catalogueYAML:
+		records, err = decodeYAMLCatalogue(body)
+	default:
+		records, err = decodeCSVCatalogue(body)
+	}
+	if err != nil {
+		return nil, dto.ValidationError{
+			Field:   "body",
+			Message: fmt.Sprintf("invalid %s catalogue: %s", strings.ToUpper(format), err),
+		}
+	}
+	return records, nil
+}
+
+// decodeCSVCatalogue parses a CSV catalogue: a header row naming the columns, then one row per record
+// The name column is required; description, created_at and updated_at are optional
+func decodeCSVCatalogue(body []byte) ([]dto.CompetencyRecord, error) {
+	// Spreadsheets often save UTF-8 with a byte order mark
+	body = bytes.TrimPrefix(body, []byte("\uFEFF"))
+
+	reader := csv.NewReader(bytes.NewReader(body))
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("missing header row")
+		}
+		return nil, err
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		column = strings.ToLower(strings.TrimSpace(column))
+		if !slices.Contains(catalogueCSVHeader, column) {
+			return nil, fmt.Errorf("unknown column %q", column)
+		}
+		if _, ok := columns[column]; ok {
+			return nil, fmt.Errorf("column %q appears more than once", column)
+		}
+		columns[column] = i
+	}
+	nameColumn, ok := columns["name"]
+	if !ok {
+		return nil, errors.New("missing name column")
+	}
+	descriptionColumn, hasDescription := columns["description"]
+
+	var records []dto.CompetencyRecord
+	for {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		record := dto.CompetencyRecord{Name: row[nameColumn]}
+		if hasDescription {
+			record.Description = row[descriptionColumn]
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// decodeJSONCatalogue parses a JSON catalogue: an array of records
+func decodeJSONCatalogue(body []byte) ([]dto.CompetencyRecord, error) {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.DisallowUnknownFields()
+	var records []dto.CompetencyRecord
+	if err := decoder.Decode(&records); err != nil {
+		return nil, err
+	}
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return nil, errors.New("data after the array")
+	}
+	return records, nil
+}
+
+// decodeYAMLCatalogue parses a YAML catalogue: a sequence of records
+func decodeYAMLCatalogue(body []byte) ([]dto.CompetencyRecord, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(body))
+	decoder.KnownFields(true)
+	var records []dto.CompetencyRecord
+	if err := decoder.Decode(&records); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("empty document")
+		}
+		return nil, err
+	}
+	return records, nil
+}
+
+// formatRecordTime formats an optional record timestamp for CSV
+func formatRecordTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
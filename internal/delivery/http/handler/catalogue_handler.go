@@ -0,0 +1,239 @@
+package handler
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/dto"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/request"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/response"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+)
+
+// exportFlushInterval is the number of exported records after which the response is flushed to the client
+const exportFlushInterval = 100
+
+// CatalogueHandler handles the export and import of the whole competency catalogue
+type CatalogueHandler struct {
+	competencyUseCase domain.CompetencyUseCase
+	binder            *request.Binder // Sized for imported files
+	logger            *slog.Logger
+	responseWriter    *response.Writer
+}
+
+// NewCatalogueHandler creates a new catalogue handler instance
+func NewCatalogueHandler(competencyUseCase domain.CompetencyUseCase, binder *request.Binder, logger *slog.Logger, responseWriter *response.Writer) (*CatalogueHandler, error) {
+	// Check if dependencies are nil
+	if competencyUseCase == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "competencyUseCase can not be nil")
+	}
+	if binder == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "binder can not be nil")
+	}
+	if logger == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "logger can not be nil")
+	}
+	if responseWriter == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "responseWriter can not be nil")
+	}
+	return &CatalogueHandler{
+		competencyUseCase: competencyUseCase,
+		binder:            binder,
+		logger:            logger,
+		responseWriter:    responseWriter,
+	}, nil
+}
+
+// Export handles catalogue export requests
+// GET /api/v1/competencies/export?format=csv|json|yaml
+// The catalogue is streamed as an attachment, ordered by name, while it is read page by page
+// HTTP Status Codes:
+//   - 200 OK: Catalogue streamed; a failure after the first bytes aborts the connection
+//   - 400 Bad Request: Invalid format
+//   - 500 Internal Server Error: Unexpected errors before anything was sent
+func (h *CatalogueHandler) Export(w http.ResponseWriter, r *http.Request) {
+	// Decode and validate query parameters
+	var query dto.ExportCompetenciesQuery
+	if err := request.BindQuery(r.URL.Query(), &query); err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid export competencies query", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+	format := query.Format
+	if format == "" {
+		format = catalogueCSV
+	}
+
+	// The status and headers are only sent with the first bytes, so errors before that are still reported as problems
+	stream := &exportStream{w: w, start: func() {
+		filename := fmt.Sprintf("competencies-%s.%s", time.Now().UTC().Format("20060102"), format)
+		w.Header().Set("Content-Type", catalogueContentTypes[format])
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+		w.WriteHeader(http.StatusOK)
+	}}
+	buffered := bufio.NewWriter(stream)
+	encoder := newCatalogueEncoder(format, buffered)
+	controller := http.NewResponseController(w)
+
+	count := 0
+	err := h.competencyUseCase.Export(r.Context(), func(competency *domain.Competency) error {
+		if err := encoder.Encode(ToCompetencyRecord(competency)); err != nil {
+			return err
+		}
+		count++
+		if count%exportFlushInterval != 0 {
+			return nil
+		}
+		if err := buffered.Flush(); err != nil {
+			return err
+		}
+		return controller.Flush()
+	})
+	if err == nil {
+		err = encoder.Close()
+	}
+	if err == nil {
+		err = buffered.Flush()
+	}
+	if err != nil {
+		if !stream.started {
+			h.logger.ErrorContext(r.Context(), "Failed to export competencies", "error", err)
+			h.responseWriter.Error(w, r, err)
+			return
+		}
+		// The client already received part of the catalogue: abort so it can't mistake it for the whole
+		h.logger.ErrorContext(r.Context(), "Competency export aborted", "error", err, "exported", count)
+		panic(http.ErrAbortHandler)
+	}
+
+	h.logger.InfoContext(r.Context(), "Competencies exported successfully", "format", format, "count", count)
+}
+
+// exportStream writes to the response, sending the status and headers before the first bytes
+type exportStream struct {
+	w       http.ResponseWriter
+	start   func()
+	started bool
+}
+
+func (s *exportStream) Write(p []byte) (int, error) {
+	if !s.started {
+		s.started = true
+		s.start()
+	}
+	return s.w.Write(p)
+}
+
+// Import handles catalogue import requests
+// POST /api/v1/competencies/import?format=csv|json|yaml&on_existing=skip|update|fail&dry_run=true
+// The body is the file, in the format given by the query or else by its Content-Type
+// Records are validated like single creations; the import is all-or-nothing
+// Admins only
+// HTTP Status Codes:
+//   - 200 OK: Import applied, or the plan of a dry run (which may show conflicts and invalid records)
+//   - 400 Bad Request: Malformed file, invalid query parameters, or no or too many records
+//   - 401 Unauthorized: Anonymous request
+//   - 403 Forbidden: The user isn't an admin
+//   - 413 Payload Too Large: The file exceeds the import size limit
+//   - 415 Unsupported Media Type: The format is neither given nor recognized from the Content-Type
+//   - 422 Unprocessable Entity: Import rejected, the invalid and conflicting records are listed in "errors"
+//   - 500 Internal Server Error: Unexpected errors
+func (h *CatalogueHandler) Import(w http.ResponseWriter, r *http.Request) {
+	// Decode and validate query parameters
+	var query dto.ImportCompetenciesQuery
+	if err := request.BindQuery(r.URL.Query(), &query); err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid import competencies query", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+	format := query.Format
+	if format == "" {
+		format = catalogueFormatOf(r.Header.Get("Content-Type"))
+	}
+	if format == "" {
+		h.logger.WarnContext(r.Context(), "Unknown import format", "content_type", r.Header.Get("Content-Type"))
+		h.responseWriter.Error(w, r, ErrUnsupportedImportFormat)
+		return
+	}
+	policy := domain.CompetencyImportPolicy(query.OnExisting)
+	if policy == "" {
+		policy = domain.CompetencyImportSkip
+	}
+
+	// Read and parse the file
+	body, err := h.binder.ReadBody(w, r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid import competencies body", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+	records, err := decodeCatalogue(format, body)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Malformed competency catalogue", "format", format, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+	items := make([]domain.NewCompetency, len(records))
+	for i, record := range records {
+		items[i] = domain.NewCompetency{Name: record.Name, Description: record.Description}
+	}
+
+	// Call use case
+	report, err := h.competencyUseCase.Import(r.Context(), items, domain.CompetencyImportOptions{OnExisting: policy, DryRun: query.DryRun})
+	if errors.Is(err, domain.ErrCompetencyImportRejected) {
+		// List the records that made the import fail
+		var failures dto.ValidationErrors
+		for i, result := range report.Results {
+			if result.Err != nil {
+				failures = append(failures, dto.ValidationError{
+					Field:   fmt.Sprintf("records[%d].name", i),
+					Message: importRecordReason(result.Err),
+				})
+			}
+		}
+		h.logger.WarnContext(r.Context(), "Competency import rejected", "records", len(items), "failed", len(failures))
+		h.responseWriter.Error(w, r, fmt.Errorf("%w: %w", err, failures))
+		return
+	}
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to import competencies", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Build response
+	resp, err := ToCompetencyImportResponse(policy, report, h.logger)
+	if err != nil {
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "Competency import processed", "format", format, "dry_run", resp.DryRun, "created", resp.Created, "updated", resp.Updated)
+	h.responseWriter.Success(w, resp)
+}
+
+// registerCatalogueProblems registers the problem details of the catalogue import errors
+func registerCatalogueProblems(reg *response.Registry) {
+	reg.Register(domain.ErrInvalidCompetencyImport, response.Problem{
+		Status: http.StatusBadRequest,
+		Code:   "invalid_competency_import",
+		Title:  "Invalid competency import",
+		Detail: "An import must contain 1 to 10000 records, with on_existing skip, update or fail",
+	})
+	reg.Register(domain.ErrCompetencyImportRejected, response.Problem{
+		Status: http.StatusUnprocessableEntity,
+		Code:   "competency_import_rejected",
+		Title:  "Competency import rejected",
+		Detail: "Nothing was imported because the records listed in errors are invalid or conflict with existing competencies; retry with dry_run=true to see the full plan",
+	})
+	reg.Register(ErrUnsupportedImportFormat, response.Problem{
+		Status: http.StatusUnsupportedMediaType,
+		Code:   "unsupported_import_format",
+		Title:  "Unsupported import format",
+		Detail: "Send the file as text/csv, application/json or application/yaml, or name its format with the format parameter",
+	})
+}
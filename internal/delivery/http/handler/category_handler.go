@@ -0,0 +1,362 @@
+package handler
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/dto"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/request"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/response"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+)
+
+// CategoryHandler handles competency category HTTP requests
+type CategoryHandler struct {
+	categoryUseCase domain.CategoryUseCase
+	binder          *request.Binder
+	logger          *slog.Logger
+	responseWriter  *response.Writer
+}
+
+// NewCategoryHandler creates a new category handler instance
+func NewCategoryHandler(categoryUseCase domain.CategoryUseCase, binder *request.Binder, logger *slog.Logger, responseWriter *response.Writer) (*CategoryHandler, error) {
+	// Check if dependencies are nil
+	if categoryUseCase == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "categoryUseCase can not be nil")
+	}
+	if binder == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "binder can not be nil")
+	}
+	if logger == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "logger can not be nil")
+	}
+	if responseWriter == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "responseWriter can not be nil")
+	}
+	return &CategoryHandler{
+		categoryUseCase: categoryUseCase,
+		binder:          binder,
+		logger:          logger,
+		responseWriter:  responseWriter,
+	}, nil
+}
+
+// Create handles category creation requests
+// POST /api/v1/categories
+// Admins only
+// HTTP Status Codes:
+//   - 201 Created: Category created after its siblings
+//   - 400 Bad Request: Validation errors (invalid name)
+//   - 401 Unauthorized: Anonymous request
+//   - 403 Forbidden: The user isn't an admin
+//   - 409 Conflict: A sibling already has the name
+//   - 422 Unprocessable Entity: The parent category doesn't exist
+//   - 500 Internal Server Error: Unexpected errors
+func (h *CategoryHandler) Create(w http.ResponseWriter, r *http.Request) {
+	// Decode and validate request body
+	var req dto.CreateCategoryRequest
+	if err := h.binder.Bind(w, r, &req); err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid create category request", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	category, err := h.categoryUseCase.Create(r.Context(), req.ParentID, req.Name)
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to create category", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Build response
+	categoryDTO, err := ToCategoryDTO(category, h.logger)
+	if err != nil {
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "Category created successfully", "category_id", category.ID, "name", category.Name)
+	h.responseWriter.Created(w, categoryDTO)
+}
+
+// GetTree handles category tree requests
+// GET /api/v1/categories
+// Returns the root categories with their descendants, siblings in order, and their competency counts
+// HTTP Status Codes:
+//   - 200 OK: Tree retrieved (possibly empty)
+//   - 500 Internal Server Error: Unexpected errors
+func (h *CategoryHandler) GetTree(w http.ResponseWriter, r *http.Request) {
+	// Call use case
+	roots, err := h.categoryUseCase.GetTree(r.Context())
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to get category tree", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Build response
+	nodes, err := ToCategoryNodeDTOs(roots, h.logger)
+	if err != nil {
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "Category tree retrieved successfully", "roots", len(nodes))
+	h.responseWriter.Success(w, dto.CategoryTreeResponse{Categories: nodes})
+}
+
+// GetSubtree handles category subtree requests
+// GET /api/v1/categories/{id}
+// Returns the category with its descendants and their competency counts
+// HTTP Status Codes:
+//   - 200 OK: Subtree retrieved
+//   - 400 Bad Request: Invalid ID format
+//   - 404 Not Found: Category not found
+//   - 500 Internal Server Error: Unexpected errors
+func (h *CategoryHandler) GetSubtree(w http.ResponseWriter, r *http.Request) {
+	// Get ID from URL parameter
+	id, err := idParam(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid category ID format", "id", chi.URLParam(r, "id"), "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	subtree, err := h.categoryUseCase.GetSubtree(r.Context(), id)
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to get category subtree", "id", id, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Build response
+	node, err := ToCategoryNodeDTO(subtree, h.logger)
+	if err != nil {
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "Category subtree retrieved successfully", "category_id", id)
+	h.responseWriter.Success(w, node)
+}
+
+// Rename handles category rename requests
+// PATCH /api/v1/categories/{id}
+// Admins only
+// HTTP Status Codes:
+//   - 200 OK: Category renamed
+//   - 400 Bad Request: Invalid ID format or name
+//   - 401 Unauthorized: Anonymous request
+//   - 403 Forbidden: The user isn't an admin
+//   - 404 Not Found: Category not found
+//   - 409 Conflict: A sibling already has the name
+//   - 500 Internal Server Error: Unexpected errors
+func (h *CategoryHandler) Rename(w http.ResponseWriter, r *http.Request) {
+	// Get ID from URL parameter
+	id, err := idParam(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid category ID format", "id", chi.URLParam(r, "id"), "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Decode and validate request body
+	var req dto.RenameCategoryRequest
+	if err := h.binder.Bind(w, r, &req); err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid rename category request", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	category, err := h.categoryUseCase.Rename(r.Context(), id, req.Name)
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to rename category", "id", id, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.respondWithCategory(w, r, category, "Category renamed successfully")
+}
+
+// Move handles category move requests
+// POST /api/v1/categories/{id}/move
+// Admins only
+// The category keeps its descendants; it can't be moved under itself or one of its descendants
+// HTTP Status Codes:
+//   - 200 OK: Category moved
+//   - 400 Bad Request: Invalid ID format or body
+//   - 401 Unauthorized: Anonymous request
+//   - 403 Forbidden: The user isn't an admin
+//   - 404 Not Found: Category not found
+//   - 409 Conflict: The move would create a cycle, or a new sibling already has the name
+//   - 422 Unprocessable Entity: The new parent doesn't exist
+//   - 500 Internal Server Error: Unexpected errors
+func (h *CategoryHandler) Move(w http.ResponseWriter, r *http.Request) {
+	// Get ID from URL parameter
+	id, err := idParam(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid category ID format", "id", chi.URLParam(r, "id"), "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Decode and validate request body
+	var req dto.MoveCategoryRequest
+	if err := h.binder.Bind(w, r, &req); err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid move category request", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+	position := int32(-1) // After the new siblings
+	if req.Position != nil {
+		if *req.Position < 0 {
+			h.responseWriter.Error(w, r, dto.ValidationError{Field: "position", Message: "must not be negative"})
+			return
+		}
+		position = *req.Position
+	}
+
+	// Call use case
+	category, err := h.categoryUseCase.Move(r.Context(), id, req.ParentID, position)
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to move category", "id", id, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.respondWithCategory(w, r, category, "Category moved successfully")
+}
+
+// Reorder handles sibling reordering requests
+// POST /api/v1/categories/reorder
+// Admins only
+// The body lists the children of parent_id (the root categories without it) in their new order
+// HTTP Status Codes:
+//   - 200 OK: Siblings reordered, returned in their new order
+//   - 400 Bad Request: Invalid body, or ids isn't exactly the current children
+//   - 401 Unauthorized: Anonymous request
+//   - 403 Forbidden: The user isn't an admin
+//   - 422 Unprocessable Entity: The parent category doesn't exist
+//   - 500 Internal Server Error: Unexpected errors
+func (h *CategoryHandler) Reorder(w http.ResponseWriter, r *http.Request) {
+	// Decode and validate request body
+	var req dto.ReorderCategoriesRequest
+	if err := h.binder.Bind(w, r, &req); err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid reorder categories request", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	categories, err := h.categoryUseCase.Reorder(r.Context(), req.ParentID, req.IDs)
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to reorder categories", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Build response
+	categoryDTOs, err := ToCategoryDTOs(categories, h.logger)
+	if err != nil {
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "Categories reordered successfully", "parent_id", req.ParentID, "count", len(categoryDTOs))
+	h.responseWriter.Success(w, dto.CategoriesResponse{Categories: categoryDTOs})
+}
+
+// Delete handles delete category requests
+// DELETE /api/v1/categories/{id}
+// Admins only
+// Only empty categories can be deleted: move or delete their children and competencies first
+// HTTP Status Codes:
+//   - 204 No Content: Category deleted
+//   - 400 Bad Request: Invalid ID format
+//   - 401 Unauthorized: Anonymous request
+//   - 403 Forbidden: The user isn't an admin
+//   - 404 Not Found: Category not found
+//   - 409 Conflict: The category still has children or competencies
+//   - 500 Internal Server Error: Unexpected errors
+func (h *CategoryHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	// Get ID from URL parameter
+	id, err := idParam(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid category ID format", "id", chi.URLParam(r, "id"), "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	if err := h.categoryUseCase.Delete(r.Context(), id); err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to delete category", "id", id, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "Category deleted successfully", "category_id", id)
+	h.responseWriter.NoContent(w)
+}
+
+// respondWithCategory sends a changed category
+func (h *CategoryHandler) respondWithCategory(w http.ResponseWriter, r *http.Request, category *domain.Category, message string) {
+	categoryDTO, err := ToCategoryDTO(category, h.logger)
+	if err != nil {
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), message, "category_id", category.ID)
+	h.responseWriter.Success(w, categoryDTO)
+}
+
+// registerCategoryProblems registers the problem details of the category errors
+func registerCategoryProblems(reg *response.Registry) {
+	reg.Register(domain.ErrCategoryNotFound, response.Problem{
+		Status: http.StatusNotFound,
+		Code:   "category_not_found",
+		Title:  "Category not found",
+		Detail: "Category not found",
+	})
+	reg.Register(domain.ErrCategoryParentNotFound, response.Problem{
+		Status: http.StatusUnprocessableEntity,
+		Code:   "category_parent_not_found",
+		Title:  "Parent category not found",
+		Detail: "The parent category doesn't exist",
+	})
+	reg.Register(domain.ErrCategoryAlreadyExists, response.Problem{
+		Status: http.StatusConflict,
+		Code:   "category_already_exists",
+		Title:  "Category already exists",
+		Detail: "A category with this name already exists under the same parent",
+	})
+	reg.Register(domain.ErrInvalidCategoryName, response.Problem{
+		Status: http.StatusBadRequest,
+		Code:   "invalid_category_name",
+		Title:  "Invalid category name",
+		Detail: "Invalid category name (must be 2-100 characters)",
+	})
+	reg.Register(domain.ErrCategoryCycle, response.Problem{
+		Status: http.StatusConflict,
+		Code:   "category_cycle",
+		Title:  "Category cycle",
+		Detail: "A category can't be moved under itself or one of its descendants",
+	})
+	reg.Register(domain.ErrCategoryInUse, response.Problem{
+		Status: http.StatusConflict,
+		Code:   "category_in_use",
+		Title:  "Category in use",
+		Detail: "The category still has subcategories or competencies; move or delete them first",
+	})
+	reg.Register(domain.ErrInvalidCategoryOrder, response.Problem{
+		Status: http.StatusBadRequest,
+		Code:   "invalid_category_order",
+		Title:  "Invalid category order",
+		Detail: "ids must list every child of the parent exactly once",
+	})
+}
@@ -1,7 +1,7 @@
 package handler
 
 import (
-	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
@@ -9,6 +9,7 @@ import (
 
 	"github.com/go-chi/chi/v5"
 	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/dto"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/request"
 	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/response"
 	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
 )
@@ -16,16 +17,20 @@ import (
 // CompetencyHandler handles competency-related HTTP requests
 type CompetencyHandler struct {
 	competencyUseCase domain.CompetencyUseCase
+	binder            *request.Binder
 	logger            *slog.Logger
 	responseWriter    *response.Writer
 }
 
 // NewCompetencyHandler creates a new competency handler instance
-func NewCompetencyHandler(competencyUseCase domain.CompetencyUseCase, logger *slog.Logger, responseWriter *response.Writer) (*CompetencyHandler, error) {
+func NewCompetencyHandler(competencyUseCase domain.CompetencyUseCase, binder *request.Binder, logger *slog.Logger, responseWriter *response.Writer) (*CompetencyHandler, error) {
 	// Check if dependencies are nil
 	if competencyUseCase == nil {
 		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "competencyUseCase can not be nil")
 	}
+	if binder == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "binder can not be nil")
+	}
 	if logger == nil {
 		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "logger can not be nil")
 	}
@@ -34,6 +39,7 @@ func NewCompetencyHandler(competencyUseCase domain.CompetencyUseCase, logger *sl
 	}
 	return &CompetencyHandler{
 		competencyUseCase: competencyUseCase,
+		binder:            binder,
 		logger:            logger,
 		responseWriter:    responseWriter,
 	}, nil
@@ -41,166 +47,798 @@ func NewCompetencyHandler(competencyUseCase domain.CompetencyUseCase, logger *sl
 
 // Create handles competency creation requests
 // POST /api/v1/competencies
+// Admins only
 // HTTP Status Codes:
 //   - 201 Created: Competency created successfully
 //   - 400 Bad Request: Validation errors (invalid name)
+//   - 401 Unauthorized: Anonymous request
+//   - 403 Forbidden: The user isn't an admin
 //   - 409 Conflict: Competency name already exists
 //   - 500 Internal Server Error: Unexpected errors
 func (h *CompetencyHandler) Create(w http.ResponseWriter, r *http.Request) {
 	var req dto.CreateCompetencyRequest
 
-	// Parse request body
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Warn("Failed to decode create competency request", "error", err)
-		h.responseWriter.Error(w, ErrInvalidJSON)
-		return
-	}
-
-	// Validate request
-	if err := req.Validate(); err != nil {
-		h.logger.Warn("Create competency request validation failed", "error", err)
-		h.responseWriter.Error(w, err)
+	// Decode and validate request body
+	if err := h.binder.Bind(w, r, &req); err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid create competency request", "error", err)
+		h.responseWriter.Error(w, r, err)
 		return
 	}
 
 	// Call use case
 	competency, err := h.competencyUseCase.Create(r.Context(), req.Name, req.Description)
 	if err != nil {
-		h.logger.Error("Failed to create competency", "error", err)
-		h.responseWriter.Error(w, err)
+		h.logger.ErrorContext(r.Context(), "Failed to create competency", "error", err)
+		h.responseWriter.Error(w, r, err)
 		return
 	}
 
 	// Build response
 	competencyDTO, err := ToCompetencyDTO(competency, h.logger)
 	if err != nil {
-		h.responseWriter.Error(w, err)
+		h.responseWriter.Error(w, r, err)
 		return
 	}
 
-	h.logger.Info("Competency created successfully", "competency_id", competency.ID, "name", competency.Name)
+	h.logger.InfoContext(r.Context(), "Competency created successfully", "competency_id", competency.ID, "name", competency.Name)
+	w.Header().Set("ETag", competencyETag(competency, domain.CompetencyInclude{}))
 	h.responseWriter.Created(w, competencyDTO)
 }
 
+// CreateBatch handles bulk competency creation requests
+// POST /api/v1/competencies:batch?mode=all_or_nothing|best_effort
+// Items are created in one transaction. all_or_nothing (the default) creates nothing if any item is
+// a duplicate or invalid; best_effort creates the others. The response has one result per item
+// Admins only
+// HTTP Status Codes:
+//   - 200 OK: Batch processed (best_effort: some items may have failed)
+//   - 400 Bad Request: Invalid body, mode, or number of items
+//   - 401 Unauthorized: Anonymous request
+//   - 403 Forbidden: The user isn't an admin
+//   - 422 Unprocessable Entity: all_or_nothing batch rejected, the failed items are listed in "errors"
+//   - 500 Internal Server Error: Unexpected errors
+func (h *CompetencyHandler) CreateBatch(w http.ResponseWriter, r *http.Request) {
+	// Decode and validate query parameters and body
+	var query dto.CreateCompetenciesBatchQuery
+	if err := request.BindQuery(r.URL.Query(), &query); err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid create competencies batch query", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+	var req dto.CreateCompetenciesBatchRequest
+	if err := h.binder.Bind(w, r, &req); err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid create competencies batch request", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	mode := domain.CompetencyBatchMode(query.Mode)
+	if mode == "" {
+		mode = domain.CompetencyBatchAllOrNothing
+	}
+	items := make([]domain.NewCompetency, len(req.Items))
+	for i, item := range req.Items {
+		items[i] = domain.NewCompetency{Name: item.Name, Description: item.Description}
+	}
+
+	// Call use case
+	results, err := h.competencyUseCase.CreateBatch(r.Context(), items, mode)
+	if errors.Is(err, domain.ErrCompetencyBatchRejected) {
+		// List the items that made the batch fail
+		var failures dto.ValidationErrors
+		for i, result := range results {
+			if result.Err != nil {
+				failures = append(failures, dto.ValidationError{
+					Field:   fmt.Sprintf("items[%d].name", i),
+					Message: batchItemReason(result.Err),
+				})
+			}
+		}
+		h.logger.WarnContext(r.Context(), "Competency batch rejected", "items", len(items), "failed", len(failures))
+		h.responseWriter.Error(w, r, fmt.Errorf("%w: %w", err, failures))
+		return
+	}
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to create competency batch", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Build response
+	resp, err := ToCompetencyBatchResponse(mode, results, h.logger)
+	if err != nil {
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "Competency batch processed", "mode", mode, "created", resp.Created, "failed", resp.Failed)
+	h.responseWriter.Success(w, resp)
+}
+
 // GetByID handles get competency by ID requests
-// GET /api/v1/competencies/{id}
+// GET /api/v1/competencies/{id}?include=levels
+// include=levels embeds the rubric of the competency
+// The response carries the competency ETag; If-None-Match is honoured
 // HTTP Status Codes:
 //   - 200 OK: Competency retrieved successfully
-//   - 400 Bad Request: Invalid ID format
+//   - 301 Moved Permanently: The competency was merged into another one, whose URL is in Location
+//   - 304 Not Modified: If-None-Match matches the current ETag
+//   - 400 Bad Request: Invalid ID format or query parameters
 //   - 404 Not Found: Competency not found
 //   - 500 Internal Server Error: Unexpected errors
 func (h *CompetencyHandler) GetByID(w http.ResponseWriter, r *http.Request) {
 	// Get ID from URL parameter
-	idStr := chi.URLParam(r, "id")
-	id, err := strconv.ParseInt(idStr, 10, 32)
+	id, err := idParam(r)
 	if err != nil {
-		h.logger.Warn("Invalid competency ID format", "id", idStr, "error", err)
-		h.responseWriter.Error(w, dto.ValidationError{
-			Field:   "id",
-			Message: "invalid ID format",
-		})
+		h.logger.WarnContext(r.Context(), "Invalid competency ID format", "id", chi.URLParam(r, "id"), "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Decode and validate query parameters
+	var query dto.GetCompetencyQuery
+	if err := request.BindQuery(r.URL.Query(), &query); err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid get competency query", "error", err)
+		h.responseWriter.Error(w, r, err)
 		return
 	}
 
 	// Call use case
-	competency, err := h.competencyUseCase.GetByID(r.Context(), int32(id))
+	include := domain.CompetencyInclude{Levels: query.Include == "levels"}
+	competency, err := h.competencyUseCase.GetByID(r.Context(), id, include)
 	if err != nil {
-		h.logger.Error("Failed to get competency by ID", "id", id, "error", err)
-		h.responseWriter.Error(w, err)
+		h.logger.ErrorContext(r.Context(), "Failed to get competency by ID", "id", id, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// The competency was merged into another one: send the client there, keeping the query
+	if competency.ID != id {
+		location := fmt.Sprintf("/api/v1/competencies/%d", competency.ID)
+		if r.URL.RawQuery != "" {
+			location += "?" + r.URL.RawQuery
+		}
+		h.logger.InfoContext(r.Context(), "Merged competency redirected", "id", id, "competency_id", competency.ID)
+		http.Redirect(w, r, location, http.StatusMovedPermanently)
+		return
+	}
+
+	// The client already has this version
+	etag := competencyETag(competency, include)
+	w.Header().Set("ETag", etag)
+	if match := r.Header.Get("If-None-Match"); match != "" && etagMatches(match, etag) {
+		h.logger.InfoContext(r.Context(), "Competency not modified", "competency_id", competency.ID)
+		w.WriteHeader(http.StatusNotModified)
 		return
 	}
 
 	// Build response
 	competencyDTO, err := ToCompetencyDTO(competency, h.logger)
 	if err != nil {
-		h.responseWriter.Error(w, err)
+		h.responseWriter.Error(w, r, err)
 		return
 	}
 
-	h.logger.Info("Competency retrieved successfully", "competency_id", competency.ID)
+	h.logger.InfoContext(r.Context(), "Competency retrieved successfully", "competency_id", competency.ID)
 	h.responseWriter.Success(w, competencyDTO)
 }
 
-// GetAll handles get all competencies requests
-// GET /api/v1/competencies
+// GetAll handles list competencies requests
+// GET /api/v1/competencies?limit=&cursor=&sort=&direction=&name_prefix=&has_description=&created_after=&tag=&tag_match=
+// Pages are keyset paginated: pass next_cursor of a page as cursor, with the same sort and direction, to get the next one
 // HTTP Status Codes:
 //   - 200 OK: Competencies retrieved successfully
+//   - 400 Bad Request: Invalid query parameters or cursor
 //   - 500 Internal Server Error: Unexpected errors
 func (h *CompetencyHandler) GetAll(w http.ResponseWriter, r *http.Request) {
+	// Decode and validate query parameters
+	var query dto.ListCompetenciesQuery
+	if err := request.BindQuery(r.URL.Query(), &query); err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid list competencies query", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	params := domain.CompetencyListParams{
+		Filter: domain.CompetencyFilter{
+			NamePrefix:      query.NamePrefix,
+			HasDescription:  query.HasDescription,
+			CreatedAfter:    query.CreatedAfter,
+			IncludeArchived: query.IncludeArchived,
+			CategoryID:      query.CategoryID,
+			Tags:            query.Tags,
+			TagMatch:        domain.TagMatch(query.TagMatch),
+		},
+		Sort:      domain.CompetencySort(query.Sort),
+		Direction: domain.SortDirection(query.Direction),
+		Limit:     valueOr(query.Limit, 0),
+	}
+	if query.Cursor != "" {
+		after, err := decodeCompetencyCursor(query.Cursor)
+		if err != nil {
+			h.logger.WarnContext(r.Context(), "Invalid competency cursor", "error", err)
+			h.responseWriter.Error(w, r, err)
+			return
+		}
+		params.After = after
+	}
+
 	// Call use case
-	competencies, err := h.competencyUseCase.GetAll(r.Context())
+	page, err := h.competencyUseCase.GetAll(r.Context(), params)
 	if err != nil {
-		h.logger.Error("Failed to get all competencies", "error", err)
-		h.responseWriter.Error(w, err)
+		h.logger.ErrorContext(r.Context(), "Failed to get all competencies", "error", err)
+		h.responseWriter.Error(w, r, err)
 		return
 	}
 
 	// Build response
-	competencyDTOs, err := ToCompetencyDTOs(competencies, h.logger)
+	competencyDTOs, err := ToCompetencyDTOs(page.Competencies, h.logger)
 	if err != nil {
-		h.responseWriter.Error(w, err)
+		h.responseWriter.Error(w, r, err)
 		return
 	}
 
 	resp := dto.CompetenciesResponse{
 		Competencies: competencyDTOs,
 		Count:        len(competencyDTOs),
+		Total:        page.Total,
+	}
+	if page.NextCursor != nil {
+		if resp.NextCursor, err = encodeCompetencyCursor(page.NextCursor); err != nil {
+			h.responseWriter.Error(w, r, err)
+			return
+		}
 	}
 
-	h.logger.Info("Competencies retrieved successfully", "count", len(competencies))
+	h.logger.InfoContext(r.Context(), "Competencies retrieved successfully", "count", len(page.Competencies), "total", page.Total)
 	h.responseWriter.Success(w, resp)
 }
 
+// Search handles competency search requests
+// GET /api/v1/competencies/search?q=&limit=
+// Matches names and descriptions (full-text) and names with typos (trigram similarity), most relevant first
+// HTTP Status Codes:
+//   - 200 OK: Search results (possibly none)
+//   - 400 Bad Request: Missing or invalid query parameters
+//   - 500 Internal Server Error: Unexpected errors
+func (h *CompetencyHandler) Search(w http.ResponseWriter, r *http.Request) {
+	// Decode and validate query parameters
+	var query dto.SearchCompetenciesQuery
+	if err := request.BindQuery(r.URL.Query(), &query); err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid search competencies query", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	results, err := h.competencyUseCase.Search(r.Context(), query.Q, valueOr(query.Limit, 0))
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to search competencies", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Build response
+	resultDTOs, err := ToCompetencySearchResultDTOs(results, h.logger)
+	if err != nil {
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "Competencies searched successfully", "count", len(resultDTOs))
+	h.responseWriter.Success(w, dto.CompetencySearchResponse{
+		Results: resultDTOs,
+		Count:   len(resultDTOs),
+	})
+}
+
+// Suggest handles competency typeahead requests
+// GET /api/v1/competencies/suggest?prefix=&limit=
+// HTTP Status Codes:
+//   - 200 OK: Suggestions (possibly none)
+//   - 400 Bad Request: Missing or invalid query parameters
+//   - 500 Internal Server Error: Unexpected errors
+func (h *CompetencyHandler) Suggest(w http.ResponseWriter, r *http.Request) {
+	// Decode and validate query parameters
+	var query dto.SuggestCompetenciesQuery
+	if err := request.BindQuery(r.URL.Query(), &query); err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid suggest competencies query", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	suggestions, err := h.competencyUseCase.Suggest(r.Context(), query.Prefix, valueOr(query.Limit, 0))
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to suggest competencies", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.responseWriter.Success(w, dto.CompetencySuggestionsResponse{
+		Suggestions: ToCompetencySuggestionDTOs(suggestions),
+	})
+}
+
 // UpdateDescription handles update competency description requests
 // PATCH /api/v1/competencies/{id}/description
+// Admins and stewards of the competency only
+// Requires If-Match with the ETag of the version being updated ("*" updates any version)
 // HTTP Status Codes:
 //   - 200 OK: Description updated successfully
 //   - 400 Bad Request: Invalid ID format
+//   - 401 Unauthorized: Anonymous request
+//   - 403 Forbidden: The user is neither an admin nor a steward of the competency
 //   - 404 Not Found: Competency not found
+//   - 412 Precondition Failed: The competency was changed since the ETag was read
+//   - 428 Precondition Required: If-Match is missing
 //   - 500 Internal Server Error: Unexpected errors
 func (h *CompetencyHandler) UpdateDescription(w http.ResponseWriter, r *http.Request) {
 	// Get ID from URL parameter
-	idStr := chi.URLParam(r, "id")
-	id, err := strconv.ParseInt(idStr, 10, 32)
+	id, err := idParam(r)
 	if err != nil {
-		h.logger.Warn("Invalid competency ID format", "id", idStr, "error", err)
-		h.responseWriter.Error(w, dto.ValidationError{
-			Field:   "id",
-			Message: "invalid ID format",
-		})
+		h.logger.WarnContext(r.Context(), "Invalid competency ID format", "id", chi.URLParam(r, "id"), "error", err)
+		h.responseWriter.Error(w, r, err)
 		return
 	}
 
-	// Parse request body
+	// Version the update is based on
+	expectedVersion, err := ifMatchVersion(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid If-Match on competency update", "id", id, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Decode and validate request body
 	var req dto.UpdateCompetencyDescriptionRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Warn("Failed to decode update competency description request", "error", err)
-		h.responseWriter.Error(w, ErrInvalidJSON)
+	if err := h.binder.Bind(w, r, &req); err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid update competency description request", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	competency, err := h.competencyUseCase.UpdateDescription(r.Context(), id, req.Description, expectedVersion)
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to update competency description", "id", id, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Build response
+	competencyDTO, err := ToCompetencyDTO(competency, h.logger)
+	if err != nil {
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "Competency description updated successfully", "competency_id", competency.ID)
+	w.Header().Set("ETag", competencyETag(competency, domain.CompetencyInclude{}))
+	h.responseWriter.Success(w, competencyDTO)
+}
+
+// Rename handles rename competency requests
+// PATCH /api/v1/competencies/{id}/name
+// Admins and stewards of the competency only
+// Requires If-Match with the ETag of the version being renamed ("*" renames any version)
+// HTTP Status Codes:
+//   - 200 OK: Competency renamed successfully
+//   - 400 Bad Request: Invalid ID format or name
+//   - 401 Unauthorized: Anonymous request
+//   - 403 Forbidden: The user is neither an admin nor a steward of the competency
+//   - 404 Not Found: Competency not found
+//   - 409 Conflict: Another competency has the name
+//   - 412 Precondition Failed: The competency was changed since the ETag was read
+//   - 428 Precondition Required: If-Match is missing
+//   - 500 Internal Server Error: Unexpected errors
+func (h *CompetencyHandler) Rename(w http.ResponseWriter, r *http.Request) {
+	// Get ID from URL parameter
+	id, err := idParam(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid competency ID format", "id", chi.URLParam(r, "id"), "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Version the rename is based on
+	expectedVersion, err := ifMatchVersion(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid If-Match on competency rename", "id", id, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Decode and validate request body
+	var req dto.RenameCompetencyRequest
+	if err := h.binder.Bind(w, r, &req); err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid rename competency request", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	competency, err := h.competencyUseCase.Rename(r.Context(), id, req.Name, expectedVersion)
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to rename competency", "id", id, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.respondWithCompetency(w, r, competency, "Competency renamed successfully")
+}
+
+// Archive handles archive competency requests
+// POST /api/v1/competencies/{id}/archive
+// Admins and stewards of the competency only
+// Archived competencies stay readable by ID but are hidden from listings (unless include_archived=true) and search
+// HTTP Status Codes:
+//   - 200 OK: Competency archived (or already archived)
+//   - 400 Bad Request: Invalid ID format
+//   - 401 Unauthorized: Anonymous request
+//   - 403 Forbidden: The user is neither an admin nor a steward of the competency
+//   - 404 Not Found: Competency not found
+//   - 500 Internal Server Error: Unexpected errors
+func (h *CompetencyHandler) Archive(w http.ResponseWriter, r *http.Request) {
+	// Get ID from URL parameter
+	id, err := idParam(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid competency ID format", "id", chi.URLParam(r, "id"), "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	competency, err := h.competencyUseCase.Archive(r.Context(), id)
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to archive competency", "id", id, "error", err)
+		h.responseWriter.Error(w, r, err)
 		return
 	}
 
-	// Validate request (currently no validation needed, but keeping for consistency)
-	if err := req.Validate(); err != nil {
-		h.logger.Warn("Update competency description request validation failed", "error", err)
-		h.responseWriter.Error(w, err)
+	h.respondWithCompetency(w, r, competency, "Competency archived successfully")
+}
+
+// Restore handles restore competency requests
+// POST /api/v1/competencies/{id}/restore
+// Admins and stewards of the competency only
+// HTTP Status Codes:
+//   - 200 OK: Competency restored (or wasn't archived)
+//   - 400 Bad Request: Invalid ID format
+//   - 401 Unauthorized: Anonymous request
+//   - 403 Forbidden: The user is neither an admin nor a steward of the competency
+//   - 404 Not Found: Competency not found
+//   - 500 Internal Server Error: Unexpected errors
+func (h *CompetencyHandler) Restore(w http.ResponseWriter, r *http.Request) {
+	// Get ID from URL parameter
+	id, err := idParam(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid competency ID format", "id", chi.URLParam(r, "id"), "error", err)
+		h.responseWriter.Error(w, r, err)
 		return
 	}
 
 	// Call use case
-	competency, err := h.competencyUseCase.UpdateDescription(r.Context(), int32(id), req.Description)
+	competency, err := h.competencyUseCase.Restore(r.Context(), id)
 	if err != nil {
-		h.logger.Error("Failed to update competency description", "id", id, "error", err)
-		h.responseWriter.Error(w, err)
+		h.logger.ErrorContext(r.Context(), "Failed to restore competency", "id", id, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.respondWithCompetency(w, r, competency, "Competency restored successfully")
+}
+
+// SetCategory handles requests placing a competency under a category
+// PUT /api/v1/competencies/{id}/category
+// Admins and stewards of the competency only
+// A null category_id makes the competency uncategorized
+// HTTP Status Codes:
+//   - 200 OK: Category set
+//   - 400 Bad Request: Invalid ID format or body
+//   - 401 Unauthorized: Anonymous request
+//   - 403 Forbidden: The user is neither an admin nor a steward of the competency
+//   - 404 Not Found: Competency or category not found
+//   - 500 Internal Server Error: Unexpected errors
+func (h *CompetencyHandler) SetCategory(w http.ResponseWriter, r *http.Request) {
+	// Get ID from URL parameter
+	id, err := idParam(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid competency ID format", "id", chi.URLParam(r, "id"), "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Decode and validate request body
+	var req dto.SetCompetencyCategoryRequest
+	if err := h.binder.Bind(w, r, &req); err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid set competency category request", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	competency, err := h.competencyUseCase.SetCategory(r.Context(), id, req.CategoryID)
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to set competency category", "id", id, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.respondWithCompetency(w, r, competency, "Competency category set successfully")
+}
+
+// History handles competency history requests
+// GET /api/v1/competencies/{id}/history
+// Revisions are listed newest first; pass next_before as before to get the next page
+// HTTP Status Codes:
+//   - 200 OK: History retrieved
+//   - 400 Bad Request: Invalid ID format or query parameters
+//   - 404 Not Found: Competency not found
+//   - 500 Internal Server Error: Unexpected errors
+func (h *CompetencyHandler) History(w http.ResponseWriter, r *http.Request) {
+	// Get ID from URL parameter
+	id, err := idParam(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid competency ID format", "id", chi.URLParam(r, "id"), "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Decode and validate query parameters
+	var query dto.CompetencyHistoryQuery
+	if err := request.BindQuery(r.URL.Query(), &query); err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid competency history query", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	revisions, err := h.competencyUseCase.History(r.Context(), id, valueOr(query.Before, 0), valueOr(query.Limit, 0))
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to get competency history", "id", id, "error", err)
+		h.responseWriter.Error(w, r, err)
 		return
 	}
 
 	// Build response
+	revisionDTOs, err := ToCompetencyRevisionDTOs(revisions, h.logger)
+	if err != nil {
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+	history := dto.CompetencyHistoryResponse{Revisions: revisionDTOs}
+	if last := len(revisions) - 1; last >= 0 && revisions[last].Revision > 1 {
+		history.NextBefore = &revisions[last].Revision
+	}
+
+	h.responseWriter.Success(w, history)
+}
+
+// Diff handles requests comparing two revisions of a competency
+// GET /api/v1/competencies/{id}/history/diff?from={revision}&to={revision}
+// HTTP Status Codes:
+//   - 200 OK: Changes from the state of one revision to the other
+//   - 400 Bad Request: Invalid ID format or revisions
+//   - 404 Not Found: Competency or revision not found
+//   - 500 Internal Server Error: Unexpected errors
+func (h *CompetencyHandler) Diff(w http.ResponseWriter, r *http.Request) {
+	// Get ID from URL parameter
+	id, err := idParam(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid competency ID format", "id", chi.URLParam(r, "id"), "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Decode and validate query parameters
+	var query dto.CompetencyDiffQuery
+	if err := request.BindQuery(r.URL.Query(), &query); err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid competency diff query", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	diff, err := h.competencyUseCase.Diff(r.Context(), id, *query.From, *query.To)
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to diff competency revisions", "id", id, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Build response
+	diffResponse, err := ToCompetencyDiffResponse(diff, h.logger)
+	if err != nil {
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.responseWriter.Success(w, diffResponse)
+}
+
+// Revert handles requests setting a competency back to the state of one of its revisions
+// POST /api/v1/competencies/{id}/revert/{rev}
+// Admins and stewards of the competency only
+// Requires If-Match with the ETag of the version being reverted ("*" reverts any version)
+// The revert is recorded as a new revision
+// HTTP Status Codes:
+//   - 200 OK: Competency reverted
+//   - 400 Bad Request: Invalid ID or revision format
+//   - 401 Unauthorized: Anonymous request
+//   - 403 Forbidden: The user is neither an admin nor a steward of the competency
+//   - 404 Not Found: Competency, revision or the category of the revision not found
+//   - 409 Conflict: Another competency has the name of the revision
+//   - 412 Precondition Failed: The competency was changed since the ETag was read
+//   - 428 Precondition Required: If-Match is missing
+//   - 500 Internal Server Error: Unexpected errors
+func (h *CompetencyHandler) Revert(w http.ResponseWriter, r *http.Request) {
+	// Get ID and revision from URL parameters
+	id, revision, err := revisionParams(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid competency revision format", "id", chi.URLParam(r, "id"), "rev", chi.URLParam(r, "rev"), "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Version the revert is based on
+	expectedVersion, err := ifMatchVersion(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid If-Match on competency revert", "id", id, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	competency, err := h.competencyUseCase.Revert(r.Context(), id, revision, expectedVersion)
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to revert competency", "id", id, "revision", revision, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.respondWithCompetency(w, r, competency, "Competency reverted successfully")
+}
+
+// Delete handles delete competency requests
+// DELETE /api/v1/competencies/{id}
+// Admins only
+// Deletion is permanent; competencies that other data references can only be archived
+// HTTP Status Codes:
+//   - 204 No Content: Competency deleted
+//   - 400 Bad Request: Invalid ID format
+//   - 401 Unauthorized: Anonymous request
+//   - 403 Forbidden: The user isn't an admin
+//   - 404 Not Found: Competency not found
+//   - 409 Conflict: Edges, merged IDs or a rubric still reference the competency
+//   - 500 Internal Server Error: Unexpected errors
+func (h *CompetencyHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	// Get ID from URL parameter
+	id, err := idParam(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid competency ID format", "id", chi.URLParam(r, "id"), "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	if err := h.competencyUseCase.Delete(r.Context(), id); err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to delete competency", "id", id, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "Competency deleted successfully", "competency_id", id)
+	h.responseWriter.NoContent(w)
+}
+
+// respondWithCompetency sends a changed competency with its new ETag
+func (h *CompetencyHandler) respondWithCompetency(w http.ResponseWriter, r *http.Request, competency *domain.Competency, message string) {
 	competencyDTO, err := ToCompetencyDTO(competency, h.logger)
 	if err != nil {
-		h.responseWriter.Error(w, err)
+		h.responseWriter.Error(w, r, err)
 		return
 	}
 
-	h.logger.Info("Competency description updated successfully", "competency_id", competency.ID)
+	h.logger.InfoContext(r.Context(), message, "competency_id", competency.ID)
+	w.Header().Set("ETag", competencyETag(competency, domain.CompetencyInclude{}))
 	h.responseWriter.Success(w, competencyDTO)
 }
+
+// idParam returns the ID of the {id} URL parameter
+func idParam(r *http.Request) (int32, error) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 32)
+	if err != nil {
+		return 0, dto.ValidationError{
+			Field:   "id",
+			Message: "invalid ID format",
+		}
+	}
+	return int32(id), nil
+}
+
+// revisionParams returns the competency ID and revision of the {id} and {rev} URL parameters
+func revisionParams(r *http.Request) (int32, int32, error) {
+	id, err := idParam(r)
+	if err != nil {
+		return 0, 0, err
+	}
+	revision, err := strconv.ParseInt(chi.URLParam(r, "rev"), 10, 32)
+	if err != nil || revision <= 0 {
+		return 0, 0, dto.ValidationError{
+			Field:   "rev",
+			Message: "invalid revision format",
+		}
+	}
+	return id, int32(revision), nil
+}
+
+// registerCompetencyProblems registers the problem details of the competency errors
+func registerCompetencyProblems(reg *response.Registry) {
+	reg.Register(domain.ErrCompetencyNotFound, response.Problem{
+		Status: http.StatusNotFound,
+		Code:   "competency_not_found",
+		Title:  "Competency not found",
+		Detail: "Competency not found",
+	})
+	reg.Register(domain.ErrCompetencyAlreadyExists, response.Problem{
+		Status: http.StatusConflict,
+		Code:   "competency_already_exists",
+		Title:  "Competency already exists",
+		Detail: "A competency with this name already exists",
+	})
+	reg.Register(domain.ErrInvalidCompetencyName, response.Problem{
+		Status: http.StatusBadRequest,
+		Code:   "invalid_competency_name",
+		Title:  "Invalid competency name",
+		Detail: "Invalid competency name (must be 2-100 characters)",
+	})
+	reg.Register(domain.ErrCompetencyVersionConflict, response.Problem{
+		Status: http.StatusPreconditionFailed,
+		Code:   "competency_version_conflict",
+		Title:  "Competency was modified",
+		Detail: "The competency was changed since it was read; fetch it again and retry with its new ETag",
+	})
+	reg.Register(domain.ErrCompetencyInUse, response.Problem{
+		Status: http.StatusConflict,
+		Code:   "competency_in_use",
+		Title:  "Competency in use",
+		Detail: "The competency is still referenced and can't be deleted; archive it instead",
+	})
+	reg.Register(domain.ErrInvalidCompetencySort, response.Problem{
+		Status: http.StatusBadRequest,
+		Code:   "invalid_competency_sort",
+		Title:  "Invalid sort",
+		Detail: "Competencies can be sorted by name, created_at or updated_at, in asc or desc direction",
+	})
+	reg.Register(domain.ErrInvalidCompetencyCursor, response.Problem{
+		Status: http.StatusBadRequest,
+		Code:   "invalid_cursor",
+		Title:  "Invalid cursor",
+		Detail: "The cursor is malformed or was issued for another sort order; start again from the first page",
+	})
+	reg.Register(domain.ErrInvalidSearchQuery, response.Problem{
+		Status: http.StatusBadRequest,
+		Code:   "invalid_search_query",
+		Title:  "Invalid search query",
+		Detail: "The search text must not be blank",
+	})
+	reg.Register(domain.ErrInvalidCompetencyBatch, response.Problem{
+		Status: http.StatusBadRequest,
+		Code:   "invalid_competency_batch",
+		Title:  "Invalid competency batch",
+		Detail: "A batch must contain 1 to 500 items, with mode all_or_nothing or best_effort",
+	})
+	reg.Register(domain.ErrCompetencyBatchRejected, response.Problem{
+		Status: http.StatusUnprocessableEntity,
+		Code:   "competency_batch_rejected",
+		Title:  "Competency batch rejected",
+		Detail: "No competency was created because the items listed in errors are duplicates or invalid; fix them or retry with mode=best_effort",
+	})
+	reg.Register(domain.ErrRevisionNotFound, response.Problem{
+		Status: http.StatusNotFound,
+		Code:   "revision_not_found",
+		Title:  "Revision not found",
+		Detail: "The competency has no revision with this number",
+	})
+}
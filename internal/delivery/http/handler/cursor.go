@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+)
+
+// competencyCursor is the JSON payload of the opaque next_cursor of competency listings
+type competencyCursor struct {
+	Sort      domain.CompetencySort `json:"s"`
+	Direction domain.SortDirection  `json:"d"`
+	ID        int32                 `json:"id"`
+	Name      string                `json:"n,omitempty"`
+	Time      time.Time             `json:"t,omitzero"`
+}
+
+// encodeCompetencyCursor encodes a cursor as an opaque URL-safe string
+func encodeCompetencyCursor(cursor *domain.CompetencyCursor) (string, error) {
+	payload, err := json.Marshal(competencyCursor(*cursor))
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(payload), nil
+}
+
+// decodeCompetencyCursor decodes a cursor returned by encodeCompetencyCursor
+// Returns domain.ErrInvalidCompetencyCursor if it was tampered with
+func decodeCompetencyCursor(s string) (*domain.CompetencyCursor, error) {
+	payload, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, domain.ErrInvalidCompetencyCursor
+	}
+	var cursor competencyCursor
+	if err := json.Unmarshal(payload, &cursor); err != nil || cursor.ID <= 0 {
+		return nil, domain.ErrInvalidCompetencyCursor
+	}
+	return (*domain.CompetencyCursor)(&cursor), nil
+}
@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"encoding/base64"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+)
+
+// TestCompetencyCursorRoundTrip checks that decoding an encoded cursor gives it back
+func TestCompetencyCursorRoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		cursor domain.CompetencyCursor
+	}{
+		{
+			name:   "by name",
+			cursor: domain.CompetencyCursor{Sort: domain.CompetencySortName, Direction: domain.SortAscending, ID: 7, Name: "Go"},
+		},
+		{
+			name:   "by name with non-ASCII characters",
+			cursor: domain.CompetencyCursor{Sort: domain.CompetencySortName, Direction: domain.SortDescending, ID: 1, Name: "Résumé écrit/?&="},
+		},
+		{
+			name: "by time",
+			cursor: domain.CompetencyCursor{
+				Sort: domain.CompetencySortCreatedAt, Direction: domain.SortDescending, ID: 42,
+				Time: time.Date(2026, 10, 18, 9, 30, 15, 123456000, time.UTC),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded, err := encodeCompetencyCursor(&tt.cursor)
+			if err != nil {
+				t.Fatalf("encode: %v", err)
+			}
+			if _, err := base64.RawURLEncoding.DecodeString(encoded); err != nil {
+				t.Errorf("cursor %q is not URL-safe base64: %v", encoded, err)
+			}
+			decoded, err := decodeCompetencyCursor(encoded)
+			if err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+			if !decoded.Time.Equal(tt.cursor.Time) {
+				t.Errorf("time = %v, want %v", decoded.Time, tt.cursor.Time)
+			}
+			decoded.Time = tt.cursor.Time
+			if !reflect.DeepEqual(*decoded, tt.cursor) {
+				t.Errorf("decoded = %+v, want %+v", *decoded, tt.cursor)
+			}
+		})
+	}
+}
+
+// TestDecodeCompetencyCursorRejectsTamperedCursors checks that cursors not issued by encodeCompetencyCursor are refused
+func TestDecodeCompetencyCursorRejectsTamperedCursors(t *testing.T) {
+	encode := func(payload string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(payload))
+	}
+	tests := []struct {
+		name   string
+		cursor string
+	}{
+		{name: "empty", cursor: ""},
+		{name: "not base64", cursor: "not a cursor!"},
+		{name: "padded base64", cursor: base64.URLEncoding.EncodeToString([]byte(`{"s":"name","d":"asc","id":1}`))},
+		{name: "not JSON", cursor: encode("id=1")},
+		{name: "JSON array", cursor: encode(`[1]`)},
+		{name: "missing ID", cursor: encode(`{"s":"name","d":"asc","n":"Go"}`)},
+		{name: "zero ID", cursor: encode(`{"s":"name","d":"asc","id":0}`)},
+		{name: "negative ID", cursor: encode(`{"s":"name","d":"asc","id":-5}`)},
+		{name: "ID of the wrong type", cursor: encode(`{"s":"name","d":"asc","id":"5"}`)},
+		{name: "bad time", cursor: encode(`{"s":"created_at","d":"asc","id":5,"t":"yesterday"}`)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cursor, err := decodeCompetencyCursor(tt.cursor)
+			if !errors.Is(err, domain.ErrInvalidCompetencyCursor) {
+				t.Errorf("decode(%q) = %+v, %v; want ErrInvalidCompetencyCursor", tt.cursor, cursor, err)
+			}
+		})
+	}
+}
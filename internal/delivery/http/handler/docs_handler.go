@@ -0,0 +1,44 @@
+package handler
+
+import (
+	_ "embed"
+	"fmt"
+	"net/http"
+)
+
+// docsPage is the bundled API docs UI, rendering the OpenAPI document in the browser without external assets
+//
+//go:embed docs.html
+var docsPage []byte
+
+// DocsHandler serves the OpenAPI document and its docs UI
+type DocsHandler struct {
+	spec []byte
+}
+
+// NewDocsHandler creates a new docs handler serving the given (JSON encoded) OpenAPI document
+func NewDocsHandler(spec []byte) (*DocsHandler, error) {
+	if len(spec) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "spec can not be empty")
+	}
+	return &DocsHandler{
+		spec: spec,
+	}, nil
+}
+
+// Spec serves the OpenAPI document
+// GET /api/openapi.json
+func (h *DocsHandler) Spec(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(h.spec)
+}
+
+// UI serves the docs UI
+// GET /api/docs
+func (h *DocsHandler) UI(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Content-Security-Policy", "default-src 'self'; script-src 'unsafe-inline' 'self'; style-src 'unsafe-inline'")
+	w.WriteHeader(http.StatusOK)
+	w.Write(docsPage)
+}
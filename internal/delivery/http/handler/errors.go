@@ -1,19 +1,15 @@
 package handler
 
-import (
-	"errors"
-
-	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/dto"
-)
+import "errors"
 
 var (
 
 	// ErrInvalidDependencies is returned when the dependencies are nil
 	ErrInvalidDependencies = errors.New("invalid dependencies")
 
-	// ErrInvalidJSON is a response returned when the request body cannot be decoded
-	ErrInvalidJSON = dto.ValidationError{
-		Field:   "body",
-		Message: "invalid JSON format",
-	}
+	// ErrPreconditionRequired is responded when an update is sent without If-Match
+	ErrPreconditionRequired = errors.New("precondition required")
+
+	// ErrUnsupportedImportFormat is responded when the format of an imported file is neither given nor recognized
+	ErrUnsupportedImportFormat = errors.New("unsupported import format")
 )
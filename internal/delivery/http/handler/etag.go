@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/dto"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+)
+
+// competencyETag returns the strong entity tag of a competency, derived from its version
+// Representations sharing the version are told apart by their locale and the related data they embed
+// ("7-de", "7-levels", "7-de-levels"), so a tag of one never validates a cached copy of another
+func competencyETag(competency *domain.Competency, include domain.CompetencyInclude) string {
+	tag := strconv.FormatInt(int64(competency.Version), 10)
+	if competency.Locale != "" && competency.Locale != domain.FallbackLocale {
+		tag += "-" + string(competency.Locale)
+	}
+	if include.Levels {
+		tag += "-levels"
+	}
+	return `"` + tag + `"`
+}
+
+// etagMatches reports whether an If-None-Match header matches etag (weak comparison, RFC 9110 13.1.2)
+func etagMatches(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
+
+// ifMatchVersion returns the version an update is based on, from its If-Match header
+// Behaviour:
+//   - Missing header: ErrPreconditionRequired, so clients can't overwrite changes they haven't seen
+//   - "*": domain.AnyVersion, the update applies to whatever version exists
+//   - A single strong ETag: its version, whatever locale and related data it was read with
+//   - Weak or foreign ETags: domain.ErrCompetencyVersionConflict, since they never match strongly
+func ifMatchVersion(r *http.Request) (int32, error) {
+	header := strings.TrimSpace(r.Header.Get("If-Match"))
+	if header == "" {
+		return 0, ErrPreconditionRequired
+	}
+	if header == "*" {
+		return domain.AnyVersion, nil
+	}
+	if strings.Contains(header, ",") {
+		return 0, dto.ValidationError{
+			Field:   "If-Match",
+			Message: "must contain a single ETag",
+		}
+	}
+
+	unquoted, ok := strings.CutPrefix(header, `"`)
+	if !ok {
+		return 0, domain.ErrCompetencyVersionConflict
+	}
+	unquoted, ok = strings.CutSuffix(unquoted, `"`)
+	if !ok {
+		return 0, domain.ErrCompetencyVersionConflict
+	}
+	unquoted, _, _ = strings.Cut(unquoted, "-")
+	version, err := strconv.ParseInt(unquoted, 10, 32)
+	if err != nil || int32(version) == domain.AnyVersion {
+		return 0, domain.ErrCompetencyVersionConflict
+	}
+	return int32(version), nil
+}
@@ -0,0 +1,174 @@
+package handler
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/dto"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/request"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/response"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+)
+
+// FrameworkHandler handles framework version HTTP requests
+type FrameworkHandler struct {
+	frameworkUseCase domain.FrameworkUseCase
+	binder           *request.Binder
+	logger           *slog.Logger
+	responseWriter   *response.Writer
+}
+
+// NewFrameworkHandler creates a new framework handler instance
+func NewFrameworkHandler(frameworkUseCase domain.FrameworkUseCase, binder *request.Binder, logger *slog.Logger, responseWriter *response.Writer) (*FrameworkHandler, error) {
+	// Check if dependencies are nil
+	if frameworkUseCase == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "frameworkUseCase can not be nil")
+	}
+	if binder == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "binder can not be nil")
+	}
+	if logger == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "logger can not be nil")
+	}
+	if responseWriter == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "responseWriter can not be nil")
+	}
+	return &FrameworkHandler{
+		frameworkUseCase: frameworkUseCase,
+		binder:           binder,
+		logger:           logger,
+		responseWriter:   responseWriter,
+	}, nil
+}
+
+// Publish handles requests publishing the current framework as an immutable version
+// POST /api/v1/framework/versions
+// Admins only
+// HTTP Status Codes:
+//   - 201 Created: Version published, without its content
+//   - 400 Bad Request: Invalid request body or version name
+//   - 401 Unauthorized: Anonymous request
+//   - 403 Forbidden: The user isn't an admin
+//   - 409 Conflict: A version with this name already exists
+//   - 500 Internal Server Error: Unexpected errors
+func (h *FrameworkHandler) Publish(w http.ResponseWriter, r *http.Request) {
+	// Decode and validate request body
+	var req dto.PublishFrameworkRequest
+	if err := h.binder.Bind(w, r, &req); err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid publish framework request", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	version, err := h.frameworkUseCase.Publish(r.Context(), req.Name, req.Notes)
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to publish framework", "name", req.Name, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "Framework published successfully", "name", version.Name, "competencies", version.CompetencyCount)
+	h.responseWriter.Created(w, ToFrameworkVersionDTO(version))
+}
+
+// GetVersions handles requests listing the published framework versions
+// GET /api/v1/framework/versions
+// HTTP Status Codes:
+//   - 200 OK: Published versions, latest first (possibly empty)
+//   - 500 Internal Server Error: Unexpected errors
+func (h *FrameworkHandler) GetVersions(w http.ResponseWriter, r *http.Request) {
+	// Call use case
+	versions, err := h.frameworkUseCase.GetVersions(r.Context())
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to get framework versions", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "Framework versions retrieved successfully", "count", len(versions))
+	h.responseWriter.Success(w, dto.FrameworkVersionsResponse{Versions: ToFrameworkVersionDTOs(versions)})
+}
+
+// GetVersion handles requests for a framework version with its categories and competencies
+// GET /api/v1/framework/versions/{name}
+// The name "draft" gets the current, unpublished framework
+// HTTP Status Codes:
+//   - 200 OK: Version with its content
+//   - 404 Not Found: Version not found
+//   - 500 Internal Server Error: Unexpected errors
+func (h *FrameworkHandler) GetVersion(w http.ResponseWriter, r *http.Request) {
+	name := chi.URLParam(r, "name")
+
+	// Call use case
+	version, err := h.frameworkUseCase.GetVersion(r.Context(), name)
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to get framework version", "name", name, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Build response
+	versionResponse, err := ToFrameworkVersionResponse(version, h.logger)
+	if err != nil {
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.responseWriter.Success(w, versionResponse)
+}
+
+// Diff handles requests comparing two framework versions
+// GET /api/v1/framework/diff?from=2026.1&to=2026.2
+// to defaults to the draft
+// HTTP Status Codes:
+//   - 200 OK: Categories and competencies added, removed or changed from one version to the other
+//   - 400 Bad Request: Invalid query parameters
+//   - 404 Not Found: Version not found
+//   - 500 Internal Server Error: Unexpected errors
+func (h *FrameworkHandler) Diff(w http.ResponseWriter, r *http.Request) {
+	// Decode and validate query parameters
+	var query dto.FrameworkDiffQuery
+	if err := request.BindQuery(r.URL.Query(), &query); err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid framework diff query", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+	if query.To == "" {
+		query.To = domain.DraftFrameworkVersion
+	}
+
+	// Call use case
+	diff, err := h.frameworkUseCase.Diff(r.Context(), query.From, query.To)
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to diff framework versions", "from", query.From, "to", query.To, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.responseWriter.Success(w, ToFrameworkDiffResponse(diff))
+}
+
+// registerFrameworkProblems registers the problem details of the framework version errors
+func registerFrameworkProblems(reg *response.Registry) {
+	reg.Register(domain.ErrFrameworkVersionNotFound, response.Problem{
+		Status: http.StatusNotFound,
+		Code:   "framework_version_not_found",
+		Title:  "Framework version not found",
+		Detail: "The requested framework version does not exist",
+	})
+	reg.Register(domain.ErrFrameworkVersionAlreadyExists, response.Problem{
+		Status: http.StatusConflict,
+		Code:   "framework_version_already_exists",
+		Title:  "Framework version already exists",
+		Detail: "A framework version with this name has already been published",
+	})
+	reg.Register(domain.ErrInvalidFrameworkVersion, response.Problem{
+		Status: http.StatusBadRequest,
+		Code:   "invalid_framework_version",
+		Title:  "Invalid framework version",
+		Detail: "A version name has at most 32 letters, digits, dots, dashes or underscores, starts with a letter or digit and isn't \"draft\"",
+	})
+}
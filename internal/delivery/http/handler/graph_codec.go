@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+)
+
+// Prerequisite graph formats
+const (
+	graphDOT     = "dot"
+	graphMermaid = "mermaid"
+)
+
+// graphContentTypes are the media types of the graph formats in responses
+var graphContentTypes = map[string]string{
+	graphDOT:     "text/vnd.graphviz; charset=utf-8",
+	graphMermaid: "text/plain; charset=utf-8",
+}
+
+// writeGraph renders graph in format to w
+// Edges point from a competency to its prerequisite; related competencies are linked by a dashed, undirected edge
+func writeGraph(w io.Writer, graph *domain.CompetencyGraph, format string) error {
+	if format == graphMermaid {
+		return writeMermaidGraph(w, graph)
+	}
+	return writeDOTGraph(w, graph)
+}
+
+// writeDOTGraph renders graph as a Graphviz digraph
+func writeDOTGraph(w io.Writer, graph *domain.CompetencyGraph) error {
+	var b strings.Builder
+	b.WriteString("digraph competencies {\n")
+	b.WriteString("  rankdir=BT;\n")
+	b.WriteString("  node [shape=box];\n")
+	for _, node := range graph.Nodes {
+		fmt.Fprintf(&b, "  c%d [label=%s];\n", node.ID, strconv.Quote(node.Name))
+	}
+	for _, edge := range graph.Edges {
+		if edge.Kind == domain.RelationRelated {
+			fmt.Fprintf(&b, "  c%d -> c%d [style=dashed, dir=none];\n", edge.From, edge.To)
+			continue
+		}
+		fmt.Fprintf(&b, "  c%d -> c%d;\n", edge.From, edge.To)
+	}
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// mermaidLabelEscaper escapes the characters Mermaid doesn't accept in quoted labels
+var mermaidLabelEscaper = strings.NewReplacer(`"`, "#quot;", "\n", " ")
+
+// writeMermaidGraph renders graph as a Mermaid flowchart
+func writeMermaidGraph(w io.Writer, graph *domain.CompetencyGraph) error {
+	var b strings.Builder
+	b.WriteString("flowchart BT\n")
+	for _, node := range graph.Nodes {
+		fmt.Fprintf(&b, "  c%d[\"%s\"]\n", node.ID, mermaidLabelEscaper.Replace(node.Name))
+	}
+	for _, edge := range graph.Edges {
+		if edge.Kind == domain.RelationRelated {
+			fmt.Fprintf(&b, "  c%d -.- c%d\n", edge.From, edge.To)
+			continue
+		}
+		fmt.Fprintf(&b, "  c%d --> c%d\n", edge.From, edge.To)
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
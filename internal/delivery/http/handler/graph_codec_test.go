@@ -0,0 +1,74 @@
+package handler
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+)
+
+// TestWriteGraph checks both formats, and that competency names can't break out of their labels
+func TestWriteGraph(t *testing.T) {
+	graph := &domain.CompetencyGraph{
+		Nodes: []*domain.CompetencyNode{
+			{ID: 1, Name: "Go"},
+			{ID: 2, Name: `Say "hi"`},
+			{ID: 3, Name: "Line\nbreak"},
+			{ID: 4, Name: `C:\path`},
+			{ID: 5, Name: `x"]; c1 -> c2 [label="pwned`},
+			{ID: 6, Name: "Sécurité"},
+		},
+		Edges: []domain.CompetencyEdge{
+			{From: 1, To: 2, Kind: domain.RelationRequires},
+			{From: 3, To: 4, Kind: domain.RelationRelated},
+		},
+	}
+
+	tests := []struct {
+		format string
+		want   []string
+	}{
+		{
+			format: graphDOT,
+			want: []string{
+				"digraph competencies {\n",
+				"  rankdir=BT;\n",
+				"  node [shape=box];\n",
+				"  c1 [label=\"Go\"];\n",
+				"  c2 [label=\"Say \\\"hi\\\"\"];\n",
+				"  c3 [label=\"Line\\nbreak\"];\n",
+				"  c4 [label=\"C:\\\\path\"];\n",
+				"  c5 [label=\"x\\\"]; c1 -> c2 [label=\\\"pwned\"];\n",
+				"  c6 [label=\"Sécurité\"];\n",
+				"  c1 -> c2;\n",
+				"  c3 -> c4 [style=dashed, dir=none];\n",
+				"}\n",
+			},
+		},
+		{
+			format: graphMermaid,
+			want: []string{
+				"flowchart BT\n",
+				"  c1[\"Go\"]\n",
+				"  c2[\"Say #quot;hi#quot;\"]\n",
+				"  c3[\"Line break\"]\n",
+				"  c4[\"C:\\path\"]\n",
+				"  c5[\"x#quot;]; c1 -> c2 [label=#quot;pwned\"]\n",
+				"  c6[\"Sécurité\"]\n",
+				"  c1 --> c2\n",
+				"  c3 -.- c4\n",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			var b strings.Builder
+			if err := writeGraph(&b, graph, tt.format); err != nil {
+				t.Fatalf("writeGraph: %v", err)
+			}
+			if got, want := b.String(), strings.Join(tt.want, ""); got != want {
+				t.Errorf("writeGraph(%s) =\n%s\nwant\n%s", tt.format, got, want)
+			}
+		})
+	}
+}
@@ -1,8 +1,11 @@
 package handler
 
 import (
+	"errors"
 	"fmt"
+	"html"
 	"log/slog"
+	"strings"
 
 	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/dto"
 	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
@@ -30,13 +33,25 @@ func ToCompetencyDTO(competency *domain.Competency, l *slog.Logger) (dto.Compete
 		return dto.CompetencyDTO{}, fmt.Errorf("cannot convert nil domain competency to DTO")
 	}
 
-	return dto.CompetencyDTO{
+	competencyDTO := dto.CompetencyDTO{
 		ID:          competency.ID,
 		Name:        competency.Name,
 		Description: competency.Description,
 		CreatedAt:   competency.CreatedAt,
 		UpdatedAt:   competency.UpdatedAt,
-	}, nil
+		Version:     competency.Version,
+		ArchivedAt:  competency.ArchivedAt,
+		CategoryID:  competency.CategoryID,
+		Locale:      string(competency.Locale),
+	}
+	if competency.Rubric != nil {
+		rubricDTO, err := ToCompetencyRubricDTO(competency.Rubric, l)
+		if err != nil {
+			return dto.CompetencyDTO{}, err
+		}
+		competencyDTO.Rubric = &rubricDTO
+	}
+	return competencyDTO, nil
 }
 
 // ToCompetencyDTOs converts a slice of domain.Competency to a slice of CompetencyDTO
@@ -56,3 +71,752 @@ func ToCompetencyDTOs(competencies []*domain.Competency, l *slog.Logger) ([]dto.
 
 	return dtos, nil
 }
+
+// highlightReplacer turns the domain highlight markers into <mark> elements
+var highlightReplacer = strings.NewReplacer(domain.HighlightStart, "<mark>", domain.HighlightEnd, "</mark>")
+
+// toHighlightHTML escapes highlighted text and marks the matched terms up
+func toHighlightHTML(text string) string {
+	return highlightReplacer.Replace(html.EscapeString(text))
+}
+
+// ToCompetencySearchResultDTOs converts search results to CompetencySearchResultDTOs
+func ToCompetencySearchResultDTOs(results []*domain.CompetencySearchResult, l *slog.Logger) ([]dto.CompetencySearchResultDTO, error) {
+	dtos := make([]dto.CompetencySearchResultDTO, len(results))
+	for i, result := range results {
+		competencyDTO, err := ToCompetencyDTO(result.Competency, l)
+		if err != nil {
+			return nil, err
+		}
+		dtos[i] = dto.CompetencySearchResultDTO{
+			Competency: competencyDTO,
+			Rank:       result.Rank,
+			Highlight: dto.CompetencyHighlightDTO{
+				Name:        toHighlightHTML(result.Name),
+				Description: toHighlightHTML(result.Snippet),
+			},
+		}
+	}
+
+	return dtos, nil
+}
+
+// ToCompetencySuggestionDTOs converts typeahead suggestions to CompetencySuggestionDTOs
+func ToCompetencySuggestionDTOs(suggestions []*domain.CompetencySuggestion) []dto.CompetencySuggestionDTO {
+	dtos := make([]dto.CompetencySuggestionDTO, len(suggestions))
+	for i, suggestion := range suggestions {
+		dtos[i] = dto.CompetencySuggestionDTO{ID: suggestion.ID, Name: suggestion.Name}
+	}
+	return dtos
+}
+
+// ToCompetencyBatchResponse converts batch results to a CompetencyBatchResponse
+func ToCompetencyBatchResponse(mode domain.CompetencyBatchMode, results []*domain.CompetencyBatchResult, l *slog.Logger) (dto.CompetencyBatchResponse, error) {
+	resp := dto.CompetencyBatchResponse{
+		Mode:    string(mode),
+		Results: make([]dto.CompetencyBatchResultDTO, len(results)),
+	}
+	for i, result := range results {
+		item := dto.CompetencyBatchResultDTO{Index: i, Status: string(result.Status)}
+		if result.Competency != nil {
+			competencyDTO, err := ToCompetencyDTO(result.Competency, l)
+			if err != nil {
+				return dto.CompetencyBatchResponse{}, err
+			}
+			item.Competency = &competencyDTO
+			resp.Created++
+		} else {
+			item.Reason = batchItemReason(result.Err)
+			resp.Failed++
+		}
+		resp.Results[i] = item
+	}
+	return resp, nil
+}
+
+// batchItemReason explains to the client why a batch item wasn't created
+func batchItemReason(err error) string {
+	switch {
+	case err == nil:
+		return ""
+	case errors.Is(err, domain.ErrCompetencyAlreadyExists):
+		return "a competency with this name already exists"
+	case errors.Is(err, domain.ErrInvalidCompetencyName):
+		return "name must be 2-100 characters"
+	default:
+		return "could not be created"
+	}
+}
+
+// ToCompetencyRecord converts domain.Competency to a CompetencyRecord of an exported catalogue
+func ToCompetencyRecord(competency *domain.Competency) dto.CompetencyRecord {
+	return dto.CompetencyRecord{
+		Name:        competency.Name,
+		Description: competency.Description,
+		CreatedAt:   &competency.CreatedAt,
+		UpdatedAt:   &competency.UpdatedAt,
+	}
+}
+
+// ToCompetencyImportResponse converts an import report to a CompetencyImportResponse
+func ToCompetencyImportResponse(policy domain.CompetencyImportPolicy, report *domain.CompetencyImportReport, l *slog.Logger) (dto.CompetencyImportResponse, error) {
+	resp := dto.CompetencyImportResponse{
+		DryRun:     report.DryRun,
+		Applied:    report.Applied,
+		Rejected:   report.Rejected,
+		OnExisting: string(policy),
+		Results:    make([]dto.CompetencyImportResultDTO, len(report.Results)),
+	}
+	for i, result := range report.Results {
+		item := dto.CompetencyImportResultDTO{
+			Index:  i,
+			Name:   result.Name,
+			Action: string(result.Action),
+			Reason: importRecordReason(result.Err),
+		}
+		if result.Competency != nil {
+			competencyDTO, err := ToCompetencyDTO(result.Competency, l)
+			if err != nil {
+				return dto.CompetencyImportResponse{}, err
+			}
+			item.Competency = &competencyDTO
+		}
+
+		switch result.Action {
+		case domain.CompetencyImportCreated:
+			resp.Created++
+		case domain.CompetencyImportUpdated:
+			resp.Updated++
+		case domain.CompetencyImportUnchanged:
+			resp.Unchanged++
+		case domain.CompetencyImportSkipped:
+			resp.Skipped++
+		case domain.CompetencyImportConflict:
+			resp.Conflicts++
+		case domain.CompetencyImportInvalid:
+			resp.Invalid++
+		}
+		resp.Results[i] = item
+	}
+	return resp, nil
+}
+
+// importRecordReason explains to the client why an import record is invalid or conflicts
+func importRecordReason(err error) string {
+	switch {
+	case errors.Is(err, domain.ErrDuplicateImportRecord):
+		return "the name appears more than once in the file"
+	case errors.Is(err, domain.ErrCompetencyVersionConflict):
+		return "the competency was changed during the import"
+	default:
+		return batchItemReason(err)
+	}
+}
+
+// ToCategoryDTO converts a domain.Category to a CategoryDTO
+func ToCategoryDTO(category *domain.Category, l *slog.Logger) (dto.CategoryDTO, error) {
+	if category == nil {
+		l.Error("Attempt to convert nil domain category to DTO")
+		return dto.CategoryDTO{}, fmt.Errorf("cannot convert nil domain category to DTO")
+	}
+
+	return dto.CategoryDTO{
+		ID:        category.ID,
+		ParentID:  category.ParentID,
+		Name:      category.Name,
+		Position:  category.Position,
+		CreatedAt: category.CreatedAt,
+		UpdatedAt: category.UpdatedAt,
+	}, nil
+}
+
+// ToCategoryDTOs converts a slice of domain.Category to a slice of CategoryDTO
+func ToCategoryDTOs(categories []*domain.Category, l *slog.Logger) ([]dto.CategoryDTO, error) {
+	dtos := make([]dto.CategoryDTO, len(categories))
+	for i, category := range categories {
+		categoryDTO, err := ToCategoryDTO(category, l)
+		if err != nil {
+			return nil, err
+		}
+		dtos[i] = categoryDTO
+	}
+	return dtos, nil
+}
+
+// ToCategoryNodeDTO converts a domain.CategoryNode and its descendants to a CategoryNodeDTO
+func ToCategoryNodeDTO(node *domain.CategoryNode, l *slog.Logger) (dto.CategoryNodeDTO, error) {
+	categoryDTO, err := ToCategoryDTO(node.Category, l)
+	if err != nil {
+		return dto.CategoryNodeDTO{}, err
+	}
+	children, err := ToCategoryNodeDTOs(node.Children, l)
+	if err != nil {
+		return dto.CategoryNodeDTO{}, err
+	}
+
+	return dto.CategoryNodeDTO{
+		CategoryDTO:     categoryDTO,
+		CompetencyCount: node.CompetencyCount,
+		Children:        children,
+	}, nil
+}
+
+// ToCategoryNodeDTOs converts a slice of domain.CategoryNode to a slice of CategoryNodeDTO
+// Leaves get an empty slice of children rather than null
+func ToCategoryNodeDTOs(nodes []*domain.CategoryNode, l *slog.Logger) ([]dto.CategoryNodeDTO, error) {
+	dtos := make([]dto.CategoryNodeDTO, len(nodes))
+	for i, node := range nodes {
+		nodeDTO, err := ToCategoryNodeDTO(node, l)
+		if err != nil {
+			return nil, err
+		}
+		dtos[i] = nodeDTO
+	}
+	return dtos, nil
+}
+
+// ToRatingScaleDTO converts a domain.RatingScale to a RatingScaleDTO
+func ToRatingScaleDTO(scale *domain.RatingScale, l *slog.Logger) (dto.RatingScaleDTO, error) {
+	if scale == nil {
+		l.Error("Attempt to convert nil domain rating scale to DTO")
+		return dto.RatingScaleDTO{}, fmt.Errorf("cannot convert nil domain rating scale to DTO")
+	}
+
+	levels := make([]dto.RatingLevelDTO, len(scale.Levels))
+	for i, level := range scale.Levels {
+		levels[i] = dto.RatingLevelDTO{Value: level.Value, Label: level.Label}
+	}
+
+	return dto.RatingScaleDTO{
+		ID:          scale.ID,
+		Name:        scale.Name,
+		Description: scale.Description,
+		Levels:      levels,
+		CreatedAt:   scale.CreatedAt,
+		UpdatedAt:   scale.UpdatedAt,
+	}, nil
+}
+
+// ToRatingScaleDTOs converts a slice of domain.RatingScale to a slice of RatingScaleDTO
+func ToRatingScaleDTOs(scales []*domain.RatingScale, l *slog.Logger) ([]dto.RatingScaleDTO, error) {
+	dtos := make([]dto.RatingScaleDTO, len(scales))
+	for i, scale := range scales {
+		scaleDTO, err := ToRatingScaleDTO(scale, l)
+		if err != nil {
+			return nil, err
+		}
+		dtos[i] = scaleDTO
+	}
+	return dtos, nil
+}
+
+// ToCompetencyRubricDTO converts a domain.CompetencyRubric to a CompetencyRubricDTO
+// Undescribed levels get an empty slice of indicators rather than null
+func ToCompetencyRubricDTO(rubric *domain.CompetencyRubric, l *slog.Logger) (dto.CompetencyRubricDTO, error) {
+	if rubric == nil {
+		l.Error("Attempt to convert nil domain rubric to DTO")
+		return dto.CompetencyRubricDTO{}, fmt.Errorf("cannot convert nil domain rubric to DTO")
+	}
+	scaleDTO, err := ToRatingScaleDTO(rubric.Scale, l)
+	if err != nil {
+		return dto.CompetencyRubricDTO{}, err
+	}
+
+	levels := make([]dto.CompetencyLevelDTO, len(rubric.Levels))
+	for i, level := range rubric.Levels {
+		indicators := level.Indicators
+		if indicators == nil {
+			indicators = []string{}
+		}
+		levels[i] = dto.CompetencyLevelDTO{
+			Value:       level.Value,
+			Label:       level.Label,
+			Description: level.Description,
+			Indicators:  indicators,
+		}
+	}
+
+	return dto.CompetencyRubricDTO{
+		Scale:     scaleDTO,
+		Levels:    levels,
+		UpdatedAt: rubric.UpdatedAt,
+	}, nil
+}
+
+// ToRatingScaleSpec converts a RatingScaleRequest to a domain.RatingScaleSpec
+func ToRatingScaleSpec(req dto.RatingScaleRequest) domain.RatingScaleSpec {
+	levels := make([]domain.RatingLevel, len(req.Levels))
+	for i, level := range req.Levels {
+		levels[i] = domain.RatingLevel{Value: level.Value, Label: level.Label}
+	}
+	return domain.RatingScaleSpec{
+		Name:        req.Name,
+		Description: req.Description,
+		Levels:      levels,
+	}
+}
+
+// ToCompetencyLevel converts a CompetencyLevelRequest to a domain.CompetencyLevel
+func ToCompetencyLevel(req dto.CompetencyLevelRequest) domain.CompetencyLevel {
+	return domain.CompetencyLevel{
+		Value:       req.Value,
+		Description: req.Description,
+		Indicators:  req.Indicators,
+	}
+}
+
+// ToTagDTO converts a domain.Tag to a TagDTO
+func ToTagDTO(tag *domain.Tag, l *slog.Logger) (dto.TagDTO, error) {
+	if tag == nil {
+		l.Error("Attempt to convert nil domain tag to DTO")
+		return dto.TagDTO{}, fmt.Errorf("cannot convert nil domain tag to DTO")
+	}
+
+	return dto.TagDTO{
+		ID:         tag.ID,
+		Name:       tag.Name,
+		Curated:    tag.Curated,
+		UsageCount: tag.UsageCount,
+		CreatedAt:  tag.CreatedAt,
+		UpdatedAt:  tag.UpdatedAt,
+	}, nil
+}
+
+// ToTagDTOs converts a slice of domain.Tag to a slice of TagDTO
+func ToTagDTOs(tags []*domain.Tag, l *slog.Logger) ([]dto.TagDTO, error) {
+	dtos := make([]dto.TagDTO, len(tags))
+	for i, tag := range tags {
+		tagDTO, err := ToTagDTO(tag, l)
+		if err != nil {
+			return nil, err
+		}
+		dtos[i] = tagDTO
+	}
+	return dtos, nil
+}
+
+// ToCompetencySnapshotDTO converts a domain.CompetencySnapshot to a CompetencySnapshotDTO
+func ToCompetencySnapshotDTO(snapshot domain.CompetencySnapshot) dto.CompetencySnapshotDTO {
+	return dto.CompetencySnapshotDTO{
+		Name:        snapshot.Name,
+		Description: snapshot.Description,
+		CategoryID:  snapshot.CategoryID,
+		Archived:    snapshot.Archived,
+	}
+}
+
+// ToCompetencyRevisionDTO converts a domain.CompetencyRevision to a CompetencyRevisionDTO
+func ToCompetencyRevisionDTO(revision *domain.CompetencyRevision, l *slog.Logger) (dto.CompetencyRevisionDTO, error) {
+	if revision == nil {
+		l.Error("Attempt to convert nil domain competency revision to DTO")
+		return dto.CompetencyRevisionDTO{}, fmt.Errorf("cannot convert nil domain competency revision to DTO")
+	}
+
+	revisionDTO := dto.CompetencyRevisionDTO{
+		Revision:     revision.Revision,
+		Action:       string(revision.Action),
+		EditorID:     revision.EditorID,
+		RevertedFrom: revision.RevertedFrom,
+		MergedID:     revision.MergedID,
+		After:        ToCompetencySnapshotDTO(revision.After),
+		CreatedAt:    revision.CreatedAt,
+	}
+	if revision.Before != nil {
+		before := ToCompetencySnapshotDTO(*revision.Before)
+		revisionDTO.Before = &before
+	}
+	return revisionDTO, nil
+}
+
+// ToCompetencyRevisionDTOs converts a slice of domain.CompetencyRevision to a slice of CompetencyRevisionDTO
+func ToCompetencyRevisionDTOs(revisions []*domain.CompetencyRevision, l *slog.Logger) ([]dto.CompetencyRevisionDTO, error) {
+	dtos := make([]dto.CompetencyRevisionDTO, len(revisions))
+	for i, revision := range revisions {
+		revisionDTO, err := ToCompetencyRevisionDTO(revision, l)
+		if err != nil {
+			return nil, err
+		}
+		dtos[i] = revisionDTO
+	}
+	return dtos, nil
+}
+
+// ToCompetencyDiffResponse converts a domain.RevisionDiff to a CompetencyDiffResponse
+func ToCompetencyDiffResponse(diff *domain.RevisionDiff, l *slog.Logger) (dto.CompetencyDiffResponse, error) {
+	if diff == nil {
+		l.Error("Attempt to convert nil domain revision diff to DTO")
+		return dto.CompetencyDiffResponse{}, fmt.Errorf("cannot convert nil domain revision diff to DTO")
+	}
+
+	from, err := ToCompetencyRevisionDTO(diff.From, l)
+	if err != nil {
+		return dto.CompetencyDiffResponse{}, err
+	}
+	to, err := ToCompetencyRevisionDTO(diff.To, l)
+	if err != nil {
+		return dto.CompetencyDiffResponse{}, err
+	}
+	changes := make([]dto.FieldChangeDTO, len(diff.Changes))
+	for i, change := range diff.Changes {
+		changes[i] = dto.FieldChangeDTO{Field: change.Field, From: change.From, To: change.To}
+	}
+	return dto.CompetencyDiffResponse{From: from, To: to, Changes: changes}, nil
+}
+
+// ToCompetencyRelationsResponse converts a domain.CompetencyRelations to a CompetencyRelationsResponse
+func ToCompetencyRelationsResponse(relations *domain.CompetencyRelations) dto.CompetencyRelationsResponse {
+	return dto.CompetencyRelationsResponse{
+		Requires:   ToCompetencyNodeDTOs(relations.Requires),
+		RequiredBy: ToCompetencyNodeDTOs(relations.RequiredBy),
+		Related:    ToCompetencyNodeDTOs(relations.Related),
+	}
+}
+
+// ToCompetencyNodeDTOs converts a slice of domain.CompetencyNode to a slice of CompetencyNodeDTO
+func ToCompetencyNodeDTOs(nodes []*domain.CompetencyNode) []dto.CompetencyNodeDTO {
+	dtos := make([]dto.CompetencyNodeDTO, len(nodes))
+	for i, node := range nodes {
+		dtos[i] = dto.CompetencyNodeDTO{ID: node.ID, Name: node.Name}
+	}
+	return dtos
+}
+
+// ToPrerequisiteDTOs converts a slice of domain.CompetencyNode to a slice of PrerequisiteDTO
+func ToPrerequisiteDTOs(nodes []*domain.CompetencyNode) []dto.PrerequisiteDTO {
+	dtos := make([]dto.PrerequisiteDTO, len(nodes))
+	for i, node := range nodes {
+		dtos[i] = dto.PrerequisiteDTO{ID: node.ID, Name: node.Name, Depth: node.Depth}
+	}
+	return dtos
+}
+
+// ToLearningStepDTOs converts a slice of domain.LearningStep to a slice of LearningStepDTO
+func ToLearningStepDTOs(steps []*domain.LearningStep) []dto.LearningStepDTO {
+	dtos := make([]dto.LearningStepDTO, len(steps))
+	for i, step := range steps {
+		dtos[i] = dto.LearningStepDTO{ID: step.Competency.ID, Name: step.Competency.Name, Target: step.Target}
+	}
+	return dtos
+}
+
+// ToCompetencyTranslation converts a SetTranslationRequest to a domain.CompetencyTranslation
+func ToCompetencyTranslation(competencyID int32, locale domain.Locale, req dto.SetTranslationRequest) domain.CompetencyTranslation {
+	levels := make([]domain.CompetencyLevel, len(req.Levels))
+	for i, level := range req.Levels {
+		levels[i] = ToCompetencyLevel(level)
+	}
+	return domain.CompetencyTranslation{
+		CompetencyID: competencyID,
+		Locale:       locale,
+		Name:         req.Name,
+		Description:  req.Description,
+		Levels:       levels,
+	}
+}
+
+// ToCompetencyTranslationDTO converts a domain.CompetencyTranslation to a CompetencyTranslationDTO
+func ToCompetencyTranslationDTO(translation *domain.CompetencyTranslation) dto.CompetencyTranslationDTO {
+	levels := make([]dto.LevelTranslationDTO, len(translation.Levels))
+	for i, level := range translation.Levels {
+		indicators := level.Indicators
+		if indicators == nil {
+			indicators = []string{}
+		}
+		levels[i] = dto.LevelTranslationDTO{Value: level.Value, Description: level.Description, Indicators: indicators}
+	}
+	return dto.CompetencyTranslationDTO{
+		Locale:      string(translation.Locale),
+		Name:        translation.Name,
+		Description: translation.Description,
+		Levels:      levels,
+		UpdatedAt:   translation.UpdatedAt,
+	}
+}
+
+// ToCompetencyTranslationDTOs converts a slice of domain.CompetencyTranslation to a slice of CompetencyTranslationDTO
+func ToCompetencyTranslationDTOs(translations []*domain.CompetencyTranslation) []dto.CompetencyTranslationDTO {
+	dtos := make([]dto.CompetencyTranslationDTO, len(translations))
+	for i, translation := range translations {
+		dtos[i] = ToCompetencyTranslationDTO(translation)
+	}
+	return dtos
+}
+
+// ToMissingTranslationDTOs converts a slice of domain.MissingTranslation to a slice of MissingTranslationDTO
+func ToMissingTranslationDTOs(missing []*domain.MissingTranslation) []dto.MissingTranslationDTO {
+	dtos := make([]dto.MissingTranslationDTO, len(missing))
+	for i, item := range missing {
+		fields := []string{}
+		if item.MissingName {
+			fields = append(fields, "name")
+		}
+		if item.MissingDescription {
+			fields = append(fields, "description")
+		}
+		levels := item.MissingLevels
+		if levels == nil {
+			levels = []int32{}
+		}
+		dtos[i] = dto.MissingTranslationDTO{
+			CompetencyID: item.CompetencyID,
+			Name:         item.Name,
+			Locale:       string(item.Locale),
+			Fields:       fields,
+			Levels:       levels,
+		}
+	}
+	return dtos
+}
+
+// ToDuplicateCandidateDTOs converts a slice of domain.DuplicateCandidate to a slice of DuplicateCandidateDTO
+func ToDuplicateCandidateDTOs(candidates []*domain.DuplicateCandidate) []dto.DuplicateCandidateDTO {
+	dtos := make([]dto.DuplicateCandidateDTO, len(candidates))
+	for i, candidate := range candidates {
+		dtos[i] = dto.DuplicateCandidateDTO{
+			Competency:            dto.CompetencyNodeDTO{ID: candidate.Competency.ID, Name: candidate.Competency.Name},
+			Other:                 dto.CompetencyNodeDTO{ID: candidate.Other.ID, Name: candidate.Other.Name},
+			NameSimilarity:        candidate.NameSimilarity,
+			DescriptionSimilarity: candidate.DescriptionSimilarity,
+			Score:                 candidate.Score,
+		}
+	}
+	return dtos
+}
+
+// ToNewProposalChange converts a CreateProposalRequest to a domain.ProposalChange
+func ToNewProposalChange(req dto.CreateProposalRequest) domain.ProposalChange {
+	return domain.ProposalChange{
+		CompetencyID: req.CompetencyID,
+		Name:         req.Name,
+		Description:  req.Description,
+		CategoryID:   req.CategoryID,
+		Rationale:    req.Rationale,
+	}
+}
+
+// ToProposalChange converts an UpdateProposalRequest to a domain.ProposalChange, without its competency
+func ToProposalChange(req dto.UpdateProposalRequest) domain.ProposalChange {
+	return domain.ProposalChange{
+		Name:        req.Name,
+		Description: req.Description,
+		CategoryID:  req.CategoryID,
+		Rationale:   req.Rationale,
+	}
+}
+
+// ToProposalCommentDTO converts a domain.ProposalComment to a ProposalCommentDTO
+func ToProposalCommentDTO(comment *domain.ProposalComment) dto.ProposalCommentDTO {
+	return dto.ProposalCommentDTO{
+		ID:        comment.ID,
+		AuthorID:  comment.AuthorID,
+		Body:      comment.Body,
+		CreatedAt: comment.CreatedAt,
+	}
+}
+
+// ToProposalDTO converts a domain.CompetencyProposal to a ProposalDTO
+func ToProposalDTO(proposal *domain.CompetencyProposal) dto.ProposalDTO {
+	proposalDTO := dto.ProposalDTO{
+		ID:           proposal.ID,
+		Kind:         string(proposal.Kind),
+		Status:       string(proposal.Status),
+		ProposerID:   proposal.ProposerID,
+		CompetencyID: proposal.Change.CompetencyID,
+		Name:         proposal.Change.Name,
+		Description:  proposal.Change.Description,
+		CategoryID:   proposal.Change.CategoryID,
+		Rationale:    proposal.Change.Rationale,
+		ReviewerID:   proposal.ReviewerID,
+		SubmittedAt:  proposal.SubmittedAt,
+		ReviewedAt:   proposal.ReviewedAt,
+		CreatedAt:    proposal.CreatedAt,
+		UpdatedAt:    proposal.UpdatedAt,
+	}
+	if len(proposal.Comments) > 0 {
+		proposalDTO.Comments = make([]dto.ProposalCommentDTO, len(proposal.Comments))
+		for i, comment := range proposal.Comments {
+			proposalDTO.Comments[i] = ToProposalCommentDTO(comment)
+		}
+	}
+	return proposalDTO
+}
+
+// ToProposalDTOs converts a slice of domain.CompetencyProposal to a slice of ProposalDTO
+func ToProposalDTOs(proposals []*domain.CompetencyProposal) []dto.ProposalDTO {
+	dtos := make([]dto.ProposalDTO, len(proposals))
+	for i, proposal := range proposals {
+		dtos[i] = ToProposalDTO(proposal)
+	}
+	return dtos
+}
+
+// ToStewardsResponse converts the stewards of a competency to StewardsResponse
+func ToStewardsResponse(competencyID int32, stewards []*domain.CompetencySteward) dto.StewardsResponse {
+	response := dto.StewardsResponse{CompetencyID: competencyID, Stewards: make([]dto.StewardDTO, len(stewards))}
+	for i, steward := range stewards {
+		response.Stewards[i] = dto.StewardDTO{
+			UserID:     steward.UserID,
+			AssignedBy: steward.AssignedBy,
+			AssignedAt: steward.AssignedAt,
+		}
+	}
+	return response
+}
+
+// ToCompetencyReportDTO converts domain.CompetencyReport to CompetencyReportDTO
+func ToCompetencyReportDTO(report *domain.CompetencyReport) dto.CompetencyReportDTO {
+	return dto.CompetencyReportDTO{
+		ID:           report.ID,
+		CompetencyID: report.CompetencyID,
+		ReporterID:   report.ReporterID,
+		Reason:       report.Reason,
+		CreatedAt:    report.CreatedAt,
+	}
+}
+
+// ToCompetencyReportDTOs converts a slice of domain.CompetencyReport to a slice of CompetencyReportDTO
+func ToCompetencyReportDTOs(reports []*domain.CompetencyReport) []dto.CompetencyReportDTO {
+	dtos := make([]dto.CompetencyReportDTO, len(reports))
+	for i, report := range reports {
+		dtos[i] = ToCompetencyReportDTO(report)
+	}
+	return dtos
+}
+
+// ToNotificationDTO converts domain.Notification to NotificationDTO
+func ToNotificationDTO(notification *domain.Notification) dto.NotificationDTO {
+	return dto.NotificationDTO{
+		ID:           notification.ID,
+		Kind:         string(notification.Kind),
+		CompetencyID: notification.CompetencyID,
+		ProposalID:   notification.ProposalID,
+		ReportID:     notification.ReportID,
+		ReadAt:       notification.ReadAt,
+		CreatedAt:    notification.CreatedAt,
+	}
+}
+
+// ToNotificationDTOs converts a slice of domain.Notification to a slice of NotificationDTO
+func ToNotificationDTOs(notifications []*domain.Notification) []dto.NotificationDTO {
+	dtos := make([]dto.NotificationDTO, len(notifications))
+	for i, notification := range notifications {
+		dtos[i] = ToNotificationDTO(notification)
+	}
+	return dtos
+}
+
+// ToFrameworkVersionDTO converts domain.FrameworkVersion to FrameworkVersionDTO, without its content
+func ToFrameworkVersionDTO(version *domain.FrameworkVersion) dto.FrameworkVersionDTO {
+	versionDTO := dto.FrameworkVersionDTO{
+		Name:            version.Name,
+		Notes:           version.Notes,
+		CompetencyCount: version.CompetencyCount,
+		PublishedBy:     version.PublishedBy,
+	}
+	if !version.PublishedAt.IsZero() {
+		id, publishedAt := version.ID, version.PublishedAt
+		versionDTO.ID = &id
+		versionDTO.PublishedAt = &publishedAt
+	}
+	return versionDTO
+}
+
+// ToFrameworkVersionDTOs converts a slice of domain.FrameworkVersion to a slice of FrameworkVersionDTO
+func ToFrameworkVersionDTOs(versions []*domain.FrameworkVersion) []dto.FrameworkVersionDTO {
+	dtos := make([]dto.FrameworkVersionDTO, len(versions))
+	for i, version := range versions {
+		dtos[i] = ToFrameworkVersionDTO(version)
+	}
+	return dtos
+}
+
+// ToFrameworkRubricDTO converts domain.FrameworkRubric to FrameworkRubricDTO; nil stays nil
+func ToFrameworkRubricDTO(rubric *domain.FrameworkRubric) *dto.FrameworkRubricDTO {
+	if rubric == nil {
+		return nil
+	}
+	rubricDTO := &dto.FrameworkRubricDTO{
+		ScaleID:   rubric.ScaleID,
+		ScaleName: rubric.ScaleName,
+		Levels:    make([]dto.FrameworkLevelDTO, len(rubric.Levels)),
+	}
+	for i, level := range rubric.Levels {
+		rubricDTO.Levels[i] = dto.FrameworkLevelDTO{
+			Value:       level.Value,
+			Label:       level.Label,
+			Description: level.Description,
+			Indicators:  level.Indicators,
+		}
+	}
+	return rubricDTO
+}
+
+// ToFrameworkVersionResponse converts domain.FrameworkVersion with its snapshot to FrameworkVersionResponse
+func ToFrameworkVersionResponse(version *domain.FrameworkVersion, l *slog.Logger) (dto.FrameworkVersionResponse, error) {
+	if version == nil || version.Snapshot == nil {
+		l.Error("Attempt to convert domain framework version without snapshot to DTO")
+		return dto.FrameworkVersionResponse{}, fmt.Errorf("cannot convert domain framework version without snapshot to DTO")
+	}
+
+	response := dto.FrameworkVersionResponse{
+		Version:      ToFrameworkVersionDTO(version),
+		Categories:   make([]dto.FrameworkCategoryDTO, len(version.Snapshot.Categories)),
+		Competencies: make([]dto.FrameworkCompetencyDTO, len(version.Snapshot.Competencies)),
+	}
+	for i, category := range version.Snapshot.Categories {
+		response.Categories[i] = dto.FrameworkCategoryDTO{
+			ID:       category.ID,
+			ParentID: category.ParentID,
+			Name:     category.Name,
+			Position: category.Position,
+		}
+	}
+	for i, competency := range version.Snapshot.Competencies {
+		response.Competencies[i] = dto.FrameworkCompetencyDTO{
+			ID:          competency.ID,
+			Name:        competency.Name,
+			Description: competency.Description,
+			CategoryID:  competency.CategoryID,
+			Rubric:      ToFrameworkRubricDTO(competency.Rubric),
+		}
+	}
+	return response, nil
+}
+
+// ToFrameworkChangeDTOs converts a slice of domain.FrameworkChange to a slice of FrameworkChangeDTO
+// Rubric values are converted to FrameworkRubricDTO
+func ToFrameworkChangeDTOs(changes []domain.FrameworkChange) []dto.FrameworkChangeDTO {
+	dtos := make([]dto.FrameworkChangeDTO, len(changes))
+	for i, change := range changes {
+		dtos[i] = dto.FrameworkChangeDTO{Change: string(change.Kind), ID: change.ID, Name: change.Name}
+		for _, field := range change.Fields {
+			from, to := field.From, field.To
+			if rubric, ok := from.(*domain.FrameworkRubric); ok {
+				from = ToFrameworkRubricDTO(rubric)
+			}
+			if rubric, ok := to.(*domain.FrameworkRubric); ok {
+				to = ToFrameworkRubricDTO(rubric)
+			}
+			dtos[i].Fields = append(dtos[i].Fields, dto.FieldChangeDTO{Field: field.Field, From: from, To: to})
+		}
+	}
+	return dtos
+}
+
+// ToFrameworkDiffResponse converts a domain.FrameworkDiff to a FrameworkDiffResponse
+func ToFrameworkDiffResponse(diff *domain.FrameworkDiff) dto.FrameworkDiffResponse {
+	return dto.FrameworkDiffResponse{
+		From:         diff.From,
+		To:           diff.To,
+		Categories:   ToFrameworkChangeDTOs(diff.Categories),
+		Competencies: ToFrameworkChangeDTOs(diff.Competencies),
+	}
+}
+
+// valueOr returns *p, or fallback when p is nil (e.g. an absent query parameter)
+func valueOr[T any](p *T, fallback T) T {
+	if p == nil {
+		return fallback
+	}
+	return *p
+}
@@ -0,0 +1,138 @@
+package handler
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/dto"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/request"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/response"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+)
+
+// MergeHandler handles duplicate detection and competency merge HTTP requests
+type MergeHandler struct {
+	mergeUseCase   domain.MergeUseCase
+	binder         *request.Binder
+	logger         *slog.Logger
+	responseWriter *response.Writer
+}
+
+// NewMergeHandler creates a new merge handler instance
+func NewMergeHandler(mergeUseCase domain.MergeUseCase, binder *request.Binder, logger *slog.Logger, responseWriter *response.Writer) (*MergeHandler, error) {
+	// Check if dependencies are nil
+	if mergeUseCase == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "mergeUseCase can not be nil")
+	}
+	if binder == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "binder can not be nil")
+	}
+	if logger == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "logger can not be nil")
+	}
+	if responseWriter == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "responseWriter can not be nil")
+	}
+	return &MergeHandler{
+		mergeUseCase:   mergeUseCase,
+		binder:         binder,
+		logger:         logger,
+		responseWriter: responseWriter,
+	}, nil
+}
+
+// GetDuplicates handles duplicate report requests
+// GET /api/v1/competencies/duplicates?threshold=0.5&limit=50
+// Admins only
+// HTTP Status Codes:
+//   - 200 OK: Pairs of similar competencies, highest score first
+//   - 400 Bad Request: Invalid threshold or limit
+//   - 401 Unauthorized: Anonymous request
+//   - 403 Forbidden: The user isn't an admin
+//   - 500 Internal Server Error: Unexpected errors
+func (h *MergeHandler) GetDuplicates(w http.ResponseWriter, r *http.Request) {
+	// Decode and validate query parameters
+	var query dto.DuplicateCompetenciesQuery
+	if err := request.BindQuery(r.URL.Query(), &query); err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid duplicate report query", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	candidates, err := h.mergeUseCase.FindDuplicates(r.Context(), valueOr(query.Threshold, 0), valueOr(query.Limit, 0))
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to find duplicate competencies", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "Duplicate competencies retrieved successfully", "count", len(candidates))
+	h.responseWriter.Success(w, dto.DuplicateCompetenciesResponse{Duplicates: ToDuplicateCandidateDTOs(candidates)})
+}
+
+// Merge handles requests merging a competency into another one
+// POST /api/v1/competencies/{id}/merge
+// Admins only; the competency of the body is deleted and its ID redirects to the competency of the URL
+// HTTP Status Codes:
+//   - 200 OK: Competencies merged, the surviving competency is returned
+//   - 400 Bad Request: Invalid ID format, or a competency merged into itself
+//   - 401 Unauthorized: Anonymous request
+//   - 403 Forbidden: The user isn't an admin
+//   - 404 Not Found: A competency not found
+//   - 409 Conflict: The merged prerequisites would make a competency require itself
+//   - 500 Internal Server Error: Unexpected errors
+func (h *MergeHandler) Merge(w http.ResponseWriter, r *http.Request) {
+	// Get ID from URL parameter
+	id, err := idParam(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid competency ID format", "id", chi.URLParam(r, "id"), "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Decode and validate request body
+	var req dto.MergeCompetencyRequest
+	if err := h.binder.Bind(w, r, &req); err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid merge competency request", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	competency, err := h.mergeUseCase.Merge(r.Context(), id, req.MergedID)
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to merge competencies", "id", id, "merged_id", req.MergedID, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Build response
+	competencyDTO, err := ToCompetencyDTO(competency, h.logger)
+	if err != nil {
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "Competencies merged successfully", "competency_id", competency.ID, "merged_id", req.MergedID)
+	w.Header().Set("ETag", competencyETag(competency, domain.CompetencyInclude{}))
+	h.responseWriter.Success(w, competencyDTO)
+}
+
+// registerMergeProblems registers the problem details of the merge errors
+func registerMergeProblems(reg *response.Registry) {
+	reg.Register(domain.ErrInvalidMerge, response.Problem{
+		Status: http.StatusBadRequest,
+		Code:   "invalid_merge",
+		Title:  "Invalid merge",
+		Detail: "A competency can't be merged into itself",
+	})
+	reg.Register(domain.ErrInvalidDuplicateThreshold, response.Problem{
+		Status: http.StatusBadRequest,
+		Code:   "invalid_duplicate_threshold",
+		Title:  "Invalid duplicate threshold",
+		Detail: "The threshold must be between 0.3 and 1",
+	})
+}
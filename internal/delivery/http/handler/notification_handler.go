@@ -0,0 +1,104 @@
+package handler
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/dto"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/request"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/response"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+)
+
+// NotificationHandler handles the inbox HTTP requests of the user
+type NotificationHandler struct {
+	notificationUseCase domain.NotificationUseCase
+	logger              *slog.Logger
+	responseWriter      *response.Writer
+}
+
+// NewNotificationHandler creates a new notification handler instance
+func NewNotificationHandler(notificationUseCase domain.NotificationUseCase, logger *slog.Logger, responseWriter *response.Writer) (*NotificationHandler, error) {
+	// Check if dependencies are nil
+	if notificationUseCase == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "notificationUseCase can not be nil")
+	}
+	if logger == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "logger can not be nil")
+	}
+	if responseWriter == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "responseWriter can not be nil")
+	}
+	return &NotificationHandler{
+		notificationUseCase: notificationUseCase,
+		logger:              logger,
+		responseWriter:      responseWriter,
+	}, nil
+}
+
+// GetMine handles requests listing the notifications of the user
+// GET /api/v1/notifications?unread=true&limit=50
+// HTTP Status Codes:
+//   - 200 OK: Notifications, newest first (possibly empty)
+//   - 400 Bad Request: Invalid query parameters
+//   - 401 Unauthorized: Anonymous request
+//   - 500 Internal Server Error: Unexpected errors
+func (h *NotificationHandler) GetMine(w http.ResponseWriter, r *http.Request) {
+	// Decode and validate query parameters
+	var query dto.NotificationsQuery
+	if err := request.BindQuery(r.URL.Query(), &query); err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid notifications query", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	notifications, err := h.notificationUseCase.GetMine(r.Context(), query.Unread, valueOr(query.Limit, 0))
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to get notifications", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.responseWriter.Success(w, dto.NotificationsResponse{Notifications: ToNotificationDTOs(notifications)})
+}
+
+// MarkRead handles requests marking a notification of the user read
+// POST /api/v1/notifications/{id}/read
+// HTTP Status Codes:
+//   - 200 OK: Notification read (or already was)
+//   - 400 Bad Request: Invalid ID format
+//   - 401 Unauthorized: Anonymous request
+//   - 404 Not Found: Notification not found, or of another user
+//   - 500 Internal Server Error: Unexpected errors
+func (h *NotificationHandler) MarkRead(w http.ResponseWriter, r *http.Request) {
+	// Get ID from URL parameter
+	id, err := idParam(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid notification ID format", "id", chi.URLParam(r, "id"), "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	notification, err := h.notificationUseCase.MarkRead(r.Context(), id)
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to mark notification read", "id", id, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.responseWriter.Success(w, ToNotificationDTO(notification))
+}
+
+// registerNotificationProblems registers the problem details of the notification errors
+func registerNotificationProblems(reg *response.Registry) {
+	reg.Register(domain.ErrNotificationNotFound, response.Problem{
+		Status: http.StatusNotFound,
+		Code:   "notification_not_found",
+		Title:  "Notification not found",
+		Detail: "The requested notification does not exist",
+	})
+}
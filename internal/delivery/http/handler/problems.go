@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/response"
+)
+
+// RegisterProblems registers the problem details of the errors returned through the handlers
+// Each handler file registers the errors of its feature; the conditional request errors are registered here
+func RegisterProblems(reg *response.Registry) {
+	registerAuthProblems(reg)
+	registerCompetencyProblems(reg)
+	registerCatalogueProblems(reg)
+	registerCategoryProblems(reg)
+	registerRubricProblems(reg)
+	registerTagProblems(reg)
+	registerRelationProblems(reg)
+	registerTranslationProblems(reg)
+	registerMergeProblems(reg)
+	registerProposalProblems(reg)
+	registerStewardProblems(reg)
+	registerNotificationProblems(reg)
+	registerFrameworkProblems(reg)
+
+	// Conditional requests
+	reg.Register(ErrPreconditionRequired, response.Problem{
+		Status: http.StatusPreconditionRequired,
+		Code:   "precondition_required",
+		Title:  "Precondition required",
+		Detail: "Send the ETag of the version being updated in the If-Match header",
+	})
+}
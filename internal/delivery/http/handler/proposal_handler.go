@@ -0,0 +1,383 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/dto"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/request"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/response"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+)
+
+// ProposalHandler handles competency proposal HTTP requests
+// Proposals are visible to their proposer, and to admins once submitted; the others get 404
+type ProposalHandler struct {
+	proposalUseCase domain.ProposalUseCase
+	binder          *request.Binder
+	logger          *slog.Logger
+	responseWriter  *response.Writer
+}
+
+// NewProposalHandler creates a new proposal handler instance
+func NewProposalHandler(proposalUseCase domain.ProposalUseCase, binder *request.Binder, logger *slog.Logger, responseWriter *response.Writer) (*ProposalHandler, error) {
+	// Check if dependencies are nil
+	if proposalUseCase == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "proposalUseCase can not be nil")
+	}
+	if binder == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "binder can not be nil")
+	}
+	if logger == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "logger can not be nil")
+	}
+	if responseWriter == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "responseWriter can not be nil")
+	}
+	return &ProposalHandler{
+		proposalUseCase: proposalUseCase,
+		binder:          binder,
+		logger:          logger,
+		responseWriter:  responseWriter,
+	}, nil
+}
+
+// Create handles create proposal requests
+// POST /api/v1/proposals
+// HTTP Status Codes:
+//   - 201 Created: Draft proposal created
+//   - 400 Bad Request: Invalid request body or change
+//   - 401 Unauthorized: Anonymous request
+//   - 404 Not Found: Competency or category not found
+//   - 500 Internal Server Error: Unexpected errors
+func (h *ProposalHandler) Create(w http.ResponseWriter, r *http.Request) {
+	// Decode and validate request body
+	var req dto.CreateProposalRequest
+	if err := h.binder.Bind(w, r, &req); err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid create proposal request", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	proposal, err := h.proposalUseCase.Create(r.Context(), ToNewProposalChange(req))
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to create proposal", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "Proposal created successfully", "proposal_id", proposal.ID)
+	h.responseWriter.Created(w, ToProposalDTO(proposal))
+}
+
+// GetMine handles requests listing the proposals of the user
+// GET /api/v1/proposals?status=submitted
+// HTTP Status Codes:
+//   - 200 OK: Proposals of the user, newest first (possibly empty)
+//   - 400 Bad Request: Invalid status
+//   - 401 Unauthorized: Anonymous request
+//   - 500 Internal Server Error: Unexpected errors
+func (h *ProposalHandler) GetMine(w http.ResponseWriter, r *http.Request) {
+	// Decode and validate query parameters
+	var query dto.ListProposalsQuery
+	if err := request.BindQuery(r.URL.Query(), &query); err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid list proposals query", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	proposals, err := h.proposalUseCase.GetMine(r.Context(), domain.ProposalStatus(query.Status))
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to get proposals", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "Proposals retrieved successfully", "count", len(proposals))
+	h.responseWriter.Success(w, dto.ProposalsResponse{Proposals: ToProposalDTOs(proposals)})
+}
+
+// GetQueue handles review queue requests
+// GET /api/v1/proposals/queue?limit=50
+// Admins only
+// HTTP Status Codes:
+//   - 200 OK: Submitted proposals, oldest submission first (possibly empty)
+//   - 400 Bad Request: Invalid limit
+//   - 401 Unauthorized: Anonymous request
+//   - 403 Forbidden: The user isn't an admin
+//   - 500 Internal Server Error: Unexpected errors
+func (h *ProposalHandler) GetQueue(w http.ResponseWriter, r *http.Request) {
+	// Decode and validate query parameters
+	var query dto.ProposalQueueQuery
+	if err := request.BindQuery(r.URL.Query(), &query); err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid proposal queue query", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	proposals, err := h.proposalUseCase.GetQueue(r.Context(), valueOr(query.Limit, 0))
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to get proposal queue", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "Proposal queue retrieved successfully", "count", len(proposals))
+	h.responseWriter.Success(w, dto.ProposalsResponse{Proposals: ToProposalDTOs(proposals)})
+}
+
+// GetByID handles get proposal requests
+// GET /api/v1/proposals/{id}
+// HTTP Status Codes:
+//   - 200 OK: Proposal with its comments
+//   - 400 Bad Request: Invalid ID format
+//   - 401 Unauthorized: Anonymous request
+//   - 404 Not Found: Proposal not found or not visible to the user
+//   - 500 Internal Server Error: Unexpected errors
+func (h *ProposalHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	// Get ID from URL parameter
+	id, err := idParam(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid proposal ID format", "id", chi.URLParam(r, "id"), "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	proposal, err := h.proposalUseCase.GetByID(r.Context(), id)
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to get proposal", "id", id, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.responseWriter.Success(w, ToProposalDTO(proposal))
+}
+
+// Update handles requests replacing the change of a draft
+// PUT /api/v1/proposals/{id}
+// HTTP Status Codes:
+//   - 200 OK: Draft updated
+//   - 400 Bad Request: Invalid ID format, request body or change
+//   - 401 Unauthorized: Anonymous request
+//   - 404 Not Found: Proposal not found or not one of the user, or category not found
+//   - 409 Conflict: The proposal isn't a draft anymore
+//   - 500 Internal Server Error: Unexpected errors
+func (h *ProposalHandler) Update(w http.ResponseWriter, r *http.Request) {
+	// Get ID from URL parameter
+	id, err := idParam(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid proposal ID format", "id", chi.URLParam(r, "id"), "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Decode and validate request body
+	var req dto.UpdateProposalRequest
+	if err := h.binder.Bind(w, r, &req); err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid update proposal request", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	proposal, err := h.proposalUseCase.Update(r.Context(), id, ToProposalChange(req))
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to update proposal", "id", id, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "Proposal updated successfully", "proposal_id", id)
+	h.responseWriter.Success(w, ToProposalDTO(proposal))
+}
+
+// Withdraw handles requests withdrawing a proposal
+// DELETE /api/v1/proposals/{id}
+// HTTP Status Codes:
+//   - 204 No Content: Proposal deleted
+//   - 400 Bad Request: Invalid ID format
+//   - 401 Unauthorized: Anonymous request
+//   - 404 Not Found: Proposal not found or not one of the user
+//   - 409 Conflict: The proposal is already reviewed
+//   - 500 Internal Server Error: Unexpected errors
+func (h *ProposalHandler) Withdraw(w http.ResponseWriter, r *http.Request) {
+	// Get ID from URL parameter
+	id, err := idParam(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid proposal ID format", "id", chi.URLParam(r, "id"), "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	if err := h.proposalUseCase.Withdraw(r.Context(), id); err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to withdraw proposal", "id", id, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "Proposal withdrawn successfully", "proposal_id", id)
+	h.responseWriter.NoContent(w)
+}
+
+// Submit handles requests sending a draft to the review queue
+// POST /api/v1/proposals/{id}/submit
+// HTTP Status Codes:
+//   - 200 OK: Proposal submitted
+//   - 400 Bad Request: Invalid ID format
+//   - 401 Unauthorized: Anonymous request
+//   - 404 Not Found: Proposal not found or not one of the user
+//   - 409 Conflict: The proposal isn't a draft
+//   - 500 Internal Server Error: Unexpected errors
+func (h *ProposalHandler) Submit(w http.ResponseWriter, r *http.Request) {
+	// Get ID from URL parameter
+	id, err := idParam(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid proposal ID format", "id", chi.URLParam(r, "id"), "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	proposal, err := h.proposalUseCase.Submit(r.Context(), id)
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to submit proposal", "id", id, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "Proposal submitted successfully", "proposal_id", id)
+	h.responseWriter.Success(w, ToProposalDTO(proposal))
+}
+
+// Comment handles requests commenting a proposal
+// POST /api/v1/proposals/{id}/comments
+// HTTP Status Codes:
+//   - 201 Created: Comment added
+//   - 400 Bad Request: Invalid ID format or comment
+//   - 401 Unauthorized: Anonymous request
+//   - 404 Not Found: Proposal not found or not visible to the user
+//   - 500 Internal Server Error: Unexpected errors
+func (h *ProposalHandler) Comment(w http.ResponseWriter, r *http.Request) {
+	// Get ID from URL parameter
+	id, err := idParam(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid proposal ID format", "id", chi.URLParam(r, "id"), "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Decode and validate request body
+	var req dto.ProposalCommentRequest
+	if err := h.binder.Bind(w, r, &req); err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid proposal comment request", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	comment, err := h.proposalUseCase.Comment(r.Context(), id, req.Body)
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to comment proposal", "id", id, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "Proposal commented successfully", "proposal_id", id, "comment_id", comment.ID)
+	h.responseWriter.Created(w, ToProposalCommentDTO(comment))
+}
+
+// Approve handles requests approving a proposal
+// POST /api/v1/proposals/{id}/approve
+// Admins only; the change is applied to the catalogue and credited to the proposer
+// HTTP Status Codes:
+//   - 200 OK: Proposal approved
+//   - 400 Bad Request: Invalid ID format, comment, or proposed name
+//   - 401 Unauthorized: Anonymous request
+//   - 403 Forbidden: The user isn't an admin
+//   - 404 Not Found: Proposal, competency or category not found
+//   - 409 Conflict: The proposal isn't submitted, or the proposed name is taken
+//   - 500 Internal Server Error: Unexpected errors
+func (h *ProposalHandler) Approve(w http.ResponseWriter, r *http.Request) {
+	h.review(w, r, "approve", h.proposalUseCase.Approve)
+}
+
+// Reject handles requests rejecting a proposal
+// POST /api/v1/proposals/{id}/reject
+// Admins only; the comment telling the proposer why is required
+// HTTP Status Codes:
+//   - 200 OK: Proposal rejected
+//   - 400 Bad Request: Invalid ID format or missing comment
+//   - 401 Unauthorized: Anonymous request
+//   - 403 Forbidden: The user isn't an admin
+//   - 404 Not Found: Proposal not found
+//   - 409 Conflict: The proposal isn't submitted
+//   - 500 Internal Server Error: Unexpected errors
+func (h *ProposalHandler) Reject(w http.ResponseWriter, r *http.Request) {
+	h.review(w, r, "reject", h.proposalUseCase.Reject)
+}
+
+// review handles the approve and reject requests, which only differ by their use case method
+func (h *ProposalHandler) review(w http.ResponseWriter, r *http.Request, action string, call func(ctx context.Context, id int32, comment string) (*domain.CompetencyProposal, error)) {
+	// Get ID from URL parameter
+	id, err := idParam(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid proposal ID format", "id", chi.URLParam(r, "id"), "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Decode and validate request body
+	var req dto.ReviewProposalRequest
+	if err := h.binder.Bind(w, r, &req); err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid review proposal request", "action", action, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	proposal, err := call(r.Context(), id, req.Comment)
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to review proposal", "id", id, "action", action, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "Proposal reviewed successfully", "proposal_id", id, "action", action)
+	h.responseWriter.Success(w, ToProposalDTO(proposal))
+}
+
+// registerProposalProblems registers the problem details of the proposal errors
+func registerProposalProblems(reg *response.Registry) {
+	reg.Register(domain.ErrProposalNotFound, response.Problem{
+		Status: http.StatusNotFound,
+		Code:   "proposal_not_found",
+		Title:  "Proposal not found",
+		Detail: "The requested proposal does not exist",
+	})
+	reg.Register(domain.ErrInvalidProposal, response.Problem{
+		Status: http.StatusBadRequest,
+		Code:   "invalid_proposal",
+		Title:  "Invalid proposal",
+		Detail: "A new competency needs a name of 2 to 100 characters, an edit at least one changed field",
+	})
+	reg.Register(domain.ErrProposalStatusConflict, response.Problem{
+		Status: http.StatusConflict,
+		Code:   "proposal_status_conflict",
+		Title:  "Proposal status conflict",
+		Detail: "The proposal isn't in a status allowing this operation",
+	})
+	reg.Register(domain.ErrInvalidProposalComment, response.Problem{
+		Status: http.StatusBadRequest,
+		Code:   "invalid_proposal_comment",
+		Title:  "Invalid proposal comment",
+		Detail: "Comments have at most 2000 characters, and rejecting a proposal needs one",
+	})
+}
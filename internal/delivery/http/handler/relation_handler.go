@@ -0,0 +1,308 @@
+package handler
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/dto"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/request"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/response"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+)
+
+// RelationHandler handles prerequisite graph HTTP requests
+type RelationHandler struct {
+	relationUseCase domain.RelationUseCase
+	logger          *slog.Logger
+	responseWriter  *response.Writer
+}
+
+// NewRelationHandler creates a new relation handler instance
+func NewRelationHandler(relationUseCase domain.RelationUseCase, logger *slog.Logger, responseWriter *response.Writer) (*RelationHandler, error) {
+	// Check if dependencies are nil
+	if relationUseCase == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "relationUseCase can not be nil")
+	}
+	if logger == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "logger can not be nil")
+	}
+	if responseWriter == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "responseWriter can not be nil")
+	}
+	return &RelationHandler{
+		relationUseCase: relationUseCase,
+		logger:          logger,
+		responseWriter:  responseWriter,
+	}, nil
+}
+
+// GetRelations handles requests for the competencies directly linked to a competency
+// GET /api/v1/competencies/{id}/relations
+// HTTP Status Codes:
+//   - 200 OK: Relations retrieved
+//   - 400 Bad Request: Invalid ID format
+//   - 404 Not Found: Competency not found
+//   - 500 Internal Server Error: Unexpected errors
+func (h *RelationHandler) GetRelations(w http.ResponseWriter, r *http.Request) {
+	// Get ID from URL parameter
+	id, err := idParam(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid competency ID format", "id", chi.URLParam(r, "id"), "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	relations, err := h.relationUseCase.GetRelations(r.Context(), id)
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to get competency relations", "id", id, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.responseWriter.Success(w, ToCompetencyRelationsResponse(relations))
+}
+
+// AddPrerequisite handles requests making a competency require another one
+// PUT /api/v1/competencies/{id}/prerequisites/{other_id}
+// Admins and stewards of the competency only
+// HTTP Status Codes:
+//   - 200 OK: Prerequisite added (or already there)
+//   - 400 Bad Request: Invalid ID format, or a competency requiring itself
+//   - 401 Unauthorized: Anonymous request
+//   - 403 Forbidden: The user is neither an admin nor a steward of the competency
+//   - 404 Not Found: Competency not found
+//   - 409 Conflict: The other competency already requires this one, directly or not
+//   - 500 Internal Server Error: Unexpected errors
+func (h *RelationHandler) AddPrerequisite(w http.ResponseWriter, r *http.Request) {
+	h.relate(w, r, domain.RelationRequires)
+}
+
+// RemovePrerequisite handles requests removing a prerequisite of a competency
+// DELETE /api/v1/competencies/{id}/prerequisites/{other_id}
+// Admins and stewards of the competency only
+// HTTP Status Codes:
+//   - 204 No Content: Prerequisite removed
+//   - 400 Bad Request: Invalid ID format
+//   - 401 Unauthorized: Anonymous request
+//   - 403 Forbidden: The user is neither an admin nor a steward of the competency
+//   - 404 Not Found: The competency doesn't directly require the other one
+//   - 500 Internal Server Error: Unexpected errors
+func (h *RelationHandler) RemovePrerequisite(w http.ResponseWriter, r *http.Request) {
+	h.unrelate(w, r, domain.RelationRequires)
+}
+
+// AddRelated handles requests marking two competencies as related
+// PUT /api/v1/competencies/{id}/related/{other_id}
+// Admins and stewards of the competency only
+// HTTP Status Codes:
+//   - 200 OK: Competencies related (or already were)
+//   - 400 Bad Request: Invalid ID format, or a competency related to itself
+//   - 401 Unauthorized: Anonymous request
+//   - 403 Forbidden: The user is neither an admin nor a steward of the competency
+//   - 404 Not Found: Competency not found
+//   - 500 Internal Server Error: Unexpected errors
+func (h *RelationHandler) AddRelated(w http.ResponseWriter, r *http.Request) {
+	h.relate(w, r, domain.RelationRelated)
+}
+
+// RemoveRelated handles requests unmarking two competencies as related
+// DELETE /api/v1/competencies/{id}/related/{other_id}
+// Admins and stewards of the competency only
+// HTTP Status Codes:
+//   - 204 No Content: Relation removed
+//   - 400 Bad Request: Invalid ID format
+//   - 401 Unauthorized: Anonymous request
+//   - 403 Forbidden: The user is neither an admin nor a steward of the competency
+//   - 404 Not Found: The competencies aren't related
+//   - 500 Internal Server Error: Unexpected errors
+func (h *RelationHandler) RemoveRelated(w http.ResponseWriter, r *http.Request) {
+	h.unrelate(w, r, domain.RelationRelated)
+}
+
+// GetPrerequisites handles requests for the transitive prerequisites of a competency
+// GET /api/v1/competencies/{id}/prerequisites
+// HTTP Status Codes:
+//   - 200 OK: Prerequisites retrieved, nearest first
+//   - 400 Bad Request: Invalid ID format
+//   - 404 Not Found: Competency not found
+//   - 500 Internal Server Error: Unexpected errors
+func (h *RelationHandler) GetPrerequisites(w http.ResponseWriter, r *http.Request) {
+	// Get ID from URL parameter
+	id, err := idParam(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid competency ID format", "id", chi.URLParam(r, "id"), "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	prerequisites, err := h.relationUseCase.GetPrerequisites(r.Context(), id)
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to get prerequisites", "id", id, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.responseWriter.Success(w, dto.PrerequisitesResponse{Prerequisites: ToPrerequisiteDTOs(prerequisites)})
+}
+
+// GetLearningOrder handles learning order requests
+// GET /api/v1/competencies/learning-order?target={id}&target={id}
+// HTTP Status Codes:
+//   - 200 OK: The targets and their prerequisites, prerequisites first
+//   - 400 Bad Request: No targets or too many
+//   - 404 Not Found: A target not found
+//   - 500 Internal Server Error: Unexpected errors
+func (h *RelationHandler) GetLearningOrder(w http.ResponseWriter, r *http.Request) {
+	// Decode and validate query parameters
+	var query dto.LearningOrderQuery
+	if err := request.BindQuery(r.URL.Query(), &query); err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid learning order query", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	steps, err := h.relationUseCase.GetLearningOrder(r.Context(), query.Target)
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to get learning order", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.responseWriter.Success(w, dto.LearningOrderResponse{Steps: ToLearningStepDTOs(steps)})
+}
+
+// ExportGraph handles prerequisite graph export requests
+// GET /api/v1/competencies/graph?format={dot|mermaid}
+// The graph is rendered before anything is sent, so errors are still reported as problems
+// HTTP Status Codes:
+//   - 200 OK: Graph in DOT (default) or Mermaid syntax
+//   - 400 Bad Request: Unknown format
+//   - 500 Internal Server Error: Unexpected errors
+func (h *RelationHandler) ExportGraph(w http.ResponseWriter, r *http.Request) {
+	// Decode and validate query parameters
+	var query dto.CompetencyGraphQuery
+	if err := request.BindQuery(r.URL.Query(), &query); err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid competency graph query", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+	format := query.Format
+	if format == "" {
+		format = graphDOT
+	}
+
+	// Call use case
+	graph, err := h.relationUseCase.GetGraph(r.Context())
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to get competency graph", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Render and send
+	var body bytes.Buffer
+	if err := writeGraph(&body, graph, format); err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to render competency graph", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+	w.Header().Set("Content-Type", graphContentTypes[format])
+	w.WriteHeader(http.StatusOK)
+	if _, err := body.WriteTo(w); err != nil {
+		h.logger.WarnContext(r.Context(), "Failed to send competency graph", "error", err)
+	}
+}
+
+// relate adds an edge of kind from the {id} competency to the {other_id} one
+func (h *RelationHandler) relate(w http.ResponseWriter, r *http.Request, kind domain.RelationKind) {
+	// Get IDs from URL parameters
+	id, otherID, err := relationParams(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid competency ID format", "id", chi.URLParam(r, "id"), "other_id", chi.URLParam(r, "other_id"), "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	relations, err := h.relationUseCase.Relate(r.Context(), id, otherID, kind)
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to relate competencies", "id", id, "other_id", otherID, "kind", kind, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "Competencies related successfully", "id", id, "other_id", otherID, "kind", kind)
+	h.responseWriter.Success(w, ToCompetencyRelationsResponse(relations))
+}
+
+// unrelate removes the edge of kind from the {id} competency to the {other_id} one
+func (h *RelationHandler) unrelate(w http.ResponseWriter, r *http.Request, kind domain.RelationKind) {
+	// Get IDs from URL parameters
+	id, otherID, err := relationParams(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid competency ID format", "id", chi.URLParam(r, "id"), "other_id", chi.URLParam(r, "other_id"), "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	if err := h.relationUseCase.Unrelate(r.Context(), id, otherID, kind); err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to unrelate competencies", "id", id, "other_id", otherID, "kind", kind, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "Competencies unrelated successfully", "id", id, "other_id", otherID, "kind", kind)
+	h.responseWriter.NoContent(w)
+}
+
+// relationParams returns the IDs of the {id} and {other_id} URL parameters
+func relationParams(r *http.Request) (int32, int32, error) {
+	id, err := idParam(r)
+	if err != nil {
+		return 0, 0, err
+	}
+	otherID, err := strconv.ParseInt(chi.URLParam(r, "other_id"), 10, 32)
+	if err != nil {
+		return 0, 0, dto.ValidationError{
+			Field:   "other_id",
+			Message: "invalid ID format",
+		}
+	}
+	return id, int32(otherID), nil
+}
+
+// registerRelationProblems registers the problem details of the relation errors
+func registerRelationProblems(reg *response.Registry) {
+	reg.Register(domain.ErrInvalidRelation, response.Problem{
+		Status: http.StatusBadRequest,
+		Code:   "invalid_relation",
+		Title:  "Invalid relation",
+		Detail: "A competency can't require or be related to itself",
+	})
+	reg.Register(domain.ErrRelationCycle, response.Problem{
+		Status: http.StatusConflict,
+		Code:   "relation_cycle",
+		Title:  "Prerequisite cycle",
+		Detail: "The other competency already requires this one, directly or through other prerequisites",
+	})
+	reg.Register(domain.ErrRelationNotFound, response.Problem{
+		Status: http.StatusNotFound,
+		Code:   "relation_not_found",
+		Title:  "Relation not found",
+		Detail: "The competencies aren't linked this way",
+	})
+	reg.Register(domain.ErrInvalidLearningTargets, response.Problem{
+		Status: http.StatusBadRequest,
+		Code:   "invalid_learning_targets",
+		Title:  "Invalid learning targets",
+		Detail: "Give between 1 and 50 target competencies",
+	})
+}
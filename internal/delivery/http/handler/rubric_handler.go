@@ -0,0 +1,471 @@
+package handler
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/dto"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/request"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/response"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+)
+
+// RubricHandler handles rating scale and competency rubric HTTP requests
+type RubricHandler struct {
+	rubricUseCase  domain.RubricUseCase
+	binder         *request.Binder
+	logger         *slog.Logger
+	responseWriter *response.Writer
+}
+
+// NewRubricHandler creates a new rubric handler instance
+func NewRubricHandler(rubricUseCase domain.RubricUseCase, binder *request.Binder, logger *slog.Logger, responseWriter *response.Writer) (*RubricHandler, error) {
+	// Check if dependencies are nil
+	if rubricUseCase == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "rubricUseCase can not be nil")
+	}
+	if binder == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "binder can not be nil")
+	}
+	if logger == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "logger can not be nil")
+	}
+	if responseWriter == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "responseWriter can not be nil")
+	}
+	return &RubricHandler{
+		rubricUseCase:  rubricUseCase,
+		binder:         binder,
+		logger:         logger,
+		responseWriter: responseWriter,
+	}, nil
+}
+
+// CreateScale handles rating scale creation requests
+// POST /api/v1/rating-scales
+// Admins only
+// HTTP Status Codes:
+//   - 201 Created: Rating scale created
+//   - 400 Bad Request: Validation errors (invalid name or levels)
+//   - 401 Unauthorized: Anonymous request
+//   - 403 Forbidden: The user isn't an admin
+//   - 409 Conflict: A rating scale already has the name
+//   - 500 Internal Server Error: Unexpected errors
+func (h *RubricHandler) CreateScale(w http.ResponseWriter, r *http.Request) {
+	// Decode and validate request body
+	var req dto.RatingScaleRequest
+	if err := h.binder.Bind(w, r, &req); err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid create rating scale request", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	scale, err := h.rubricUseCase.CreateScale(r.Context(), ToRatingScaleSpec(req))
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to create rating scale", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Build response
+	scaleDTO, err := ToRatingScaleDTO(scale, h.logger)
+	if err != nil {
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "Rating scale created successfully", "scale_id", scale.ID, "name", scale.Name)
+	h.responseWriter.Created(w, scaleDTO)
+}
+
+// GetScales handles list rating scales requests
+// GET /api/v1/rating-scales
+// HTTP Status Codes:
+//   - 200 OK: Rating scales retrieved (possibly empty)
+//   - 500 Internal Server Error: Unexpected errors
+func (h *RubricHandler) GetScales(w http.ResponseWriter, r *http.Request) {
+	// Call use case
+	scales, err := h.rubricUseCase.GetScales(r.Context())
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to get rating scales", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Build response
+	scaleDTOs, err := ToRatingScaleDTOs(scales, h.logger)
+	if err != nil {
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "Rating scales retrieved successfully", "count", len(scaleDTOs))
+	h.responseWriter.Success(w, dto.RatingScalesResponse{Scales: scaleDTOs})
+}
+
+// GetScale handles get rating scale by ID requests
+// GET /api/v1/rating-scales/{id}
+// HTTP Status Codes:
+//   - 200 OK: Rating scale retrieved
+//   - 400 Bad Request: Invalid ID format
+//   - 404 Not Found: Rating scale not found
+//   - 500 Internal Server Error: Unexpected errors
+func (h *RubricHandler) GetScale(w http.ResponseWriter, r *http.Request) {
+	// Get ID from URL parameter
+	id, err := idParam(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid rating scale ID format", "id", chi.URLParam(r, "id"), "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	scale, err := h.rubricUseCase.GetScale(r.Context(), id)
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to get rating scale", "id", id, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.respondWithScale(w, r, scale, "Rating scale retrieved successfully")
+}
+
+// UpdateScale handles rating scale replacement requests
+// PUT /api/v1/rating-scales/{id}
+// Admins only
+// Levels described by a competency can't be removed
+// HTTP Status Codes:
+//   - 200 OK: Rating scale replaced
+//   - 400 Bad Request: Invalid ID format or validation errors
+//   - 401 Unauthorized: Anonymous request
+//   - 403 Forbidden: The user isn't an admin
+//   - 404 Not Found: Rating scale not found
+//   - 409 Conflict: A rating scale already has the name, or a removed level is described by a competency
+//   - 500 Internal Server Error: Unexpected errors
+func (h *RubricHandler) UpdateScale(w http.ResponseWriter, r *http.Request) {
+	// Get ID from URL parameter
+	id, err := idParam(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid rating scale ID format", "id", chi.URLParam(r, "id"), "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Decode and validate request body
+	var req dto.RatingScaleRequest
+	if err := h.binder.Bind(w, r, &req); err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid update rating scale request", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	scale, err := h.rubricUseCase.UpdateScale(r.Context(), id, ToRatingScaleSpec(req))
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to update rating scale", "id", id, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.respondWithScale(w, r, scale, "Rating scale updated successfully")
+}
+
+// DeleteScale handles rating scale deletion requests
+// DELETE /api/v1/rating-scales/{id}
+// Admins only
+// HTTP Status Codes:
+//   - 204 No Content: Rating scale deleted
+//   - 400 Bad Request: Invalid ID format
+//   - 401 Unauthorized: Anonymous request
+//   - 403 Forbidden: The user isn't an admin
+//   - 404 Not Found: Rating scale not found
+//   - 409 Conflict: A competency rubric uses the scale
+//   - 500 Internal Server Error: Unexpected errors
+func (h *RubricHandler) DeleteScale(w http.ResponseWriter, r *http.Request) {
+	// Get ID from URL parameter
+	id, err := idParam(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid rating scale ID format", "id", chi.URLParam(r, "id"), "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	if err := h.rubricUseCase.DeleteScale(r.Context(), id); err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to delete rating scale", "id", id, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "Rating scale deleted successfully", "scale_id", id)
+	h.responseWriter.NoContent(w)
+}
+
+// GetRubric handles competency rubric requests
+// GET /api/v1/competencies/{id}/levels
+// HTTP Status Codes:
+//   - 200 OK: Rubric retrieved, with every level of its scale
+//   - 400 Bad Request: Invalid ID format
+//   - 404 Not Found: Competency not found, or it has no rubric
+//   - 500 Internal Server Error: Unexpected errors
+func (h *RubricHandler) GetRubric(w http.ResponseWriter, r *http.Request) {
+	// Get ID from URL parameter
+	id, err := idParam(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid competency ID format", "id", chi.URLParam(r, "id"), "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	rubric, err := h.rubricUseCase.GetRubric(r.Context(), id)
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to get rubric", "competency_id", id, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.respondWithRubric(w, r, id, rubric, "Rubric retrieved successfully")
+}
+
+// SetRubric handles competency rubric replacement requests
+// PUT /api/v1/competencies/{id}/levels
+// Admins and stewards of the competency only
+// Sets the rating scale of the competency and replaces every level description
+// HTTP Status Codes:
+//   - 200 OK: Rubric replaced
+//   - 400 Bad Request: Invalid ID format or validation errors
+//   - 401 Unauthorized: Anonymous request
+//   - 403 Forbidden: The user is neither an admin nor a steward of the competency
+//   - 404 Not Found: Competency or rating scale not found
+//   - 422 Unprocessable Entity: A level isn't part of the rating scale
+//   - 500 Internal Server Error: Unexpected errors
+func (h *RubricHandler) SetRubric(w http.ResponseWriter, r *http.Request) {
+	// Get ID from URL parameter
+	id, err := idParam(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid competency ID format", "id", chi.URLParam(r, "id"), "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Decode and validate request body
+	var req dto.SetRubricRequest
+	if err := h.binder.Bind(w, r, &req); err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid set rubric request", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+	levels := make([]domain.CompetencyLevel, len(req.Levels))
+	for i, level := range req.Levels {
+		levels[i] = ToCompetencyLevel(level)
+	}
+
+	// Call use case
+	rubric, err := h.rubricUseCase.SetRubric(r.Context(), id, req.ScaleID, levels)
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to set rubric", "competency_id", id, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.respondWithRubric(w, r, id, rubric, "Rubric set successfully")
+}
+
+// DeleteRubric handles competency rubric deletion requests
+// DELETE /api/v1/competencies/{id}/levels
+// Admins and stewards of the competency only
+// HTTP Status Codes:
+//   - 204 No Content: Rubric deleted
+//   - 400 Bad Request: Invalid ID format
+//   - 401 Unauthorized: Anonymous request
+//   - 403 Forbidden: The user is neither an admin nor a steward of the competency
+//   - 404 Not Found: Competency not found, or it has no rubric
+//   - 500 Internal Server Error: Unexpected errors
+func (h *RubricHandler) DeleteRubric(w http.ResponseWriter, r *http.Request) {
+	// Get ID from URL parameter
+	id, err := idParam(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid competency ID format", "id", chi.URLParam(r, "id"), "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	if err := h.rubricUseCase.DeleteRubric(r.Context(), id); err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to delete rubric", "competency_id", id, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "Rubric deleted successfully", "competency_id", id)
+	h.responseWriter.NoContent(w)
+}
+
+// SetLevel handles competency level description requests
+// PUT /api/v1/competencies/{id}/levels/{level}
+// Admins and stewards of the competency only
+// HTTP Status Codes:
+//   - 200 OK: Level described; the whole rubric is returned
+//   - 400 Bad Request: Invalid ID or level format, or validation errors
+//   - 401 Unauthorized: Anonymous request
+//   - 403 Forbidden: The user is neither an admin nor a steward of the competency
+//   - 404 Not Found: Competency not found, or it has no rubric
+//   - 422 Unprocessable Entity: The level isn't part of the rating scale of the rubric
+//   - 500 Internal Server Error: Unexpected errors
+func (h *RubricHandler) SetLevel(w http.ResponseWriter, r *http.Request) {
+	// Get ID and level from URL parameters
+	id, value, err := levelParams(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid competency level parameters", "id", chi.URLParam(r, "id"), "level", chi.URLParam(r, "level"), "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Decode and validate request body
+	var req dto.CompetencyLevelRequest
+	if err := h.binder.Bind(w, r, &req); err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid set competency level request", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+	level := ToCompetencyLevel(req)
+	level.Value = value
+
+	// Call use case
+	rubric, err := h.rubricUseCase.SetLevel(r.Context(), id, level)
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to set competency level", "competency_id", id, "level", value, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.respondWithRubric(w, r, id, rubric, "Competency level set successfully")
+}
+
+// DeleteLevel handles competency level description deletion requests
+// DELETE /api/v1/competencies/{id}/levels/{level}
+// Admins and stewards of the competency only
+// The level stays in the rubric, undescribed
+// HTTP Status Codes:
+//   - 204 No Content: Level description deleted
+//   - 400 Bad Request: Invalid ID or level format
+//   - 401 Unauthorized: Anonymous request
+//   - 403 Forbidden: The user is neither an admin nor a steward of the competency
+//   - 404 Not Found: Competency not found, it has no rubric, or the level isn't described
+//   - 500 Internal Server Error: Unexpected errors
+func (h *RubricHandler) DeleteLevel(w http.ResponseWriter, r *http.Request) {
+	// Get ID and level from URL parameters
+	id, value, err := levelParams(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid competency level parameters", "id", chi.URLParam(r, "id"), "level", chi.URLParam(r, "level"), "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	if err := h.rubricUseCase.DeleteLevel(r.Context(), id, value); err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to delete competency level", "competency_id", id, "level", value, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "Competency level deleted successfully", "competency_id", id, "level", value)
+	h.responseWriter.NoContent(w)
+}
+
+// respondWithScale sends a rating scale
+func (h *RubricHandler) respondWithScale(w http.ResponseWriter, r *http.Request, scale *domain.RatingScale, message string) {
+	scaleDTO, err := ToRatingScaleDTO(scale, h.logger)
+	if err != nil {
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), message, "scale_id", scale.ID)
+	h.responseWriter.Success(w, scaleDTO)
+}
+
+// respondWithRubric sends the rubric of a competency
+func (h *RubricHandler) respondWithRubric(w http.ResponseWriter, r *http.Request, competencyID int32, rubric *domain.CompetencyRubric, message string) {
+	rubricDTO, err := ToCompetencyRubricDTO(rubric, h.logger)
+	if err != nil {
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), message, "competency_id", competencyID)
+	h.responseWriter.Success(w, rubricDTO)
+}
+
+// levelParams returns the competency ID of the {id} URL parameter and the level value of the {level} one
+func levelParams(r *http.Request) (int32, int32, error) {
+	id, err := idParam(r)
+	if err != nil {
+		return 0, 0, err
+	}
+	value, err := strconv.ParseInt(chi.URLParam(r, "level"), 10, 32)
+	if err != nil || value <= 0 {
+		return 0, 0, dto.ValidationError{
+			Field:   "level",
+			Message: "invalid level format",
+		}
+	}
+	return id, int32(value), nil
+}
+
+// registerRubricProblems registers the problem details of the rating scale and rubric errors
+func registerRubricProblems(reg *response.Registry) {
+	reg.Register(domain.ErrRatingScaleNotFound, response.Problem{
+		Status: http.StatusNotFound,
+		Code:   "rating_scale_not_found",
+		Title:  "Rating scale not found",
+		Detail: "Rating scale not found",
+	})
+	reg.Register(domain.ErrRatingScaleAlreadyExists, response.Problem{
+		Status: http.StatusConflict,
+		Code:   "rating_scale_already_exists",
+		Title:  "Rating scale already exists",
+		Detail: "A rating scale with this name already exists",
+	})
+	reg.Register(domain.ErrInvalidRatingScale, response.Problem{
+		Status: http.StatusBadRequest,
+		Code:   "invalid_rating_scale",
+		Title:  "Invalid rating scale",
+		Detail: "Invalid rating scale (name of 2-100 characters, 1-10 levels with distinct positive values and labels)",
+	})
+	reg.Register(domain.ErrRatingScaleInUse, response.Problem{
+		Status: http.StatusConflict,
+		Code:   "rating_scale_in_use",
+		Title:  "Rating scale in use",
+		Detail: "Competency rubrics use the rating scale or the removed levels",
+	})
+	reg.Register(domain.ErrRubricNotFound, response.Problem{
+		Status: http.StatusNotFound,
+		Code:   "rubric_not_found",
+		Title:  "Rubric not found",
+		Detail: "The competency has no rubric",
+	})
+	reg.Register(domain.ErrInvalidCompetencyLevel, response.Problem{
+		Status: http.StatusBadRequest,
+		Code:   "invalid_competency_level",
+		Title:  "Invalid competency level",
+		Detail: "Invalid level (description up to 2000 characters, up to 20 non-empty indicators of up to 500 characters, each level once)",
+	})
+	reg.Register(domain.ErrUnknownRatingLevel, response.Problem{
+		Status: http.StatusUnprocessableEntity,
+		Code:   "unknown_rating_level",
+		Title:  "Unknown rating level",
+		Detail: "The level isn't part of the rating scale",
+	})
+	reg.Register(domain.ErrCompetencyLevelNotFound, response.Problem{
+		Status: http.StatusNotFound,
+		Code:   "competency_level_not_found",
+		Title:  "Competency level not found",
+		Detail: "The competency has no description for this level",
+	})
+}
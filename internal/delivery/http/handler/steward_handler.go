@@ -0,0 +1,275 @@
+package handler
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/dto"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/request"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/response"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+)
+
+// StewardHandler handles competency steward and stale-content report HTTP requests
+type StewardHandler struct {
+	stewardUseCase domain.StewardUseCase
+	binder         *request.Binder
+	logger         *slog.Logger
+	responseWriter *response.Writer
+}
+
+// NewStewardHandler creates a new steward handler instance
+func NewStewardHandler(stewardUseCase domain.StewardUseCase, binder *request.Binder, logger *slog.Logger, responseWriter *response.Writer) (*StewardHandler, error) {
+	// Check if dependencies are nil
+	if stewardUseCase == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "stewardUseCase can not be nil")
+	}
+	if binder == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "binder can not be nil")
+	}
+	if logger == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "logger can not be nil")
+	}
+	if responseWriter == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "responseWriter can not be nil")
+	}
+	return &StewardHandler{
+		stewardUseCase: stewardUseCase,
+		binder:         binder,
+		logger:         logger,
+		responseWriter: responseWriter,
+	}, nil
+}
+
+// GetStewards handles requests for the stewards of a competency
+// GET /api/v1/competencies/{id}/stewards
+// HTTP Status Codes:
+//   - 200 OK: Stewards retrieved (possibly empty)
+//   - 400 Bad Request: Invalid ID format
+//   - 404 Not Found: Competency not found
+//   - 500 Internal Server Error: Unexpected errors
+func (h *StewardHandler) GetStewards(w http.ResponseWriter, r *http.Request) {
+	// Get ID from URL parameter
+	id, err := idParam(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid competency ID format", "id", chi.URLParam(r, "id"), "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	stewards, err := h.stewardUseCase.GetStewards(r.Context(), id)
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to get stewards", "id", id, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.responseWriter.Success(w, ToStewardsResponse(id, stewards))
+}
+
+// Assign handles requests making a user a steward of a competency
+// PUT /api/v1/competencies/{id}/stewards/{user_id}
+// Admins only
+// HTTP Status Codes:
+//   - 200 OK: User assigned (or already was), the stewards are returned
+//   - 400 Bad Request: Invalid ID format
+//   - 401 Unauthorized: Anonymous request
+//   - 403 Forbidden: The user isn't an admin
+//   - 404 Not Found: Competency or user not found
+//   - 500 Internal Server Error: Unexpected errors
+func (h *StewardHandler) Assign(w http.ResponseWriter, r *http.Request) {
+	// Get IDs from URL parameters
+	id, userID, err := stewardParams(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid steward ID format", "id", chi.URLParam(r, "id"), "user_id", chi.URLParam(r, "user_id"), "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	stewards, err := h.stewardUseCase.Assign(r.Context(), id, userID)
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to assign steward", "id", id, "user_id", userID, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "Steward assigned successfully", "id", id, "user_id", userID)
+	h.responseWriter.Success(w, ToStewardsResponse(id, stewards))
+}
+
+// Unassign handles requests removing a steward of a competency
+// DELETE /api/v1/competencies/{id}/stewards/{user_id}
+// Admins only
+// HTTP Status Codes:
+//   - 204 No Content: Steward removed
+//   - 400 Bad Request: Invalid ID format
+//   - 401 Unauthorized: Anonymous request
+//   - 403 Forbidden: The user isn't an admin
+//   - 404 Not Found: The user isn't a steward of the competency
+//   - 500 Internal Server Error: Unexpected errors
+func (h *StewardHandler) Unassign(w http.ResponseWriter, r *http.Request) {
+	// Get IDs from URL parameters
+	id, userID, err := stewardParams(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid steward ID format", "id", chi.URLParam(r, "id"), "user_id", chi.URLParam(r, "user_id"), "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	if err := h.stewardUseCase.Unassign(r.Context(), id, userID); err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to unassign steward", "id", id, "user_id", userID, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "Steward unassigned successfully", "id", id, "user_id", userID)
+	h.responseWriter.NoContent(w)
+}
+
+// GetStewarded handles requests for the competencies the user is a steward of
+// GET /api/v1/competencies/stewarded
+// HTTP Status Codes:
+//   - 200 OK: Stewarded competencies, by name (possibly empty)
+//   - 401 Unauthorized: Anonymous request
+//   - 500 Internal Server Error: Unexpected errors
+func (h *StewardHandler) GetStewarded(w http.ResponseWriter, r *http.Request) {
+	// Call use case
+	competencies, err := h.stewardUseCase.GetStewarded(r.Context())
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to get stewarded competencies", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Build response
+	competencyDTOs, err := ToCompetencyDTOs(competencies, h.logger)
+	if err != nil {
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.responseWriter.Success(w, dto.CompetenciesResponse{
+		Competencies: competencyDTOs,
+		Count:        len(competencyDTOs),
+		Total:        int64(len(competencyDTOs)),
+	})
+}
+
+// Report handles requests reporting a competency as outdated
+// POST /api/v1/competencies/{id}/reports
+// The stewards of the competency are notified
+// HTTP Status Codes:
+//   - 201 Created: Report recorded
+//   - 400 Bad Request: Invalid ID format or reason
+//   - 401 Unauthorized: Anonymous request
+//   - 404 Not Found: Competency not found
+//   - 500 Internal Server Error: Unexpected errors
+func (h *StewardHandler) Report(w http.ResponseWriter, r *http.Request) {
+	// Get ID from URL parameter
+	id, err := idParam(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid competency ID format", "id", chi.URLParam(r, "id"), "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Decode and validate request body
+	var req dto.ReportCompetencyRequest
+	if err := h.binder.Bind(w, r, &req); err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid report competency request", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	report, err := h.stewardUseCase.Report(r.Context(), id, req.Reason)
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to report competency", "id", id, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "Competency reported successfully", "id", id, "report_id", report.ID)
+	h.responseWriter.Created(w, ToCompetencyReportDTO(report))
+}
+
+// GetReports handles requests for the reports of a competency
+// GET /api/v1/competencies/{id}/reports?limit=50
+// Admins and stewards of the competency only
+// HTTP Status Codes:
+//   - 200 OK: Reports, newest first (possibly empty)
+//   - 400 Bad Request: Invalid ID format or limit
+//   - 401 Unauthorized: Anonymous request
+//   - 403 Forbidden: The user is neither an admin nor a steward of the competency
+//   - 500 Internal Server Error: Unexpected errors
+func (h *StewardHandler) GetReports(w http.ResponseWriter, r *http.Request) {
+	// Get ID from URL parameter
+	id, err := idParam(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid competency ID format", "id", chi.URLParam(r, "id"), "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Decode and validate query parameters
+	var query dto.CompetencyReportsQuery
+	if err := request.BindQuery(r.URL.Query(), &query); err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid competency reports query", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	reports, err := h.stewardUseCase.GetReports(r.Context(), id, valueOr(query.Limit, 0))
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to get competency reports", "id", id, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.responseWriter.Success(w, dto.CompetencyReportsResponse{Reports: ToCompetencyReportDTOs(reports)})
+}
+
+// stewardParams returns the competency and user IDs of the {id} and {user_id} URL parameters
+func stewardParams(r *http.Request) (int32, int32, error) {
+	id, err := idParam(r)
+	if err != nil {
+		return 0, 0, err
+	}
+	userID, err := strconv.ParseInt(chi.URLParam(r, "user_id"), 10, 32)
+	if err != nil {
+		return 0, 0, dto.ValidationError{
+			Field:   "user_id",
+			Message: "invalid ID format",
+		}
+	}
+	return id, int32(userID), nil
+}
+
+// registerStewardProblems registers the problem details of the steward and report errors
+func registerStewardProblems(reg *response.Registry) {
+	reg.Register(domain.ErrUserNotFound, response.Problem{
+		Status: http.StatusNotFound,
+		Code:   "user_not_found",
+		Title:  "User not found",
+		Detail: "The requested user does not exist",
+	})
+	reg.Register(domain.ErrStewardNotFound, response.Problem{
+		Status: http.StatusNotFound,
+		Code:   "steward_not_found",
+		Title:  "Steward not found",
+		Detail: "The user isn't a steward of the competency",
+	})
+	reg.Register(domain.ErrInvalidReport, response.Problem{
+		Status: http.StatusBadRequest,
+		Code:   "invalid_report",
+		Title:  "Invalid report",
+		Detail: "A report needs a reason of at most 1000 characters",
+	})
+}
@@ -0,0 +1,364 @@
+package handler
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/dto"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/request"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/response"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+)
+
+// TagHandler handles tag HTTP requests
+type TagHandler struct {
+	tagUseCase     domain.TagUseCase
+	binder         *request.Binder
+	logger         *slog.Logger
+	responseWriter *response.Writer
+}
+
+// NewTagHandler creates a new tag handler instance
+func NewTagHandler(tagUseCase domain.TagUseCase, binder *request.Binder, logger *slog.Logger, responseWriter *response.Writer) (*TagHandler, error) {
+	// Check if dependencies are nil
+	if tagUseCase == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "tagUseCase can not be nil")
+	}
+	if binder == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "binder can not be nil")
+	}
+	if logger == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "logger can not be nil")
+	}
+	if responseWriter == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "responseWriter can not be nil")
+	}
+	return &TagHandler{
+		tagUseCase:     tagUseCase,
+		binder:         binder,
+		logger:         logger,
+		responseWriter: responseWriter,
+	}, nil
+}
+
+// Create handles tag creation requests
+// POST /api/v1/tags
+// Admins only: other users create free-form tags by tagging competencies
+// HTTP Status Codes:
+//   - 201 Created: Tag created
+//   - 400 Bad Request: Validation errors (invalid name)
+//   - 401 Unauthorized: Anonymous request
+//   - 403 Forbidden: The user isn't an admin
+//   - 409 Conflict: A tag already has the name
+//   - 500 Internal Server Error: Unexpected errors
+func (h *TagHandler) Create(w http.ResponseWriter, r *http.Request) {
+	// Decode and validate request body
+	var req dto.CreateTagRequest
+	if err := h.binder.Bind(w, r, &req); err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid create tag request", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	tag, err := h.tagUseCase.Create(r.Context(), req.Name, req.Curated)
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to create tag", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Build response
+	tagDTO, err := ToTagDTO(tag, h.logger)
+	if err != nil {
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "Tag created successfully", "tag_id", tag.ID, "name", tag.Name)
+	h.responseWriter.Created(w, tagDTO)
+}
+
+// GetAll handles list tags requests
+// GET /api/v1/tags?name_prefix=&curated=&limit=
+// Tags are ordered by usage count, most used first
+// HTTP Status Codes:
+//   - 200 OK: Tags retrieved (possibly empty)
+//   - 400 Bad Request: Invalid query parameters
+//   - 500 Internal Server Error: Unexpected errors
+func (h *TagHandler) GetAll(w http.ResponseWriter, r *http.Request) {
+	// Decode and validate query parameters
+	var query dto.ListTagsQuery
+	if err := request.BindQuery(r.URL.Query(), &query); err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid list tags query", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	tags, err := h.tagUseCase.GetAll(r.Context(), domain.TagFilter{
+		NamePrefix: query.NamePrefix,
+		Curated:    query.Curated,
+		Limit:      valueOr(query.Limit, 0),
+	})
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to get tags", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.respondWithTags(w, r, tags, "Tags retrieved successfully")
+}
+
+// GetByID handles get tag by ID requests
+// GET /api/v1/tags/{id}
+// HTTP Status Codes:
+//   - 200 OK: Tag retrieved
+//   - 400 Bad Request: Invalid ID format
+//   - 404 Not Found: Tag not found
+//   - 500 Internal Server Error: Unexpected errors
+func (h *TagHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	// Get ID from URL parameter
+	id, err := idParam(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid tag ID format", "id", chi.URLParam(r, "id"), "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	tag, err := h.tagUseCase.GetByID(r.Context(), id)
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to get tag", "id", id, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.respondWithTag(w, r, tag, "Tag retrieved successfully")
+}
+
+// Update handles tag update requests
+// PATCH /api/v1/tags/{id}
+// Admins only; omitted fields are left as they are
+// HTTP Status Codes:
+//   - 200 OK: Tag updated
+//   - 400 Bad Request: Invalid ID format or validation errors
+//   - 401 Unauthorized: Anonymous request
+//   - 403 Forbidden: The user isn't an admin
+//   - 404 Not Found: Tag not found
+//   - 409 Conflict: Another tag already has the name
+//   - 500 Internal Server Error: Unexpected errors
+func (h *TagHandler) Update(w http.ResponseWriter, r *http.Request) {
+	// Get ID from URL parameter
+	id, err := idParam(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid tag ID format", "id", chi.URLParam(r, "id"), "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Decode and validate request body
+	var req dto.UpdateTagRequest
+	if err := h.binder.Bind(w, r, &req); err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid update tag request", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	tag, err := h.tagUseCase.Update(r.Context(), id, req.Name, req.Curated)
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to update tag", "id", id, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.respondWithTag(w, r, tag, "Tag updated successfully")
+}
+
+// Delete handles tag deletion requests
+// DELETE /api/v1/tags/{id}
+// Admins only; the tag is removed from its competencies
+// HTTP Status Codes:
+//   - 204 No Content: Tag deleted
+//   - 400 Bad Request: Invalid ID format
+//   - 401 Unauthorized: Anonymous request
+//   - 403 Forbidden: The user isn't an admin
+//   - 404 Not Found: Tag not found
+//   - 500 Internal Server Error: Unexpected errors
+func (h *TagHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	// Get ID from URL parameter
+	id, err := idParam(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid tag ID format", "id", chi.URLParam(r, "id"), "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	if err := h.tagUseCase.Delete(r.Context(), id); err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to delete tag", "id", id, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "Tag deleted successfully", "tag_id", id)
+	h.responseWriter.NoContent(w)
+}
+
+// GetCompetencyTags handles competency tags requests
+// GET /api/v1/competencies/{id}/tags
+// HTTP Status Codes:
+//   - 200 OK: Tags retrieved by name (possibly empty)
+//   - 400 Bad Request: Invalid ID format
+//   - 404 Not Found: Competency not found
+//   - 500 Internal Server Error: Unexpected errors
+func (h *TagHandler) GetCompetencyTags(w http.ResponseWriter, r *http.Request) {
+	// Get ID from URL parameter
+	id, err := idParam(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid competency ID format", "id", chi.URLParam(r, "id"), "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	tags, err := h.tagUseCase.GetCompetencyTags(r.Context(), id)
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to get competency tags", "competency_id", id, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.respondWithTags(w, r, tags, "Competency tags retrieved successfully")
+}
+
+// TagCompetency handles competency tagging requests
+// POST /api/v1/competencies/{id}/tags
+// Unknown names are created as free-form tags; tags the competency already carries are ignored
+// Curated tags are attached by admins and stewards of the competency only
+// HTTP Status Codes:
+//   - 200 OK: Tags added; every tag of the competency is returned
+//   - 400 Bad Request: Invalid ID format or tag names
+//   - 401 Unauthorized: Anonymous request
+//   - 403 Forbidden: A curated tag is attached by a user who isn't an admin or a steward of the competency
+//   - 404 Not Found: Competency not found
+//   - 500 Internal Server Error: Unexpected errors
+func (h *TagHandler) TagCompetency(w http.ResponseWriter, r *http.Request) {
+	// Get ID from URL parameter
+	id, err := idParam(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid competency ID format", "id", chi.URLParam(r, "id"), "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Decode and validate request body
+	var req dto.TagCompetencyRequest
+	if err := h.binder.Bind(w, r, &req); err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid tag competency request", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	tags, err := h.tagUseCase.TagCompetency(r.Context(), id, req.Tags)
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to tag competency", "competency_id", id, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.respondWithTags(w, r, tags, "Competency tagged successfully")
+}
+
+// UntagCompetency handles competency untagging requests
+// DELETE /api/v1/competencies/{id}/tags/{tag_id}
+// The tag itself is kept; curated tags are removed by admins and stewards of the competency only
+// HTTP Status Codes:
+//   - 204 No Content: Tag removed from the competency
+//   - 400 Bad Request: Invalid ID format
+//   - 401 Unauthorized: Anonymous request
+//   - 403 Forbidden: The tag is curated and the user isn't an admin or a steward of the competency
+//   - 404 Not Found: Competency not found, or it doesn't carry the tag
+//   - 500 Internal Server Error: Unexpected errors
+func (h *TagHandler) UntagCompetency(w http.ResponseWriter, r *http.Request) {
+	// Get IDs from URL parameters
+	id, err := idParam(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid competency ID format", "id", chi.URLParam(r, "id"), "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+	tagID, err := strconv.ParseInt(chi.URLParam(r, "tag_id"), 10, 32)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid tag ID format", "tag_id", chi.URLParam(r, "tag_id"), "error", err)
+		h.responseWriter.Error(w, r, dto.ValidationError{Field: "tag_id", Message: "invalid ID format"})
+		return
+	}
+
+	// Call use case
+	if err := h.tagUseCase.UntagCompetency(r.Context(), id, int32(tagID)); err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to untag competency", "competency_id", id, "tag_id", tagID, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "Competency untagged successfully", "competency_id", id, "tag_id", tagID)
+	h.responseWriter.NoContent(w)
+}
+
+// respondWithTag sends a tag
+func (h *TagHandler) respondWithTag(w http.ResponseWriter, r *http.Request, tag *domain.Tag, message string) {
+	tagDTO, err := ToTagDTO(tag, h.logger)
+	if err != nil {
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), message, "tag_id", tag.ID)
+	h.responseWriter.Success(w, tagDTO)
+}
+
+// respondWithTags sends a list of tags
+func (h *TagHandler) respondWithTags(w http.ResponseWriter, r *http.Request, tags []*domain.Tag, message string) {
+	tagDTOs, err := ToTagDTOs(tags, h.logger)
+	if err != nil {
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), message, "count", len(tagDTOs))
+	h.responseWriter.Success(w, dto.TagsResponse{Tags: tagDTOs})
+}
+
+// registerTagProblems registers the problem details of the tag errors
+func registerTagProblems(reg *response.Registry) {
+	reg.Register(domain.ErrTagNotFound, response.Problem{
+		Status: http.StatusNotFound,
+		Code:   "tag_not_found",
+		Title:  "Tag not found",
+		Detail: "Tag not found",
+	})
+	reg.Register(domain.ErrTagAlreadyExists, response.Problem{
+		Status: http.StatusConflict,
+		Code:   "tag_already_exists",
+		Title:  "Tag already exists",
+		Detail: "A tag with this name already exists",
+	})
+	reg.Register(domain.ErrInvalidTagName, response.Problem{
+		Status: http.StatusBadRequest,
+		Code:   "invalid_tag_name",
+		Title:  "Invalid tag name",
+		Detail: "Invalid tag name (1-50 letters, digits, spaces or -_.+#; at most 20 tags)",
+	})
+	reg.Register(domain.ErrInvalidTagMatch, response.Problem{
+		Status: http.StatusBadRequest,
+		Code:   "invalid_tag_match",
+		Title:  "Invalid tag match",
+		Detail: "tag_match must be any or all",
+	})
+}
@@ -0,0 +1,203 @@
+package handler
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/dto"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/request"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/response"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+)
+
+// TranslationHandler handles competency translation HTTP requests
+type TranslationHandler struct {
+	translationUseCase domain.TranslationUseCase
+	binder             *request.Binder
+	logger             *slog.Logger
+	responseWriter     *response.Writer
+}
+
+// NewTranslationHandler creates a new translation handler instance
+func NewTranslationHandler(translationUseCase domain.TranslationUseCase, binder *request.Binder, logger *slog.Logger, responseWriter *response.Writer) (*TranslationHandler, error) {
+	// Check if dependencies are nil
+	if translationUseCase == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "translationUseCase can not be nil")
+	}
+	if binder == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "binder can not be nil")
+	}
+	if logger == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "logger can not be nil")
+	}
+	if responseWriter == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "responseWriter can not be nil")
+	}
+	return &TranslationHandler{
+		translationUseCase: translationUseCase,
+		binder:             binder,
+		logger:             logger,
+		responseWriter:     responseWriter,
+	}, nil
+}
+
+// GetTranslations handles requests for the translations of a competency
+// GET /api/v1/competencies/{id}/translations
+// Admins and stewards of the competency only
+// HTTP Status Codes:
+//   - 200 OK: Translations retrieved, ordered by locale
+//   - 400 Bad Request: Invalid ID format
+//   - 401 Unauthorized: Anonymous request
+//   - 403 Forbidden: The user is neither an admin nor a steward of the competency
+//   - 404 Not Found: Competency not found
+//   - 500 Internal Server Error: Unexpected errors
+func (h *TranslationHandler) GetTranslations(w http.ResponseWriter, r *http.Request) {
+	// Get ID from URL parameter
+	id, err := idParam(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid competency ID format", "id", chi.URLParam(r, "id"), "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	translations, err := h.translationUseCase.GetTranslations(r.Context(), id)
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to get competency translations", "id", id, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.responseWriter.Success(w, dto.CompetencyTranslationsResponse{
+		FallbackLocale: string(domain.FallbackLocale),
+		Translations:   ToCompetencyTranslationDTOs(translations),
+	})
+}
+
+// SetTranslation handles requests creating or replacing the translation of a competency into a locale
+// PUT /api/v1/competencies/{id}/translations/{locale}
+// Admins and stewards of the competency only; the fallback locale is edited on the competency itself
+// HTTP Status Codes:
+//   - 200 OK: Translation saved
+//   - 400 Bad Request: Invalid ID format, unsupported locale, or invalid texts
+//   - 401 Unauthorized: Anonymous request
+//   - 403 Forbidden: The user is neither an admin nor a steward of the competency
+//   - 404 Not Found: Competency not found, no rubric, or a level that isn't described
+//   - 409 Conflict: Another competency shows the name in the locale
+//   - 500 Internal Server Error: Unexpected errors
+func (h *TranslationHandler) SetTranslation(w http.ResponseWriter, r *http.Request) {
+	// Get ID and locale from URL parameters
+	id, err := idParam(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid competency ID format", "id", chi.URLParam(r, "id"), "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+	locale := domain.Locale(chi.URLParam(r, "locale"))
+
+	// Decode and validate request body
+	var req dto.SetTranslationRequest
+	if err := h.binder.Bind(w, r, &req); err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid set translation request", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	translation, err := h.translationUseCase.SetTranslation(r.Context(), ToCompetencyTranslation(id, locale, req))
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to set competency translation", "id", id, "locale", locale, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "Competency translation set successfully", "id", id, "locale", locale)
+	h.responseWriter.Success(w, ToCompetencyTranslationDTO(translation))
+}
+
+// DeleteTranslation handles requests deleting the translation of a competency into a locale
+// DELETE /api/v1/competencies/{id}/translations/{locale}
+// Admins and stewards of the competency only
+// HTTP Status Codes:
+//   - 204 No Content: Translation deleted
+//   - 400 Bad Request: Invalid ID format or unsupported locale
+//   - 401 Unauthorized: Anonymous request
+//   - 403 Forbidden: The user is neither an admin nor a steward of the competency
+//   - 404 Not Found: The competency has no translation into the locale
+//   - 500 Internal Server Error: Unexpected errors
+func (h *TranslationHandler) DeleteTranslation(w http.ResponseWriter, r *http.Request) {
+	// Get ID and locale from URL parameters
+	id, err := idParam(r)
+	if err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid competency ID format", "id", chi.URLParam(r, "id"), "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+	locale := domain.Locale(chi.URLParam(r, "locale"))
+
+	// Call use case
+	if err := h.translationUseCase.DeleteTranslation(r.Context(), id, locale); err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to delete competency translation", "id", id, "locale", locale, "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.logger.InfoContext(r.Context(), "Competency translation deleted successfully", "id", id, "locale", locale)
+	h.responseWriter.NoContent(w)
+}
+
+// GetMissing handles missing translations report requests
+// GET /api/v1/translations/missing?locale={de|fa}
+// Admins only; every locale but the fallback one is reported without locale
+// HTTP Status Codes:
+//   - 200 OK: Competencies with missing translations
+//   - 400 Bad Request: Unsupported locale
+//   - 401 Unauthorized: Anonymous request
+//   - 403 Forbidden: The user isn't an admin
+//   - 500 Internal Server Error: Unexpected errors
+func (h *TranslationHandler) GetMissing(w http.ResponseWriter, r *http.Request) {
+	// Decode and validate query parameters
+	var query dto.MissingTranslationsQuery
+	if err := request.BindQuery(r.URL.Query(), &query); err != nil {
+		h.logger.WarnContext(r.Context(), "Invalid missing translations query", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	// Call use case
+	missing, err := h.translationUseCase.GetMissing(r.Context(), domain.Locale(query.Locale))
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "Failed to get missing translations", "error", err)
+		h.responseWriter.Error(w, r, err)
+		return
+	}
+
+	h.responseWriter.Success(w, dto.MissingTranslationsResponse{
+		Missing: ToMissingTranslationDTOs(missing),
+		Count:   len(missing),
+	})
+}
+
+// registerTranslationProblems registers the problem details of the locale and translation errors
+func registerTranslationProblems(reg *response.Registry) {
+	reg.Register(domain.ErrUnsupportedLocale, response.Problem{
+		Status: http.StatusBadRequest,
+		Code:   "unsupported_locale",
+		Title:  "Unsupported locale",
+		Detail: "Supported locales are en, de and fa",
+	})
+	reg.Register(domain.ErrInvalidTranslation, response.Problem{
+		Status: http.StatusBadRequest,
+		Code:   "invalid_translation",
+		Title:  "Invalid translation",
+		Detail: "The translation is invalid",
+	})
+	reg.Register(domain.ErrTranslationNotFound, response.Problem{
+		Status: http.StatusNotFound,
+		Code:   "translation_not_found",
+		Title:  "Translation not found",
+		Detail: "The competency has no translation into this locale",
+	})
+}
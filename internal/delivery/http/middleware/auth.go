@@ -0,0 +1,57 @@
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/response"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+)
+
+// Authenticate creates a middleware that resolves the user from a Bearer token
+// Requests without an Authorization header continue anonymously
+// Requests with an invalid or expired token are rejected with 401
+// The authenticated user is stored in the request context (see domain.ActorFromContext)
+func Authenticate(tokenGenerator domain.TokenGenerator, responseWriter *response.Writer, logger *slog.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			header := r.Header.Get("Authorization")
+			if header == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			scheme, token, found := strings.Cut(header, " ")
+			if !found || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+				logger.WarnContext(r.Context(), "Malformed Authorization header")
+				responseWriter.Error(w, r, domain.ErrInvalidToken)
+				return
+			}
+
+			user, err := tokenGenerator.Validate(r.Context(), strings.TrimSpace(token))
+			if err != nil {
+				logger.WarnContext(r.Context(), "Token validation failed", "error", err)
+				responseWriter.Error(w, r, err)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(domain.ContextWithActor(r.Context(), user)))
+		})
+	}
+}
+
+// RequireAdmin creates a middleware that only lets admins through
+// Requires the Authenticate middleware earlier in the chain; anonymous requests get 401, other users 403
+func RequireAdmin(responseWriter *response.Writer, logger *slog.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if err := domain.RequireAdmin(r.Context()); err != nil {
+				logger.WarnContext(r.Context(), "Admin route refused", "reason", err, "path", r.URL.Path)
+				responseWriter.Error(w, r, err)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
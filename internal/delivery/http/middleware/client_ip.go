@@ -0,0 +1,135 @@
+package middleware
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+// ClientIPResolver determines the originating client IP of a request
+// Forwarding headers are only trusted when the direct peer is a configured trusted proxy,
+// otherwise any client could spoof its IP by sending X-Forwarded-For itself
+type ClientIPResolver struct {
+	trustedProxies []netip.Prefix
+}
+
+// NewClientIPResolver creates a resolver trusting forwarding headers from the given CIDRs
+// Plain IP addresses are accepted and treated as single-host prefixes
+func NewClientIPResolver(trustedProxies []string) (*ClientIPResolver, error) {
+	prefixes := make([]netip.Prefix, 0, len(trustedProxies))
+	for _, cidr := range trustedProxies {
+		cidr = strings.TrimSpace(cidr)
+		if cidr == "" {
+			continue
+		}
+
+		if !strings.Contains(cidr, "/") {
+			addr, err := netip.ParseAddr(cidr)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s", ErrInvalidTrustedProxy, cidr)
+			}
+			prefixes = append(prefixes, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+			continue
+		}
+
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidTrustedProxy, cidr)
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+
+	return &ClientIPResolver{trustedProxies: prefixes}, nil
+}
+
+// ClientIP returns the client IP of the request
+// When the peer is a trusted proxy, the forwarding chain (Forwarded, then X-Forwarded-For)
+// is walked from right to left and the first address that is not a trusted proxy is returned
+func (c *ClientIPResolver) ClientIP(r *http.Request) string {
+	peer, ok := parseHostIP(r.RemoteAddr)
+	if !ok {
+		return r.RemoteAddr
+	}
+	if !c.isTrusted(peer) {
+		return peer.String()
+	}
+
+	chain := forwardedFor(r.Header)
+	if len(chain) == 0 {
+		chain = xForwardedFor(r.Header)
+	}
+
+	client := peer
+	for i := len(chain) - 1; i >= 0; i-- {
+		addr, ok := parseHostIP(chain[i])
+		if !ok {
+			// Unparseable hop (e.g. obfuscated identifier): stop at the last known address
+			break
+		}
+		client = addr
+		if !c.isTrusted(addr) {
+			break
+		}
+	}
+
+	return client.String()
+}
+
+// isTrusted reports whether addr belongs to a trusted proxy
+func (c *ClientIPResolver) isTrusted(addr netip.Addr) bool {
+	for _, prefix := range c.trustedProxies {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// xForwardedFor returns the hops listed in all X-Forwarded-For headers, in order
+func xForwardedFor(header http.Header) []string {
+	var hops []string
+	for _, value := range header.Values("X-Forwarded-For") {
+		for _, hop := range strings.Split(value, ",") {
+			if hop = strings.TrimSpace(hop); hop != "" {
+				hops = append(hops, hop)
+			}
+		}
+	}
+	return hops
+}
+
+// forwardedFor returns the "for" parameters listed in all RFC 7239 Forwarded headers, in order
+func forwardedFor(header http.Header) []string {
+	var hops []string
+	for _, value := range header.Values("Forwarded") {
+		for _, element := range strings.Split(value, ",") {
+			for _, pair := range strings.Split(element, ";") {
+				name, val, found := strings.Cut(strings.TrimSpace(pair), "=")
+				if !found || !strings.EqualFold(name, "for") {
+					continue
+				}
+				// Quoted values carry IPv6 addresses and ports: for="[2001:db8::1]:4711"
+				val = strings.Trim(val, `"`)
+				hops = append(hops, val)
+			}
+		}
+	}
+	return hops
+}
+
+// parseHostIP parses an IP address that may carry a port and IPv6 brackets
+func parseHostIP(value string) (netip.Addr, bool) {
+	value = strings.TrimSpace(value)
+	if host, _, err := net.SplitHostPort(value); err == nil {
+		value = host
+	}
+	value = strings.TrimSuffix(strings.TrimPrefix(value, "["), "]")
+
+	addr, err := netip.ParseAddr(value)
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	return addr.Unmap(), true
+}
@@ -0,0 +1,153 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestNewClientIPResolver checks the parsing of trusted proxy lists
+func TestNewClientIPResolver(t *testing.T) {
+	tests := []struct {
+		name    string
+		proxies []string
+		wantErr bool
+	}{
+		{name: "none", proxies: nil},
+		{name: "CIDRs", proxies: []string{"10.0.0.0/8", "fd00::/8"}},
+		{name: "plain addresses", proxies: []string{"192.0.2.1", "2001:db8::1"}},
+		{name: "blank entries and spaces", proxies: []string{" 10.0.0.0/8 ", "", "  "}},
+		{name: "invalid address", proxies: []string{"10.0.0.256"}, wantErr: true},
+		{name: "invalid CIDR", proxies: []string{"10.0.0.0/33"}, wantErr: true},
+		{name: "hostname", proxies: []string{"proxy.internal"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewClientIPResolver(tt.proxies)
+			if tt.wantErr != errors.Is(err, ErrInvalidTrustedProxy) {
+				t.Errorf("NewClientIPResolver(%q) error = %v, want error %v", tt.proxies, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestClientIP checks that forwarding headers are only followed through trusted proxies
+func TestClientIP(t *testing.T) {
+	resolver, err := NewClientIPResolver([]string{"10.0.0.0/8", "192.0.2.1", "fd00::/8"})
+	if err != nil {
+		t.Fatalf("NewClientIPResolver: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		header     http.Header
+		want       string
+	}{
+		{
+			name:       "direct client",
+			remoteAddr: "203.0.113.7:5000",
+			want:       "203.0.113.7",
+		},
+		{
+			name:       "spoofed header from an untrusted peer",
+			remoteAddr: "203.0.113.7:5000",
+			header:     http.Header{"X-Forwarded-For": {"198.51.100.1"}},
+			want:       "203.0.113.7",
+		},
+		{
+			name:       "trusted proxy without forwarding headers",
+			remoteAddr: "10.1.2.3:5000",
+			want:       "10.1.2.3",
+		},
+		{
+			name:       "X-Forwarded-For through a trusted proxy",
+			remoteAddr: "10.1.2.3:5000",
+			header:     http.Header{"X-Forwarded-For": {"198.51.100.1"}},
+			want:       "198.51.100.1",
+		},
+		{
+			name:       "spoofed hops left of the first untrusted address are ignored",
+			remoteAddr: "10.1.2.3:5000",
+			header:     http.Header{"X-Forwarded-For": {"1.1.1.1, 198.51.100.1, 10.9.9.9"}},
+			want:       "198.51.100.1",
+		},
+		{
+			name:       "repeated X-Forwarded-For headers",
+			remoteAddr: "192.0.2.1:443",
+			header:     http.Header{"X-Forwarded-For": {"1.1.1.1, 198.51.100.1", "10.9.9.9"}},
+			want:       "198.51.100.1",
+		},
+		{
+			name:       "chain of trusted proxies only",
+			remoteAddr: "10.1.2.3:5000",
+			header:     http.Header{"X-Forwarded-For": {"10.8.8.8, 10.9.9.9"}},
+			want:       "10.8.8.8",
+		},
+		{
+			name:       "Forwarded takes precedence over X-Forwarded-For",
+			remoteAddr: "10.1.2.3:5000",
+			header: http.Header{
+				"Forwarded":       {"for=198.51.100.2;proto=https"},
+				"X-Forwarded-For": {"198.51.100.1"},
+			},
+			want: "198.51.100.2",
+		},
+		{
+			name:       "Forwarded with a quoted IPv6 address and port",
+			remoteAddr: "10.1.2.3:5000",
+			header:     http.Header{"Forwarded": {`for="[2001:db8::7]:4711", for=10.9.9.9`}},
+			want:       "2001:db8::7",
+		},
+		{
+			name:       "Forwarded with an upper-case parameter name",
+			remoteAddr: "10.1.2.3:5000",
+			header:     http.Header{"Forwarded": {"By=10.0.0.1;For=198.51.100.3"}},
+			want:       "198.51.100.3",
+		},
+		{
+			name:       "obfuscated hop stops the walk",
+			remoteAddr: "10.1.2.3:5000",
+			header:     http.Header{"Forwarded": {"for=198.51.100.1, for=_hidden, for=10.9.9.9"}},
+			want:       "10.9.9.9",
+		},
+		{
+			name:       "IPv6 peer in a trusted prefix",
+			remoteAddr: "[fd00::1]:5000",
+			header:     http.Header{"X-Forwarded-For": {"2001:db8::9"}},
+			want:       "2001:db8::9",
+		},
+		{
+			name:       "IPv4-mapped IPv6 peer",
+			remoteAddr: "[::ffff:10.1.2.3]:5000",
+			header:     http.Header{"X-Forwarded-For": {"::ffff:198.51.100.1"}},
+			want:       "198.51.100.1",
+		},
+		{
+			name:       "peer without a port",
+			remoteAddr: "203.0.113.7",
+			want:       "203.0.113.7",
+		},
+		{
+			name:       "unparseable peer is returned as is",
+			remoteAddr: "@unix",
+			header:     http.Header{"X-Forwarded-For": {"198.51.100.1"}},
+			want:       "@unix",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.RemoteAddr = tt.remoteAddr
+			for name, values := range tt.header {
+				for _, value := range values {
+					r.Header.Add(name, value)
+				}
+			}
+			if got := resolver.ClientIP(r); got != tt.want {
+				t.Errorf("ClientIP() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,26 +1,147 @@
 package middleware
 
-import "net/http"
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/mehrnoosh-hk/devnorth-back/config"
+)
+
+// originPattern matches an origin against an allowlist entry
+// An entry is either an exact origin or contains a single "*" wildcard (https://*.example.com, http://localhost:*)
+type originPattern struct {
+	prefix   string
+	suffix   string
+	wildcard bool
+}
+
+// matches reports whether the (lower-cased) origin satisfies the pattern
+func (p originPattern) matches(origin string) bool {
+	if !p.wildcard {
+		return origin == p.prefix
+	}
+	return len(origin) > len(p.prefix)+len(p.suffix) &&
+		strings.HasPrefix(origin, p.prefix) &&
+		strings.HasSuffix(origin, p.suffix)
+}
+
+// CORS creates a middleware that applies the configured cross-origin policy
+// Behaviour:
+//   - Requests without an Origin header are passed through untouched
+//   - Allowed origins are echoed in Access-Control-Allow-Origin ("*" only when any origin is allowed without credentials)
+//   - Preflight requests (OPTIONS + Access-Control-Request-Method) are answered with 204 and never reach handlers
+//   - Disallowed origins get no CORS headers, so the browser blocks the response
+//   - Vary: Origin is set whenever the response depends on the Origin header, so caches don't mix origins
+func CORS(cfg config.CORSConfig) func(http.Handler) http.Handler {
+	allowAnyOrigin := false
+	patterns := make([]originPattern, 0, len(cfg.AllowedOrigins))
+	for _, origin := range cfg.AllowedOrigins {
+		origin = strings.ToLower(strings.TrimSpace(origin))
+		if origin == "*" {
+			allowAnyOrigin = true
+			continue
+		}
+		prefix, suffix, wildcard := strings.Cut(origin, "*")
+		patterns = append(patterns, originPattern{prefix: prefix, suffix: suffix, wildcard: wildcard})
+	}
+
+	allowedMethods := make([]string, len(cfg.AllowedMethods))
+	for i, method := range cfg.AllowedMethods {
+		allowedMethods[i] = strings.ToUpper(method)
+	}
+	allowAnyHeader := slices.Contains(cfg.AllowedHeaders, "*")
+	allowedHeaders := make([]string, len(cfg.AllowedHeaders))
+	for i, header := range cfg.AllowedHeaders {
+		allowedHeaders[i] = http.CanonicalHeaderKey(header)
+	}
+
+	methodsValue := strings.Join(allowedMethods, ", ")
+	headersValue := strings.Join(allowedHeaders, ", ")
+	exposedValue := strings.Join(cfg.ExposedHeaders, ", ")
+	maxAgeValue := strconv.Itoa(cfg.MaxAge)
+
+	// With "*" and no credentials the response is identical for every origin
+	echoOrigin := !allowAnyOrigin || cfg.AllowCredentials
+
+	isOriginAllowed := func(origin string) bool {
+		if allowAnyOrigin {
+			return true
+		}
+		origin = strings.ToLower(origin)
+		for _, pattern := range patterns {
+			if pattern.matches(origin) {
+				return true
+			}
+		}
+		return false
+	}
 
-// CORS creates a middleware that adds CORS headers
-// For POC: allows all origins
-// Production: should restrict to specific origins
-func CORS() func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			// Allow all origins for POC (change in production!)
-			w.Header().Set("Access-Control-Allow-Origin", "*")
-			w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-			w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
-			w.Header().Set("Access-Control-Max-Age", "3600")
-
-			// Handle preflight requests
-			if r.Method == http.MethodOptions {
+			origin := r.Header.Get("Origin")
+			preflight := r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != ""
+
+			headers := w.Header()
+			if echoOrigin {
+				headers.Add("Vary", "Origin")
+			}
+			if preflight {
+				headers.Add("Vary", "Access-Control-Request-Method")
+				headers.Add("Vary", "Access-Control-Request-Headers")
+			}
+
+			if origin == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if !isOriginAllowed(origin) {
+				if preflight {
+					w.WriteHeader(http.StatusNoContent)
+					return
+				}
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if echoOrigin {
+				headers.Set("Access-Control-Allow-Origin", origin)
+			} else {
+				headers.Set("Access-Control-Allow-Origin", "*")
+			}
+			if cfg.AllowCredentials {
+				headers.Set("Access-Control-Allow-Credentials", "true")
+			}
+
+			if !preflight {
+				if exposedValue != "" {
+					headers.Set("Access-Control-Expose-Headers", exposedValue)
+				}
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			// Preflight: only advertise the policy when the requested method is allowed
+			requestedMethod := strings.ToUpper(r.Header.Get("Access-Control-Request-Method"))
+			if !slices.Contains(allowedMethods, requestedMethod) {
 				w.WriteHeader(http.StatusNoContent)
 				return
 			}
 
-			next.ServeHTTP(w, r)
+			headers.Set("Access-Control-Allow-Methods", methodsValue)
+			if allowAnyHeader {
+				if requested := r.Header.Get("Access-Control-Request-Headers"); requested != "" {
+					headers.Set("Access-Control-Allow-Headers", requested)
+				}
+			} else if headersValue != "" {
+				headers.Set("Access-Control-Allow-Headers", headersValue)
+			}
+			if cfg.MaxAge > 0 {
+				headers.Set("Access-Control-Max-Age", maxAgeValue)
+			}
+			w.WriteHeader(http.StatusNoContent)
 		})
 	}
 }
@@ -0,0 +1,141 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mehrnoosh-hk/devnorth-back/config"
+)
+
+// TestCORSOriginMatching checks which origins are allowed by exact and wildcard allowlist entries
+func TestCORSOriginMatching(t *testing.T) {
+	handler := CORS(config.CORSConfig{
+		AllowedOrigins: []string{"https://app.example.com", " https://*.Example.org ", "http://localhost:*"},
+		AllowedMethods: []string{"GET"},
+	})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	tests := []struct {
+		origin  string
+		allowed bool
+	}{
+		{origin: "https://app.example.com", allowed: true},
+		{origin: "HTTPS://APP.EXAMPLE.COM", allowed: true},
+		{origin: "http://app.example.com", allowed: false},
+		{origin: "https://app.example.com:8443", allowed: false},
+		{origin: "https://evil-app.example.com", allowed: false},
+		{origin: "https://app.example.com.evil.com", allowed: false},
+		{origin: "https://www.example.org", allowed: true},
+		{origin: "https://a.b.example.org", allowed: true},
+		{origin: "https://example.org", allowed: false},
+		{origin: "https://.example.org", allowed: false},
+		{origin: "https://evilexample.org", allowed: false},
+		{origin: "https://www.example.org.evil.com", allowed: false},
+		{origin: "http://localhost:3000", allowed: true},
+		{origin: "http://localhost:", allowed: false},
+		{origin: "http://localhost", allowed: false},
+		{origin: "https://localhost:3000", allowed: false},
+		{origin: "null", allowed: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.origin, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.Header.Set("Origin", tt.origin)
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, r)
+
+			got := rec.Header().Get("Access-Control-Allow-Origin")
+			switch {
+			case tt.allowed && got != tt.origin:
+				t.Errorf("Access-Control-Allow-Origin = %q, want the origin echoed", got)
+			case !tt.allowed && got != "":
+				t.Errorf("Access-Control-Allow-Origin = %q, want none", got)
+			}
+			if vary := rec.Header().Values("Vary"); len(vary) == 0 || vary[0] != "Origin" {
+				t.Errorf("Vary = %q, want Origin", vary)
+			}
+		})
+	}
+}
+
+// TestCORSAnyOrigin checks that "*" is only answered literally when no credentials are allowed
+func TestCORSAnyOrigin(t *testing.T) {
+	tests := []struct {
+		name        string
+		credentials bool
+		want        string
+		wantVary    bool
+	}{
+		{name: "without credentials", credentials: false, want: "*", wantVary: false},
+		{name: "with credentials", credentials: true, want: "https://anything.test", wantVary: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := CORS(config.CORSConfig{
+				AllowedOrigins:   []string{"*"},
+				AllowCredentials: tt.credentials,
+			})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.Header.Set("Origin", "https://anything.test")
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, r)
+
+			if got := rec.Header().Get("Access-Control-Allow-Origin"); got != tt.want {
+				t.Errorf("Access-Control-Allow-Origin = %q, want %q", got, tt.want)
+			}
+			if got := rec.Header().Get("Vary") == "Origin"; got != tt.wantVary {
+				t.Errorf("Vary: Origin set = %v, want %v", got, tt.wantVary)
+			}
+		})
+	}
+}
+
+// TestCORSPreflight checks that preflights are answered without reaching the handler
+func TestCORSPreflight(t *testing.T) {
+	handler := CORS(config.CORSConfig{
+		AllowedOrigins: []string{"https://app.example.com"},
+		AllowedMethods: []string{"get", "POST"},
+		AllowedHeaders: []string{"content-type", "authorization"},
+		MaxAge:         600,
+	})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("preflight reached the handler")
+	}))
+
+	tests := []struct {
+		name        string
+		origin      string
+		method      string
+		wantMethods string
+	}{
+		{name: "allowed origin and method", origin: "https://app.example.com", method: "post", wantMethods: "GET, POST"},
+		{name: "method not allowed", origin: "https://app.example.com", method: "DELETE"},
+		{name: "origin not allowed", origin: "https://evil.test", method: "POST"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodOptions, "/", nil)
+			r.Header.Set("Origin", tt.origin)
+			r.Header.Set("Access-Control-Request-Method", tt.method)
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, r)
+
+			if rec.Code != http.StatusNoContent {
+				t.Errorf("status = %d, want %d", rec.Code, http.StatusNoContent)
+			}
+			if got := rec.Header().Get("Access-Control-Allow-Methods"); got != tt.wantMethods {
+				t.Errorf("Access-Control-Allow-Methods = %q, want %q", got, tt.wantMethods)
+			}
+			wantHeaders, wantMaxAge := "", ""
+			if tt.wantMethods != "" {
+				wantHeaders, wantMaxAge = "Content-Type, Authorization", "600"
+			}
+			if got := rec.Header().Get("Access-Control-Allow-Headers"); got != wantHeaders {
+				t.Errorf("Access-Control-Allow-Headers = %q, want %q", got, wantHeaders)
+			}
+			if got := rec.Header().Get("Access-Control-Max-Age"); got != wantMaxAge {
+				t.Errorf("Access-Control-Max-Age = %q, want %q", got, wantMaxAge)
+			}
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package middleware
+
+import "errors"
+
+var (
+	// ErrInvalidTrustedProxy is returned when a trusted proxy is neither an IP nor a CIDR
+	ErrInvalidTrustedProxy = errors.New("invalid trusted proxy")
+
+	// ErrRateLimitExceeded is responded when a request exceeds one of its rate limits
+	ErrRateLimitExceeded = errors.New("rate limit exceeded")
+
+	// ErrRequestTimeout is responded when a request is not processed within its route group timeout
+	ErrRequestTimeout = errors.New("request timeout")
+
+	// ErrPanicRecovered is responded when a handler panicked
+	ErrPanicRecovered = errors.New("panic recovered")
+)
@@ -0,0 +1,66 @@
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/response"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+)
+
+// Locale creates a middleware that selects the locale competencies are read in (see domain.ContextWithLocale)
+// Behaviour:
+//   - ?lang= wins; an unsupported value is rejected with 400, so a typo doesn't silently fall back
+//   - Otherwise the supported language of Accept-Language with the highest q-value, the first one on ties
+//   - Otherwise domain.FallbackLocale
+//   - Region subtags are ignored (de-CH reads German), and Vary: Accept-Language is set so caches keep locales apart
+func Locale(responseWriter *response.Writer, logger *slog.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Add("Vary", "Accept-Language")
+
+			locale := negotiateLocale(r.Header.Get("Accept-Language"))
+			if lang := r.URL.Query().Get("lang"); lang != "" {
+				locale = domain.Locale(primarySubtag(lang))
+				if !locale.Valid() {
+					logger.InfoContext(r.Context(), "Unsupported locale requested", "lang", lang)
+					responseWriter.Error(w, r, domain.ErrUnsupportedLocale)
+					return
+				}
+			}
+
+			next.ServeHTTP(w, r.WithContext(domain.ContextWithLocale(r.Context(), locale)))
+		})
+	}
+}
+
+// negotiateLocale returns the supported locale an Accept-Language header prefers (RFC 9110 12.5.4)
+// Malformed entries are skipped; domain.FallbackLocale is returned when no entry is supported
+func negotiateLocale(header string) domain.Locale {
+	best, bestQuality := domain.FallbackLocale, 0.0
+	for _, entry := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(entry), ";")
+		quality := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			quality = parsed
+		}
+
+		locale := domain.Locale(primarySubtag(tag))
+		if locale.Valid() && quality > bestQuality {
+			best, bestQuality = locale, quality
+		}
+	}
+	return best
+}
+
+// primarySubtag returns the lower-cased language of a language tag (de for de-CH)
+func primarySubtag(tag string) string {
+	language, _, _ := strings.Cut(strings.TrimSpace(tag), "-")
+	return strings.ToLower(language)
+}
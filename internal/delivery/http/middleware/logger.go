@@ -6,14 +6,14 @@ import (
 	"time"
 )
 
-// responseWriter wraps http.ResponseWriter to capture status code
-type responseWriter struct {
+// statusRecorder wraps http.ResponseWriter to capture status code
+type statusRecorder struct {
 	http.ResponseWriter
 	statusCode int
 	written    bool
 }
 
-func (rw *responseWriter) WriteHeader(statusCode int) {
+func (rw *statusRecorder) WriteHeader(statusCode int) {
 	if !rw.written {
 		rw.statusCode = statusCode
 		rw.written = true
@@ -21,13 +21,18 @@ func (rw *responseWriter) WriteHeader(statusCode int) {
 	}
 }
 
-func (rw *responseWriter) Write(b []byte) (int, error) {
+func (rw *statusRecorder) Write(b []byte) (int, error) {
 	if !rw.written {
 		rw.WriteHeader(http.StatusOK)
 	}
 	return rw.ResponseWriter.Write(b)
 }
 
+// Unwrap lets http.ResponseController reach the underlying writer (Flush, deadlines)
+func (rw *statusRecorder) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
+
 // Logger creates a middleware that logs HTTP requests
 func Logger(logger *slog.Logger) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
@@ -35,7 +40,7 @@ func Logger(logger *slog.Logger) func(http.Handler) http.Handler {
 			start := time.Now()
 
 			// Wrap response writer to capture status code
-			wrapped := &responseWriter{
+			wrapped := &statusRecorder{
 				ResponseWriter: w,
 				statusCode:     http.StatusOK,
 				written:        false,
@@ -47,7 +52,7 @@ func Logger(logger *slog.Logger) func(http.Handler) http.Handler {
 			// Log request details
 			duration := time.Since(start)
 
-			logger.Info("HTTP request",
+			logger.InfoContext(r.Context(), "HTTP request",
 				"method", r.Method,
 				"path", r.URL.Path,
 				"status", wrapped.statusCode,
@@ -0,0 +1,29 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/response"
+)
+
+// RegisterProblems registers the problem details of the errors rejected by the middlewares
+func RegisterProblems(reg *response.Registry) {
+	reg.Register(ErrRateLimitExceeded, response.Problem{
+		Status: http.StatusTooManyRequests,
+		Code:   "rate_limit_exceeded",
+		Title:  "Rate limit exceeded",
+		Detail: "Too many requests, please try again later",
+	})
+	reg.Register(ErrRequestTimeout, response.Problem{
+		Status: http.StatusServiceUnavailable,
+		Code:   "request_timeout",
+		Title:  "Request timeout",
+		Detail: "The request took too long to process",
+	})
+	reg.Register(ErrPanicRecovered, response.Problem{
+		Status: http.StatusInternalServerError,
+		Code:   "internal_server_error",
+		Title:  "Internal server error",
+		Detail: "An unexpected error occurred",
+	})
+}
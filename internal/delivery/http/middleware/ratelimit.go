@@ -0,0 +1,55 @@
+package middleware
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"net/http"
+
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/response"
+	"github.com/mehrnoosh-hk/devnorth-back/pkg/ratelimit"
+)
+
+// RateLimitRule binds a named limiter limit to the identity it is counted against
+type RateLimitRule struct {
+	Limit string       // Name of the limit configured on the limiter
+	Key   RateLimitKey // Identity the limit applies to
+}
+
+// RateLimit creates a middleware that rejects requests exceeding any of the given rules
+// Rules are combined: e.g. per-IP plus per-email on login, and every applicable rule must allow the request
+// Rules whose key is absent from the request are skipped
+// If the limiter store fails, the request is allowed (fail open) and the error is logged
+func RateLimit(limiter *ratelimit.Limiter, responseWriter *response.Writer, logger *slog.Logger, rules ...RateLimitRule) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			for _, rule := range rules {
+				key, ok := rule.Key(r)
+				if !ok {
+					continue
+				}
+
+				allowed, err := limiter.Allow(r.Context(), rule.Limit, key)
+				if err != nil {
+					logger.ErrorContext(r.Context(), "Rate limiter unavailable, skipping limit", "error", err, "limit", rule.Limit)
+					continue
+				}
+
+				if !allowed {
+					logger.WarnContext(r.Context(), "Rate limit exceeded", "limit", rule.Limit, "key_hash", keyHash(key), "path", r.URL.Path)
+					responseWriter.Error(w, r, ErrRateLimitExceeded)
+					return
+				}
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// keyHash identifies a rate limit key in logs without writing it, since keys can be personal data (e.g. emails)
+// The same key always gives the same hash, so repeated offenders can still be correlated
+func keyHash(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,91 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+)
+
+// maxKeyBodyBytes caps how much of the request body is read to extract a body field key
+const maxKeyBodyBytes = 1 << 20 // 1 MB
+
+// RateLimitKey extracts the identity a rate limit applies to from a request
+// ok is false when the request carries no such identity; the limit is then skipped
+// Keys are prefixed with their kind (ip:, user:, <field>:) so they never collide
+type RateLimitKey func(r *http.Request) (key string, ok bool)
+
+// ClientIPKey keys requests by client IP, honouring forwarding headers from trusted proxies
+func ClientIPKey(resolver *ClientIPResolver) RateLimitKey {
+	return func(r *http.Request) (string, bool) {
+		return "ip:" + resolver.ClientIP(r), true
+	}
+}
+
+// UserIDKey keys requests by the authenticated user ID
+// Requires the Authenticate middleware earlier in the chain
+func UserIDKey() RateLimitKey {
+	return func(r *http.Request) (string, bool) {
+		user, ok := domain.ActorFromContext(r.Context())
+		if !ok {
+			return "", false
+		}
+		return fmt.Sprintf("user:%d", user.ID), true
+	}
+}
+
+// BodyFieldKey keys requests by a top-level string field of the JSON request body (e.g. login email)
+// The value is trimmed and lower-cased so "Foo@x.io" and "foo@x.io " share a limit
+// The bytes read are put back in front of the rest of the body, so handlers still get all of it;
+// a body larger than maxKeyBodyBytes carries no key
+func BodyFieldKey(field string) RateLimitKey {
+	return func(r *http.Request) (string, bool) {
+		if r.Body == nil {
+			return "", false
+		}
+
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxKeyBodyBytes))
+		r.Body = readCloser{Reader: io.MultiReader(bytes.NewReader(body), r.Body), Closer: r.Body}
+		if err != nil {
+			return "", false
+		}
+
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(body, &fields); err != nil {
+			return "", false
+		}
+
+		var value string
+		if err := json.Unmarshal(fields[field], &value); err != nil {
+			return "", false
+		}
+
+		value = strings.ToLower(strings.TrimSpace(value))
+		if value == "" {
+			return "", false
+		}
+		return field + ":" + value, true
+	}
+}
+
+// readCloser reads from Reader and closes Closer, e.g. a partly read request body behind the bytes already read
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// FirstKey uses the first identity the request carries, e.g. user ID, then IP
+func FirstKey(keys ...RateLimitKey) RateLimitKey {
+	return func(r *http.Request) (string, bool) {
+		for _, key := range keys {
+			if k, ok := key(r); ok {
+				return k, true
+			}
+		}
+		return "", false
+	}
+}
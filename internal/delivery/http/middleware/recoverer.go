@@ -0,0 +1,54 @@
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/response"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/metrics"
+)
+
+// Recoverer creates a middleware that turns handler panics into a 500 problem details response
+// The panic is logged with its stack trace (and the request ID through the context) and counted in metrics.PanicsTotal
+// It is placed before the Timeout middlewares: Timeout runs the handler in the request goroutine, so panics still
+// unwind through it, and the writes it lets through are recorded here, so no 500 follows a started response
+// http.ErrAbortHandler is re-panicked so net/http can abort the connection as intended
+func Recoverer(responseWriter *response.Writer, logger *slog.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			wrapped := &statusRecorder{
+				ResponseWriter: w,
+				statusCode:     http.StatusOK,
+				written:        false,
+			}
+
+			defer func() {
+				recovered := recover()
+				if recovered == nil {
+					return
+				}
+				if recovered == http.ErrAbortHandler {
+					panic(recovered)
+				}
+
+				metrics.PanicsTotal.Add(1)
+				logger.ErrorContext(r.Context(), "Panic recovered",
+					"panic", recovered,
+					"method", r.Method,
+					"path", r.URL.Path,
+					"stack", string(debug.Stack()),
+				)
+
+				// The handler already started the response: the status can't be changed anymore
+				if wrapped.written {
+					return
+				}
+
+				responseWriter.Error(wrapped, r, ErrPanicRecovered)
+			}()
+
+			next.ServeHTTP(wrapped, r)
+		})
+	}
+}
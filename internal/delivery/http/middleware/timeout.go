@@ -1,18 +1,137 @@
 package middleware
 
 import (
+	"context"
+	"errors"
+	"log/slog"
 	"net/http"
 	"time"
+
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/response"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/metrics"
 )
 
-// Timeout creates a middleware that sets a timeout for request processing
-// This timeout applies to the entire request lifecycle (from handler start to response)
-// If the handler exceeds the timeout, http.TimeoutHandler will:
-//   - Send a 503 Service Unavailable response with the timeout message
-//   - Cancel the handler's context (context-aware operations will stop)
-//   - Prevent any subsequent writes to the response writer
-func Timeout(timeout time.Duration) func(http.Handler) http.Handler {
+// timeoutWriteGrace is added to the route timeout when extending the connection write deadline,
+// leaving room to send the timeout response itself
+const timeoutWriteGrace = 5 * time.Second
+
+// timeoutScope is put in the request context by Timeout, so a Timeout nested in it replaces its deadline
+// instead of being bounded by it
+type timeoutScope struct {
+	parent     context.Context // Request context before the deadline
+	overridden bool            // A nested Timeout took over
+}
+
+// timeoutScopeKey is the context key for the timeoutScope of the enclosing Timeout
+type timeoutScopeKey struct{}
+
+// valuesContext has the values of one context and the deadline and cancellation of another
+type valuesContext struct {
+	context.Context
+	values context.Context
+}
+
+func (c valuesContext) Value(key any) any {
+	return c.values.Value(key)
+}
+
+// timeoutWriter discards the handler's response once the deadline has passed,
+// so the middleware can answer with a timeout error instead of e.g. a 500 caused by a cancelled query
+type timeoutWriter struct {
+	http.ResponseWriter
+	ctx      context.Context
+	scope    *timeoutScope
+	started  bool // The handler's response reached the client
+	timedOut bool // The handler tried to respond after the deadline
+}
+
+func (tw *timeoutWriter) WriteHeader(statusCode int) {
+	if tw.started || tw.timedOut {
+		return
+	}
+	if tw.ctx.Err() != nil && !tw.scope.overridden {
+		tw.timedOut = true
+		return
+	}
+	tw.started = true
+	tw.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (tw *timeoutWriter) Write(b []byte) (int, error) {
+	if !tw.started {
+		tw.WriteHeader(http.StatusOK)
+	}
+	if tw.timedOut {
+		return 0, http.ErrHandlerTimeout
+	}
+	return tw.ResponseWriter.Write(b)
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer (Flush, deadlines)
+func (tw *timeoutWriter) Unwrap() http.ResponseWriter {
+	return tw.ResponseWriter
+}
+
+// Timeout creates a middleware that bounds request processing of a route group with a context deadline
+// Unlike http.TimeoutHandler, the handler runs in the request goroutine and is expected to honour ctx
+// (pgx queries do); it is not abandoned while still running
+// Behaviour:
+//   - timeout > 0: the request context gets the deadline and the connection write deadline is extended to match,
+//     so groups may run longer than SERVER_WRITE_TIMEOUT. If the deadline passes before the handler responds,
+//     a 503 problem (ErrRequestTimeout) with the request ID is sent and the timeout is counted per group in metrics.TimeoutsTotal
+//   - timeout <= 0: no deadline at all (streaming); the connection write deadline is lifted
+//
+// A Timeout nested in another replaces its deadline: the router applies a default one to every request and
+// route groups override it, so a long-running group isn't cut off by the default
+func Timeout(group string, timeout time.Duration, responseWriter *response.Writer, logger *slog.Logger) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
-		return http.TimeoutHandler(next, timeout, "Request timeout")
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			controller := http.NewResponseController(w)
+
+			// Start from the request context before the enclosing deadline, keeping the values added since
+			if scope, ok := r.Context().Value(timeoutScopeKey{}).(*timeoutScope); ok {
+				scope.overridden = true
+				r = r.WithContext(valuesContext{Context: scope.parent, values: r.Context()})
+			}
+
+			if timeout <= 0 {
+				if err := controller.SetWriteDeadline(time.Time{}); err != nil {
+					logger.WarnContext(r.Context(), "Failed to lift write deadline", "error", err, "group", group)
+				}
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if err := controller.SetWriteDeadline(time.Now().Add(timeout + timeoutWriteGrace)); err != nil {
+				logger.WarnContext(r.Context(), "Failed to extend write deadline", "error", err, "group", group)
+			}
+
+			ctx, cancel := context.WithTimeout(r.Context(), timeout)
+			defer cancel()
+
+			scope := &timeoutScope{parent: r.Context()}
+			tw := &timeoutWriter{ResponseWriter: w, ctx: ctx, scope: scope}
+			next.ServeHTTP(tw, r.WithContext(context.WithValue(ctx, timeoutScopeKey{}, scope)))
+
+			// A nested Timeout handled its own deadline
+			if scope.overridden || !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return
+			}
+
+			metrics.TimeoutsTotal.Add(group, 1)
+			logger.WarnContext(r.Context(), "Request timed out",
+				"group", group,
+				"timeout_ms", timeout.Milliseconds(),
+				"method", r.Method,
+				"path", r.URL.Path,
+			)
+
+			// The handler's response already reached the client before the deadline
+			if tw.started {
+				return
+			}
+
+			responseWriter.Error(w, r, ErrRequestTimeout)
+		})
 	}
 }
@@ -0,0 +1,267 @@
+package openapi
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	timeType       = reflect.TypeFor[time.Time]()
+	rawMessageType = reflect.TypeFor[json.RawMessage]()
+)
+
+// Builder assembles an OpenAPI document
+// Schemas of Go types are derived from their json and validate struct tags (see request.Validate),
+// so they follow the DTOs as they change
+type Builder struct {
+	doc Document
+}
+
+// NewBuilder creates a builder for a document with the given info
+func NewBuilder(info Info) *Builder {
+	return &Builder{
+		doc: Document{
+			OpenAPI: Version,
+			Info:    info,
+			Paths:   make(map[string]*PathItem),
+			Components: Components{
+				Schemas:         make(map[string]*Schema),
+				SecuritySchemes: make(map[string]SecurityScheme),
+			},
+		},
+	}
+}
+
+// AddServer adds a base URL of the API
+func (b *Builder) AddServer(server Server) {
+	b.doc.Servers = append(b.doc.Servers, server)
+}
+
+// AddTag adds a tag grouping operations
+func (b *Builder) AddTag(tag Tag) {
+	b.doc.Tags = append(b.doc.Tags, tag)
+}
+
+// AddSecurityScheme registers an authentication method under name
+func (b *Builder) AddSecurityScheme(name string, scheme SecurityScheme) {
+	b.doc.Components.SecuritySchemes[name] = scheme
+}
+
+// AddSchema registers a named schema component and returns a reference to it
+func (b *Builder) AddSchema(name string, schema *Schema) *Schema {
+	b.doc.Components.Schemas[name] = schema
+	return &Schema{Ref: "#/components/schemas/" + name}
+}
+
+// Add adds an operation on a path (in OpenAPI template syntax, e.g. /competencies/{id})
+func (b *Builder) Add(method, path string, op *Operation) {
+	item, ok := b.doc.Paths[path]
+	if !ok {
+		item = &PathItem{}
+		b.doc.Paths[path] = item
+	}
+	(*item)[strings.ToLower(method)] = op
+}
+
+// Document returns the assembled document
+func (b *Builder) Document() *Document {
+	return &b.doc
+}
+
+// Schema returns the schema of the type of v
+// Named struct types are registered as components and returned as references
+func (b *Builder) Schema(v any) *Schema {
+	return b.schemaOf(reflect.TypeOf(v))
+}
+
+// QueryParameters returns the query parameters described by the `query` tags of the struct v
+// Constraints come from the validate tags; pointer fields are optional values, not nullable ones
+func (b *Builder) QueryParameters(v any) []Parameter {
+	t := reflect.TypeOf(v)
+	var params []Parameter
+	for i := range t.NumField() {
+		field := t.Field(i)
+		name := field.Tag.Get("query")
+		if name == "" || name == "-" || !field.IsExported() {
+			continue
+		}
+
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+		schema := b.schemaOf(fieldType)
+		rules := field.Tag.Get("validate")
+		applyRules(schema, rules)
+
+		params = append(params, Parameter{
+			Name:     name,
+			In:       "query",
+			Required: slices.Contains(strings.Split(rules, ","), "required"),
+			Schema:   schema,
+		})
+	}
+	return params
+}
+
+// schemaOf builds the schema of a Go type
+func (b *Builder) schemaOf(t reflect.Type) *Schema {
+	switch t {
+	case timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case rawMessageType:
+		return &Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		return nullable(b.schemaOf(t.Elem()))
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: b.schemaOf(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: b.schemaOf(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return b.objectSchema(t)
+		}
+		if _, ok := b.doc.Components.Schemas[t.Name()]; !ok {
+			// Reserve the name first so recursive types terminate
+			b.doc.Components.Schemas[t.Name()] = &Schema{}
+			b.doc.Components.Schemas[t.Name()] = b.objectSchema(t)
+		}
+		return &Schema{Ref: "#/components/schemas/" + t.Name()}
+	default:
+		return &Schema{}
+	}
+}
+
+// objectSchema builds the object schema of a struct type
+// A field is required when its validate tag says so, or, without validate tag, when it is never omitted
+func (b *Builder) objectSchema(t reflect.Type) *Schema {
+	schema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, options, _ := strings.Cut(tag, ",")
+
+		// Embedded structs are flattened like encoding/json does
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			embedded := b.objectSchema(field.Type)
+			for property, propertySchema := range embedded.Properties {
+				schema.Properties[property] = propertySchema
+			}
+			schema.Required = append(schema.Required, embedded.Required...)
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		property := b.schemaOf(field.Type)
+		rules, hasRules := field.Tag.Lookup("validate")
+		if hasRules {
+			applyRules(property, rules)
+		}
+		schema.Properties[name] = property
+
+		omitted := slices.Contains(strings.Split(options, ","), "omitempty")
+		if (hasRules && slices.Contains(strings.Split(rules, ","), "required")) || (!hasRules && !omitted) {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+
+	return schema
+}
+
+// applyRules maps validate tag rules to schema constraints
+func applyRules(schema *Schema, rules string) {
+	for _, rule := range strings.Split(rules, ",") {
+		name, param, _ := strings.Cut(rule, "=")
+		switch name {
+		case "min", "max":
+			bound, err := strconv.ParseFloat(param, 64)
+			if err != nil {
+				continue
+			}
+			setBound(schema, name == "min", bound)
+		case "email":
+			schema.Format = "email"
+		case "oneof":
+			for _, value := range strings.Fields(param) {
+				schema.Enum = append(schema.Enum, value)
+			}
+		}
+	}
+}
+
+// setBound sets the length, item count or value bound matching the schema type
+func setBound(schema *Schema, lower bool, bound float64) {
+	n := int(bound)
+	switch baseType(schema) {
+	case "string":
+		if lower {
+			schema.MinLength = &n
+		} else {
+			schema.MaxLength = &n
+		}
+	case "array":
+		if lower {
+			schema.MinItems = &n
+		} else {
+			schema.MaxItems = &n
+		}
+	case "integer", "number":
+		if lower {
+			schema.Minimum = &bound
+		} else {
+			schema.Maximum = &bound
+		}
+	}
+}
+
+// baseType returns the non-null type of a schema
+func baseType(schema *Schema) string {
+	switch typ := schema.Type.(type) {
+	case string:
+		return typ
+	case []string:
+		return typ[0]
+	default:
+		return ""
+	}
+}
+
+// nullable allows null in addition to the schema's type
+// References are returned as is: JSON Schema can't combine $ref with a type
+func nullable(schema *Schema) *Schema {
+	if typ, ok := schema.Type.(string); ok {
+		schema.Type = []string{typ, "null"}
+	}
+	return schema
+}
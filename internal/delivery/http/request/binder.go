@@ -0,0 +1,131 @@
+package request
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/dto"
+)
+
+// Binder decodes JSON request bodies into DTOs and validates them
+// Decoding is strict: the body size is capped, unknown fields and trailing data are rejected,
+// and the Content-Type must be JSON
+type Binder struct {
+	maxBodyBytes int64
+}
+
+// NewBinder creates a binder accepting bodies of at most maxBodyBytes bytes
+func NewBinder(maxBodyBytes int64) (*Binder, error) {
+	if maxBodyBytes <= 0 {
+		return nil, ErrInvalidMaxBodyBytes
+	}
+	return &Binder{maxBodyBytes: maxBodyBytes}, nil
+}
+
+// Bind decodes the JSON body of r into dst (a pointer to a struct) and validates it (see Validate)
+// The returned error can be passed to response.Writer.Error as is:
+//   - ErrUnsupportedMediaType: Content-Type is not application/json (or a +json type)
+//   - ErrBodyTooLarge: the body exceeds the limit
+//   - dto.ValidationError: the body is empty, malformed, has trailing data, or a field has the wrong type or is unknown
+//   - dto.ValidationErrors: every struct tag violation
+func (b *Binder) Bind(w http.ResponseWriter, r *http.Request, dst any) error {
+	if !isJSONContentType(r.Header.Get("Content-Type")) {
+		return ErrUnsupportedMediaType
+	}
+
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, b.maxBodyBytes))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(dst); err != nil {
+		return decodeError(err)
+	}
+
+	// The body must hold exactly one JSON value
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			return ErrBodyTooLarge
+		}
+		return ErrTrailingData
+	}
+
+	return Validate(dst)
+}
+
+// ReadBody reads the raw body of r, for bodies that aren't JSON DTOs (such as uploaded files)
+// Returns ErrBodyTooLarge when the body exceeds the limit and ErrEmptyBody when there is none
+func (b *Binder) ReadBody(w http.ResponseWriter, r *http.Request) ([]byte, error) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, b.maxBodyBytes))
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			return nil, ErrBodyTooLarge
+		}
+		return nil, err
+	}
+	if len(body) == 0 {
+		return nil, ErrEmptyBody
+	}
+	return body, nil
+}
+
+// isJSONContentType reports whether the media type is application/json or a structured +json type
+func isJSONContentType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+// decodeError converts a json decoding error into the error reported to the client
+func decodeError(err error) error {
+	var maxBytesErr *http.MaxBytesError
+	var typeErr *json.UnmarshalTypeError
+
+	switch {
+	case errors.Is(err, io.EOF):
+		return ErrEmptyBody
+
+	case errors.As(err, &maxBytesErr):
+		return ErrBodyTooLarge
+
+	case errors.As(err, &typeErr) && typeErr.Field != "":
+		return dto.ValidationError{
+			Field:   typeErr.Field,
+			Message: fmt.Sprintf("must be of type %s", jsonTypeName(typeErr.Type.Kind().String())),
+		}
+
+	case strings.HasPrefix(err.Error(), "json: unknown field "):
+		// encoding/json has no typed error for unknown fields
+		field := strings.Trim(strings.TrimPrefix(err.Error(), "json: unknown field "), `"`)
+		return dto.ValidationError{
+			Field:   field,
+			Message: "unknown field",
+		}
+
+	default:
+		return ErrInvalidJSON
+	}
+}
+
+// jsonTypeName names a Go kind the way API clients know it
+func jsonTypeName(kind string) string {
+	switch {
+	case kind == "string":
+		return "string"
+	case kind == "bool":
+		return "boolean"
+	case strings.HasPrefix(kind, "int"), strings.HasPrefix(kind, "uint"), strings.HasPrefix(kind, "float"):
+		return "number"
+	case kind == "slice", kind == "array":
+		return "array"
+	default:
+		return "object"
+	}
+}
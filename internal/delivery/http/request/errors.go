@@ -0,0 +1,36 @@
+package request
+
+import (
+	"errors"
+
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/dto"
+)
+
+var (
+	// ErrInvalidMaxBodyBytes is returned when the binder is created with a non-positive body size limit
+	ErrInvalidMaxBodyBytes = errors.New("max body bytes must be greater than 0")
+
+	// ErrUnsupportedMediaType is returned when the request body is not declared as JSON
+	ErrUnsupportedMediaType = errors.New("unsupported media type")
+
+	// ErrBodyTooLarge is returned when the request body exceeds the configured limit
+	ErrBodyTooLarge = errors.New("request body too large")
+
+	// ErrInvalidJSON is returned when the request body cannot be decoded
+	ErrInvalidJSON = dto.ValidationError{
+		Field:   "body",
+		Message: "invalid JSON format",
+	}
+
+	// ErrEmptyBody is returned when the request body is missing
+	ErrEmptyBody = dto.ValidationError{
+		Field:   "body",
+		Message: "request body is required",
+	}
+
+	// ErrTrailingData is returned when the request body contains data after the JSON value
+	ErrTrailingData = dto.ValidationError{
+		Field:   "body",
+		Message: "request body must contain a single JSON value",
+	}
+)
@@ -0,0 +1,24 @@
+package request
+
+import (
+	"net/http"
+
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/response"
+)
+
+// RegisterProblems registers the problem details of the errors returned while binding requests
+// Decoding and validation errors are dto.ValidationError(s) and need no registration
+func RegisterProblems(reg *response.Registry) {
+	reg.Register(ErrUnsupportedMediaType, response.Problem{
+		Status: http.StatusUnsupportedMediaType,
+		Code:   "unsupported_media_type",
+		Title:  "Unsupported media type",
+		Detail: "Request body must be sent as application/json",
+	})
+	reg.Register(ErrBodyTooLarge, response.Problem{
+		Status: http.StatusRequestEntityTooLarge,
+		Code:   "request_body_too_large",
+		Title:  "Request body too large",
+		Detail: "The request body exceeds the maximum allowed size",
+	})
+}
@@ -0,0 +1,126 @@
+package request
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/dto"
+)
+
+// ErrInvalidQueryTag is returned when a DTO declares a query field of a type the binder can't parse
+var ErrInvalidQueryTag = errors.New("invalid query tag")
+
+// timeType is the reflected type of time.Time, parsed from RFC 3339 values
+var timeType = reflect.TypeFor[time.Time]()
+
+// BindQuery parses query parameters (r.URL.Query()) into dst (a pointer to a struct) and validates it (see Validate)
+// Fields are bound by their `query` tag; parameters without a matching field are ignored
+// Supported field types: string, integers, floats, bool, time.Time (RFC 3339), pointers to those
+// (nil when the parameter is absent) and slices of those (for repeated parameters)
+// Values that can't be parsed are returned together as dto.ValidationErrors
+func BindQuery(values url.Values, dst any) error {
+	value := reflect.ValueOf(dst)
+	if value.Kind() != reflect.Pointer || value.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("%w: destination must be a pointer to a struct, got %T", ErrInvalidQueryTag, dst)
+	}
+	value = value.Elem()
+	structType := value.Type()
+
+	var violations dto.ValidationErrors
+	for i := range structType.NumField() {
+		field := structType.Field(i)
+		name := field.Tag.Get("query")
+		if name == "" || name == "-" || !field.IsExported() {
+			continue
+		}
+		raw, ok := values[name]
+		if !ok {
+			continue
+		}
+
+		message, err := setQueryField(value.Field(i), raw)
+		if err != nil {
+			return fmt.Errorf("%w: %s.%s: %w", ErrInvalidQueryTag, structType.Name(), field.Name, err)
+		}
+		if message != "" {
+			violations = append(violations, dto.ValidationError{Field: name, Message: message})
+		}
+	}
+	if len(violations) > 0 {
+		return violations
+	}
+
+	return Validate(dst)
+}
+
+// setQueryField parses the values of a parameter into field
+// It returns the message for the client when a value is invalid, or an error if the field type is unsupported
+func setQueryField(field reflect.Value, raw []string) (string, error) {
+	switch {
+	case field.Kind() == reflect.Slice:
+		slice := reflect.MakeSlice(field.Type(), len(raw), len(raw))
+		for i, s := range raw {
+			if message, err := parseQueryValue(slice.Index(i), s); message != "" || err != nil {
+				return message, err
+			}
+		}
+		field.Set(slice)
+		return "", nil
+
+	case len(raw) > 1:
+		return "must be given once", nil
+
+	case field.Kind() == reflect.Pointer:
+		elem := reflect.New(field.Type().Elem())
+		if message, err := parseQueryValue(elem.Elem(), raw[0]); message != "" || err != nil {
+			return message, err
+		}
+		field.Set(elem)
+		return "", nil
+
+	default:
+		return parseQueryValue(field, raw[0])
+	}
+}
+
+// parseQueryValue parses a single value into dst
+func parseQueryValue(dst reflect.Value, s string) (string, error) {
+	if dst.Type() == timeType {
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return "must be an RFC 3339 date-time", nil
+		}
+		dst.Set(reflect.ValueOf(t))
+		return "", nil
+	}
+
+	switch dst.Kind() {
+	case reflect.String:
+		dst.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return "must be true or false", nil
+		}
+		dst.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, dst.Type().Bits())
+		if err != nil {
+			return "must be an integer", nil
+		}
+		dst.SetInt(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, dst.Type().Bits())
+		if err != nil {
+			return "must be a number", nil
+		}
+		dst.SetFloat(f)
+	default:
+		return "", fmt.Errorf("unsupported type %s", dst.Type())
+	}
+	return "", nil
+}
@@ -0,0 +1,248 @@
+package request
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/dto"
+)
+
+// ErrInvalidValidationTag is returned when a DTO declares a validate tag the validator doesn't understand
+var ErrInvalidValidationTag = errors.New("invalid validate tag")
+
+// Validate checks the `validate` struct tags of v (a struct or a pointer to one) and nested structs
+// Every violation is collected and returned together as dto.ValidationErrors, named after the JSON fields (or query parameters)
+// Supported rules, separated by commas:
+//   - required: the value must not be empty: a nil pointer, a blank string or an empty slice or map
+//   - min=N, max=N: length of strings (in characters), slices and maps, or the value of numbers
+//   - email: a bare email address
+//   - oneof=a b c: one of the space-separated values
+//
+// Rules other than required are skipped for empty values, so optional fields are only checked when set
+// Numbers are never empty: their bounds apply to zero too, so optional numbers must be pointers
+func Validate(v any) error {
+	value := reflect.ValueOf(v)
+	for value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return nil
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var violations dto.ValidationErrors
+	if err := validateStruct(value, "", &violations); err != nil {
+		return err
+	}
+	if len(violations) > 0 {
+		return violations
+	}
+	return nil
+}
+
+// validateStruct validates the fields of a struct value, prefixing field names with path
+func validateStruct(value reflect.Value, path string, violations *dto.ValidationErrors) error {
+	structType := value.Type()
+	for i := range structType.NumField() {
+		field := structType.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, ok := jsonFieldName(field)
+		if !ok {
+			continue
+		}
+		if path != "" {
+			name = path + "." + name
+		}
+
+		fieldValue := value.Field(i)
+		if tag, ok := field.Tag.Lookup("validate"); ok {
+			message, err := checkRules(fieldValue, tag)
+			if err != nil {
+				return fmt.Errorf("%w: %s.%s: %w", ErrInvalidValidationTag, structType.Name(), field.Name, err)
+			}
+			if message != "" {
+				*violations = append(*violations, dto.ValidationError{Field: name, Message: message})
+				continue
+			}
+		}
+
+		if err := validateNested(fieldValue, name, violations); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateNested descends into struct fields and slices of structs
+func validateNested(value reflect.Value, path string, violations *dto.ValidationErrors) error {
+	for value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return nil
+		}
+		value = value.Elem()
+	}
+
+	switch value.Kind() {
+	case reflect.Struct:
+		return validateStruct(value, path, violations)
+	case reflect.Slice, reflect.Array:
+		for i := range value.Len() {
+			if err := validateNested(value.Index(i), fmt.Sprintf("%s[%d]", path, i), violations); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// jsonFieldName returns the name of the field in JSON, false if it is not encoded
+// Query parameter DTOs have no json tags, their fields are named after the query tag
+func jsonFieldName(field reflect.StructField) (string, bool) {
+	tag, ok := field.Tag.Lookup("json")
+	if !ok {
+		tag = field.Tag.Get("query")
+	}
+	if tag == "-" {
+		return "", false
+	}
+	name, _, _ := strings.Cut(tag, ",")
+	if name == "" {
+		return field.Name, true
+	}
+	return name, true
+}
+
+// checkRules applies the comma-separated rules of tag to value
+// It returns the message of the first violated rule, or an error if a rule is malformed
+func checkRules(value reflect.Value, tag string) (string, error) {
+	rules := strings.Split(tag, ",")
+
+	empty := isEmpty(value)
+	if slices.Contains(rules, "required") && empty {
+		return "field is required", nil
+	}
+	if empty {
+		return "", nil
+	}
+
+	for value.Kind() == reflect.Pointer {
+		value = value.Elem()
+	}
+
+	for _, rule := range rules {
+		name, param, _ := strings.Cut(rule, "=")
+		var message string
+		var err error
+
+		switch name {
+		case "required":
+			continue
+		case "min":
+			message, err = checkBound(value, param, true)
+		case "max":
+			message, err = checkBound(value, param, false)
+		case "email":
+			message = checkEmail(value)
+		case "oneof":
+			message = checkOneOf(value, param)
+		default:
+			err = fmt.Errorf("unknown rule %q", name)
+		}
+
+		if err != nil || message != "" {
+			return message, err
+		}
+	}
+	return "", nil
+}
+
+// isEmpty reports whether value is missing: a nil pointer, a string containing only spaces, or an empty slice or map
+// Numbers and booleans are never empty, so their bounds are checked on zero too; optional ones are pointers
+func isEmpty(value reflect.Value) bool {
+	for value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return true
+		}
+		value = value.Elem()
+	}
+	switch value.Kind() {
+	case reflect.String:
+		return strings.TrimSpace(value.String()) == ""
+	case reflect.Slice, reflect.Map:
+		return value.Len() == 0
+	default:
+		return false
+	}
+}
+
+// checkBound checks a min (lower) or max bound
+func checkBound(value reflect.Value, param string, lower bool) (string, error) {
+	bound, err := strconv.ParseFloat(param, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid bound %q", param)
+	}
+
+	var actual float64
+	var unit string
+	switch value.Kind() {
+	case reflect.String:
+		actual, unit = float64(utf8.RuneCountInString(value.String())), " characters"
+	case reflect.Slice, reflect.Array, reflect.Map:
+		actual, unit = float64(value.Len()), " items"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		actual = float64(value.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		actual = float64(value.Uint())
+	case reflect.Float32, reflect.Float64:
+		actual = value.Float()
+	default:
+		return "", fmt.Errorf("min/max not supported on %s", value.Kind())
+	}
+
+	switch {
+	case lower && actual < bound:
+		if unit == "" {
+			return fmt.Sprintf("must be at least %s", param), nil
+		}
+		return fmt.Sprintf("must be at least %s%s", param, unit), nil
+	case !lower && actual > bound:
+		if unit == "" {
+			return fmt.Sprintf("must be at most %s", param), nil
+		}
+		return fmt.Sprintf("must be at most %s%s", param, unit), nil
+	}
+	return "", nil
+}
+
+// checkEmail checks that a string is a bare email address (no display name)
+func checkEmail(value reflect.Value) string {
+	if value.Kind() != reflect.String {
+		return "must be a valid email address"
+	}
+	email := strings.TrimSpace(value.String())
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Address != email {
+		return "must be a valid email address"
+	}
+	return ""
+}
+
+// checkOneOf checks that a string is one of the space-separated allowed values
+func checkOneOf(value reflect.Value, param string) string {
+	allowed := strings.Fields(param)
+	if value.Kind() == reflect.String && slices.Contains(allowed, value.String()) {
+		return ""
+	}
+	return fmt.Sprintf("must be one of: %s", strings.Join(allowed, ", "))
+}
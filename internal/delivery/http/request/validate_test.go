@@ -0,0 +1,183 @@
+package request
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/dto"
+)
+
+// TestValidate checks each rule, and that every violation is reported under the name of its field
+func TestValidate(t *testing.T) {
+	type item struct {
+		Name string `json:"name" validate:"required"`
+	}
+	type nested struct {
+		Owner *item  `json:"owner"`
+		Items []item `json:"items" validate:"max=3"`
+	}
+	type query struct {
+		Limit  *int32 `query:"limit" validate:"min=1,max=100"`
+		Offset int32  `query:"offset" validate:"min=0"`
+	}
+	type required struct {
+		Name  string   `json:"name" validate:"required"`
+		Count *int32   `json:"count" validate:"required"`
+		Tags  []string `json:"tags" validate:"required"`
+		Limit int32    `json:"limit" validate:"required"`
+	}
+	type bounds struct {
+		Name  string   `json:"name" validate:"min=2,max=5"`
+		Tags  []string `json:"tags" validate:"max=2"`
+		Limit int32    `json:"limit" validate:"min=1,max=10"`
+		Score *float32 `json:"score" validate:"min=0.5,max=1"`
+	}
+	type formats struct {
+		Email  string `json:"email" validate:"email"`
+		Status string `json:"status" validate:"oneof=draft published"`
+		Secret string `json:"-" validate:"required"`
+	}
+
+	ptr := func(n int32) *int32 { return &n }
+	score := func(f float32) *float32 { return &f }
+
+	tests := []struct {
+		name  string
+		value any
+		want  dto.ValidationErrors
+	}{
+		{
+			name:  "required values present",
+			value: required{Name: "Go", Count: ptr(0), Tags: []string{"x"}},
+		},
+		{
+			name:  "required values missing",
+			value: &required{Name: "   "},
+			want: dto.ValidationErrors{
+				{Field: "name", Message: "field is required"},
+				{Field: "count", Message: "field is required"},
+				{Field: "tags", Message: "field is required"},
+			},
+		},
+		{
+			name:  "bounds within range",
+			value: bounds{Name: "héllo", Tags: []string{"a", "b"}, Limit: 10, Score: score(0.5)},
+		},
+		{
+			name:  "bounds out of range",
+			value: bounds{Name: "a", Tags: []string{"a", "b", "c"}, Limit: 11, Score: score(1.5)},
+			want: dto.ValidationErrors{
+				{Field: "name", Message: "must be at least 2 characters"},
+				{Field: "tags", Message: "must be at most 2 items"},
+				{Field: "limit", Message: "must be at most 10"},
+				{Field: "score", Message: "must be at most 1"},
+			},
+		},
+		{
+			name:  "zero is checked against min",
+			value: bounds{Name: "Go", Limit: 0, Score: score(0)},
+			want: dto.ValidationErrors{
+				{Field: "limit", Message: "must be at least 1"},
+				{Field: "score", Message: "must be at least 0.5"},
+			},
+		},
+		{
+			name:  "characters are counted, not bytes",
+			value: bounds{Name: "ééééé", Limit: 1},
+		},
+		{
+			name:  "absent optional values are skipped",
+			value: query{},
+		},
+		{
+			name:  "set optional values are checked",
+			value: query{Limit: ptr(0), Offset: -1},
+			want: dto.ValidationErrors{
+				{Field: "limit", Message: "must be at least 1"},
+				{Field: "offset", Message: "must be at least 0"},
+			},
+		},
+		{
+			name:  "valid formats",
+			value: formats{Email: "ada@example.com", Status: "draft"},
+		},
+		{
+			name:  "invalid formats",
+			value: formats{Email: "Ada <ada@example.com>", Status: "archived"},
+			want: dto.ValidationErrors{
+				{Field: "email", Message: "must be a valid email address"},
+				{Field: "status", Message: "must be one of: draft, published"},
+			},
+		},
+		{
+			name:  "malformed email",
+			value: formats{Email: "ada.example.com"},
+			want:  dto.ValidationErrors{{Field: "email", Message: "must be a valid email address"}},
+		},
+		{
+			name:  "nested structs and slices are named by path",
+			value: nested{Owner: &item{}, Items: []item{{Name: "a"}, {Name: " "}}},
+			want: dto.ValidationErrors{
+				{Field: "owner.name", Message: "field is required"},
+				{Field: "items[1].name", Message: "field is required"},
+			},
+		},
+		{
+			name:  "nil nested struct is skipped",
+			value: nested{},
+		},
+		{
+			name:  "violated slice rule skips its elements",
+			value: nested{Items: []item{{}, {}, {}, {}}},
+			want:  dto.ValidationErrors{{Field: "items", Message: "must be at most 3 items"}},
+		},
+		{
+			name:  "nil pointer",
+			value: (*required)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.value)
+			if tt.want == nil {
+				if err != nil {
+					t.Fatalf("Validate() = %v, want no error", err)
+				}
+				return
+			}
+			var got dto.ValidationErrors
+			if !errors.As(err, &got) {
+				t.Fatalf("Validate() = %v, want dto.ValidationErrors", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestValidateRejectsInvalidTags checks that malformed rules are reported as programming errors, not violations
+func TestValidateRejectsInvalidTags(t *testing.T) {
+	tests := []struct {
+		name  string
+		value any
+	}{
+		{name: "unknown rule", value: struct {
+			Name string `json:"name" validate:"uppercase"`
+		}{Name: "Go"}},
+		{name: "bound that isn't a number", value: struct {
+			Name string `json:"name" validate:"min=two"`
+		}{Name: "Go"}},
+		{name: "bound on a type without length", value: struct {
+			Done bool `json:"done" validate:"max=1"`
+		}{Done: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := Validate(tt.value); !errors.Is(err, ErrInvalidValidationTag) {
+				t.Errorf("Validate() = %v, want ErrInvalidValidationTag", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package response
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/dto"
+)
+
+// problemTypePrefix builds the problem type URI from the problem code
+const problemTypePrefix = "urn:devnorth:problem:"
+
+// Problem describes how an error is rendered as an RFC 7807 problem details response
+type Problem struct {
+	Status int    // HTTP status code
+	Code   string // Stable error code, sent as the legacy "error" field and used in the type URI
+	Title  string // Short summary of the problem type
+	Detail string // Explanation sent to the client
+}
+
+// Type returns the problem type URI
+func (p Problem) Type() string {
+	return problemTypePrefix + p.Code
+}
+
+// Problems returned when an error is not registered
+var (
+	validationProblem = Problem{
+		Status: http.StatusBadRequest,
+		Code:   "validation_error",
+		Title:  "Validation failed",
+	}
+	internalProblem = Problem{
+		Status: http.StatusInternalServerError,
+		Code:   "internal_server_error",
+		Title:  "Internal server error",
+		Detail: "An unexpected error occurred",
+	}
+)
+
+// registryEntry maps one error to its problem
+type registryEntry struct {
+	target  error
+	problem Problem
+}
+
+// Registry maps errors to problems
+// Packages owning errors register their own mappings (e.g. handler.RegisterProblems),
+// so the writer doesn't need to know every error of the application
+type Registry struct {
+	mu      sync.RWMutex
+	entries []registryEntry
+}
+
+// NewRegistry creates an empty problem registry
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// Register maps target to a problem; errors wrapping target are matched with errors.Is
+// Registering the same target again replaces its problem
+func (reg *Registry) Register(target error, problem Problem) {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+
+	for i, entry := range reg.entries {
+		if entry.target == target {
+			reg.entries[i].problem = problem
+			return
+		}
+	}
+	reg.entries = append(reg.entries, registryEntry{target: target, problem: problem})
+}
+
+// Lookup returns the problem of the first registered target matching err
+func (reg *Registry) Lookup(err error) (Problem, bool) {
+	reg.mu.RLock()
+	defer reg.mu.RUnlock()
+
+	for _, entry := range reg.entries {
+		if errors.Is(err, entry.target) {
+			return entry.problem, true
+		}
+	}
+	return Problem{}, false
+}
+
+// Resolve returns the problem err is rendered as: its registered problem, the validation problem
+// for dto.ValidationError(s), or the internal server error problem, in which case found is false
+func (reg *Registry) Resolve(err error) (problem Problem, found bool) {
+	if problem, found := reg.Lookup(err); found {
+		return problem, true
+	}
+
+	var validationErrs dto.ValidationErrors
+	var validationErr dto.ValidationError
+	if errors.As(err, &validationErrs) || errors.As(err, &validationErr) {
+		return validationProblem, true
+	}
+	return internalProblem, false
+}
+
+// Problems returns every problem the registry can resolve to, sorted by status and code
+// Problems sharing a code are listed once
+func (reg *Registry) Problems() []Problem {
+	reg.mu.RLock()
+	problems := []Problem{validationProblem, internalProblem}
+	for _, entry := range reg.entries {
+		problems = append(problems, entry.problem)
+	}
+	reg.mu.RUnlock()
+
+	slices.SortStableFunc(problems, func(a, b Problem) int {
+		if a.Status != b.Status {
+			return a.Status - b.Status
+		}
+		return strings.Compare(a.Code, b.Code)
+	})
+	return slices.CompactFunc(problems, func(a, b Problem) bool {
+		return a.Code == b.Code
+	})
+}
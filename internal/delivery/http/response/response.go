@@ -8,115 +8,105 @@ import (
 	"net/http"
 
 	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/dto"
-	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/logging"
 )
 
 // Writer handles HTTP responses with structured logging
 type Writer struct {
-	logger *slog.Logger
+	logger   *slog.Logger
+	problems *Registry
 }
 
 // NewWriter creates a new response writer with the given logger
-func NewWriter(logger *slog.Logger) (*Writer, error) {
+// Errors are rendered as problem details using the mappings in problems
+func NewWriter(logger *slog.Logger, problems *Registry) (*Writer, error) {
 	if logger == nil {
 		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "logger can not be nil")
 	}
+	if problems == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "problems can not be nil")
+	}
 	return &Writer{
-		logger: logger,
+		logger:   logger,
+		problems: problems,
 	}, nil
 }
 
 // JSON sends a JSON response with the given status code
 // Only accepts types that implement JSONSerializable to ensure compile-time safety
 func (rw *Writer) JSON(w http.ResponseWriter, statusCode int, payload dto.JSONSerializable) {
-	w.Header().Set("Content-Type", "application/json")
+	rw.write(w, statusCode, "application/json", payload)
+}
 
+// write marshals the payload and sends it with the given status code and content type
+func (rw *Writer) write(w http.ResponseWriter, statusCode int, contentType string, payload dto.JSONSerializable) {
 	if payload != nil {
 		// 1. Marshal FIRST (in memory, no network communication yet)
 		data, err := json.Marshal(payload)
 		if err != nil {
 			// 2. Encoding failed? Send 500 BEFORE any body
+			w.Header().Set("Content-Type", "application/problem+json")
 			w.WriteHeader(http.StatusInternalServerError)
-			w.Write([]byte(`{"error":"internal_server_error","message":"Failed to encode response"}`))
+			w.Write([]byte(`{"type":"` + internalProblem.Type() + `","title":"Internal server error","status":500,"detail":"Failed to encode response","error":"internal_server_error"}`))
 			return
 		}
 
 		// 3. Only send status AFTER we know encoding succeeded
+		w.Header().Set("Content-Type", contentType)
 		w.WriteHeader(statusCode)
 		w.Write(data) // Now write the valid data
 	}
 }
 
-// Error sends an error response with appropriate HTTP status code
-func (rw *Writer) Error(w http.ResponseWriter, err error) {
-	var statusCode int
-	var errorCode string
-	var message string
+// Error sends an application/problem+json error response
+// The problem is looked up in the registry; unregistered validation errors become a 400 listing
+// every invalid field in "errors", anything else is logged and sent as a 500
+// A registered error can list fields too by wrapping dto.ValidationErrors, e.g. fmt.Errorf("%w: %w", err, fields)
+func (rw *Writer) Error(w http.ResponseWriter, r *http.Request, err error) {
+	problem, found := rw.problems.Resolve(err)
+	if !found {
+		rw.logger.ErrorContext(r.Context(), "Unhandled error in response", "error", err)
+	}
 
-	// Map domain errors to HTTP status codes
+	// Validation errors list the invalid fields; unregistered ones also carry their own detail
+	var fields []dto.FieldProblem
+	var validationErrs dto.ValidationErrors
+	var validationErr dto.ValidationError
 	switch {
-	case errors.Is(err, domain.ErrEmailAlreadyExists):
-		statusCode = http.StatusConflict
-		errorCode = "email_already_exists"
-		message = "An account with this email already exists"
-
-	case errors.Is(err, domain.ErrInvalidCredentials):
-		statusCode = http.StatusUnauthorized
-		errorCode = "invalid_credentials"
-		message = "Invalid email or password"
-
-	case errors.Is(err, domain.ErrInvalidEmail):
-		statusCode = http.StatusBadRequest
-		errorCode = "invalid_email"
-		message = "Invalid email format"
-
-	case errors.Is(err, domain.ErrInvalidPassword):
-		statusCode = http.StatusBadRequest
-		errorCode = "invalid_password"
-		message = "Password must be at least 8 characters"
-
-	case errors.Is(err, domain.ErrInvalidToken):
-		statusCode = http.StatusUnauthorized
-		errorCode = "invalid_token"
-		message = "Invalid or expired token"
-
-	case errors.Is(err, domain.ErrCompetencyNotFound):
-		statusCode = http.StatusNotFound
-		errorCode = "competency_not_found"
-		message = "Competency not found"
-
-	case errors.Is(err, domain.ErrCompetencyAlreadyExists):
-		statusCode = http.StatusConflict
-		errorCode = "competency_already_exists"
-		message = "A competency with this name already exists"
-
-	case errors.Is(err, domain.ErrInvalidCompetencyName):
-		statusCode = http.StatusBadRequest
-		errorCode = "invalid_competency_name"
-		message = "Invalid competency name (must be 2-100 characters)"
-
-	default:
-		// Check if it's a validation error
-		var validationErr dto.ValidationError
-		if errors.As(err, &validationErr) {
-			statusCode = http.StatusBadRequest
-			errorCode = "validation_error"
-			message = validationErr.Error()
-		} else {
-			// Internal server error for unknown errors
-			rw.logger.Error("Unhandled error in response", "error", err)
-			statusCode = http.StatusInternalServerError
-			errorCode = "internal_server_error"
-			message = "An unexpected error occurred"
+	case errors.As(err, &validationErrs):
+		fields = toFieldProblems(validationErrs...)
+		if problem.Code == validationProblem.Code {
+			problem.Detail = validationErrs.Error()
+		}
+	case errors.As(err, &validationErr):
+		fields = toFieldProblems(validationErr)
+		if problem.Code == validationProblem.Code {
+			problem.Detail = validationErr.Error()
 		}
 	}
 
-	resp := dto.ErrorResponse{
-		Error:   errorCode,
-		Message: message,
+	resp := dto.ProblemDetails{
+		Type:      problem.Type(),
+		Title:     problem.Title,
+		Status:    problem.Status,
+		Detail:    problem.Detail,
+		Instance:  r.URL.Path,
+		Errors:    fields,
+		Error:     problem.Code,
+		Message:   problem.Detail,
+		RequestID: logging.RequestIDFromContext(r.Context()),
 	}
 
-	rw.JSON(w, statusCode, resp)
+	rw.write(w, problem.Status, "application/problem+json", resp)
+}
+
+// toFieldProblems converts validation errors to the problem details field list
+func toFieldProblems(errs ...dto.ValidationError) []dto.FieldProblem {
+	fields := make([]dto.FieldProblem, len(errs))
+	for i, err := range errs {
+		fields[i] = dto.FieldProblem{Field: err.Field, Message: err.Message}
+	}
+	return fields
 }
 
 // Success sends a successful response
@@ -128,3 +118,8 @@ func (rw *Writer) Success(w http.ResponseWriter, payload dto.JSONSerializable) {
 func (rw *Writer) Created(w http.ResponseWriter, payload dto.JSONSerializable) {
 	rw.JSON(w, http.StatusCreated, payload)
 }
+
+// NoContent sends an empty response (204)
+func (rw *Writer) NoContent(w http.ResponseWriter) {
+	w.WriteHeader(http.StatusNoContent)
+}
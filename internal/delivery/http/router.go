@@ -1,37 +1,185 @@
 package http
 
 import (
+	"expvar"
+	"fmt"
 	"log/slog"
+	"net/http"
 	"time"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/mehrnoosh-hk/devnorth-back/config"
 	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/handler"
 	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/middleware"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/request"
 	"github.com/mehrnoosh-hk/devnorth-back/internal/delivery/http/response"
 	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+	"github.com/mehrnoosh-hk/devnorth-back/pkg/ratelimit"
 )
 
+// Named rate limits configured on the limiter by NewRouter
+const (
+	rateLimitAuthIP     = "auth:ip"
+	rateLimitLoginEmail = "login:email"
+	rateLimitAPIIP      = "api:ip"
+	rateLimitAPI        = "api"
+)
+
+// RouteTimeouts holds the processing timeout of each route group
+type RouteTimeouts struct {
+	Health      time.Duration // Health checks
+	Auth        time.Duration // Authentication endpoints
+	Standard    time.Duration // Regular API endpoints
+	LongRunning time.Duration // Bulk operations
+	Streaming   time.Duration // Streaming responses; 0 disables the deadline
+}
+
+// RouterConfig holds route-level settings applied by NewRouter
+type RouterConfig struct {
+	Timeouts            RouteTimeouts       // Timeouts per route group
+	MaxBodyBytes        int64               // Maximum size of JSON request bodies
+	MaxImportBytes      int64               // Maximum size of imported catalogue files
+	TrustedProxies      []string            // CIDRs of proxies whose forwarding headers are trusted
+	AuthRateLimit       ratelimit.RateLimit // Per client IP on authentication endpoints
+	LoginEmailRateLimit ratelimit.RateLimit // Per email on login
+	APIIPRateLimit      ratelimit.RateLimit // Per client IP on the rest of the API, checked before authentication
+	APIRateLimit        ratelimit.RateLimit // Per user ID or client IP on the rest of the API
+	CORS                config.CORSConfig   // Cross-origin policy
+}
+
+// UseCases holds the use cases the handlers of NewRouter call
+type UseCases struct {
+	User         domain.UserUseCase
+	Competency   domain.CompetencyUseCase
+	Category     domain.CategoryUseCase
+	Rubric       domain.RubricUseCase
+	Tag          domain.TagUseCase
+	Relation     domain.RelationUseCase
+	Translation  domain.TranslationUseCase
+	Merge        domain.MergeUseCase
+	Proposal     domain.ProposalUseCase
+	Steward      domain.StewardUseCase
+	Notification domain.NotificationUseCase
+	Framework    domain.FrameworkUseCase
+}
+
 // NewRouter creates and configures the HTTP router
-func NewRouter(userUseCase domain.UserUseCase, competencyUseCase domain.CompetencyUseCase, logger *slog.Logger, handlerTimeout time.Duration) (*chi.Mux, error) {
+func NewRouter(useCases UseCases, tokenGenerator domain.TokenGenerator, limiter *ratelimit.Limiter, logger *slog.Logger, cfg RouterConfig) (*chi.Mux, error) {
+	if tokenGenerator == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "tokenGenerator can not be nil")
+	}
+	if limiter == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDependencies, "limiter can not be nil")
+	}
+
 	r := chi.NewRouter()
 
-	// Global middleware
-	r.Use(middleware.Timeout(handlerTimeout)) // Apply timeout first
-	r.Use(middleware.Logger(logger))
-	r.Use(middleware.CORS())
+	// Initialize response writer with the problem details of every error package
+	problems := response.NewRegistry()
+	handler.RegisterProblems(problems)
+	middleware.RegisterProblems(problems)
+	request.RegisterProblems(problems)
+	responseWriter, err := response.NewWriter(logger, problems)
+	if err != nil {
+		return nil, err
+	}
 
-	// Initialize response writer
-	responseWriter, err := response.NewWriter(logger)
+	// Rate limits and the identities they are counted against
+	ipResolver, err := middleware.NewClientIPResolver(cfg.TrustedProxies)
 	if err != nil {
 		return nil, err
 	}
+	limiter.AddLimit(map[string]ratelimit.RateLimit{
+		rateLimitAuthIP:     cfg.AuthRateLimit,
+		rateLimitLoginEmail: cfg.LoginEmailRateLimit,
+		rateLimitAPIIP:      cfg.APIIPRateLimit,
+		rateLimitAPI:        cfg.APIRateLimit,
+	})
+	authIPRule := middleware.RateLimitRule{Limit: rateLimitAuthIP, Key: middleware.ClientIPKey(ipResolver)}
+	loginEmailRule := middleware.RateLimitRule{Limit: rateLimitLoginEmail, Key: middleware.BodyFieldKey("email")}
+	// Every request is counted per client IP before its token is checked, so floods of invalid tokens are limited too
+	apiIPRule := middleware.RateLimitRule{Limit: rateLimitAPIIP, Key: middleware.ClientIPKey(ipResolver)}
+	// Authenticated requests are counted per user ID, anonymous ones per client IP
+	apiRule := middleware.RateLimitRule{
+		Limit: rateLimitAPI,
+		Key: middleware.FirstKey(
+			middleware.UserIDKey(),
+			middleware.ClientIPKey(ipResolver),
+		),
+	}
+
+	// timeout builds the timeout middleware of a route group
+	timeout := func(group string, d time.Duration) func(http.Handler) http.Handler {
+		return middleware.Timeout(group, d, responseWriter, logger)
+	}
+
+	// Global middleware
+	r.Use(middleware.RequestID()) // Assign the request ID before anything logs
+	r.Use(middleware.Logger(logger))
+	r.Use(middleware.Recoverer(responseWriter, logger)) // After Logger, so recovered panics are logged with their 500
+	r.Use(middleware.CORS(cfg.CORS))
+	// Default deadline, so authentication, rate limiting and the routes outside the groups below are bounded too;
+	// route groups replace it with their own
+	r.Use(timeout("default", cfg.Timeouts.Standard))
 
 	// Initialize handlers
-	authHandler, err := handler.NewAuthHandler(userUseCase, logger, responseWriter)
+	binder, err := request.NewBinder(cfg.MaxBodyBytes)
+	if err != nil {
+		return nil, err
+	}
+	authHandler, err := handler.NewAuthHandler(useCases.User, binder, logger, responseWriter)
+	if err != nil {
+		return nil, err
+	}
+	competencyHandler, err := handler.NewCompetencyHandler(useCases.Competency, binder, logger, responseWriter)
+	if err != nil {
+		return nil, err
+	}
+	categoryHandler, err := handler.NewCategoryHandler(useCases.Category, binder, logger, responseWriter)
+	if err != nil {
+		return nil, err
+	}
+	rubricHandler, err := handler.NewRubricHandler(useCases.Rubric, binder, logger, responseWriter)
 	if err != nil {
 		return nil, err
 	}
-	competencyHandler, err := handler.NewCompetencyHandler(competencyUseCase, logger, responseWriter)
+	tagHandler, err := handler.NewTagHandler(useCases.Tag, binder, logger, responseWriter)
+	if err != nil {
+		return nil, err
+	}
+	relationHandler, err := handler.NewRelationHandler(useCases.Relation, logger, responseWriter)
+	if err != nil {
+		return nil, err
+	}
+	translationHandler, err := handler.NewTranslationHandler(useCases.Translation, binder, logger, responseWriter)
+	if err != nil {
+		return nil, err
+	}
+	mergeHandler, err := handler.NewMergeHandler(useCases.Merge, binder, logger, responseWriter)
+	if err != nil {
+		return nil, err
+	}
+	proposalHandler, err := handler.NewProposalHandler(useCases.Proposal, binder, logger, responseWriter)
+	if err != nil {
+		return nil, err
+	}
+	stewardHandler, err := handler.NewStewardHandler(useCases.Steward, binder, logger, responseWriter)
+	if err != nil {
+		return nil, err
+	}
+	notificationHandler, err := handler.NewNotificationHandler(useCases.Notification, logger, responseWriter)
+	if err != nil {
+		return nil, err
+	}
+	frameworkHandler, err := handler.NewFrameworkHandler(useCases.Framework, binder, logger, responseWriter)
+	if err != nil {
+		return nil, err
+	}
+	importBinder, err := request.NewBinder(cfg.MaxImportBytes)
+	if err != nil {
+		return nil, err
+	}
+	catalogueHandler, err := handler.NewCatalogueHandler(useCases.Competency, importBinder, logger, responseWriter)
 	if err != nil {
 		return nil, err
 	}
@@ -39,24 +187,171 @@ func NewRouter(userUseCase domain.UserUseCase, competencyUseCase domain.Competen
 	if err != nil {
 		return nil, err
 	}
+	spec, err := buildAPISpec(problems)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrBuildAPISpec, err)
+	}
+	docsHandler, err := handler.NewDocsHandler(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	// Process metrics (expvar), admins only: they expose memory statistics and the command line
+	r.With(
+		middleware.Authenticate(tokenGenerator, responseWriter, logger),
+		middleware.RequireAdmin(responseWriter, logger),
+	).Method(http.MethodGet, "/debug/vars", expvar.Handler())
+
+	// API documentation (see buildAPISpec)
+	r.Get("/api/openapi.json", docsHandler.Spec)
+	r.Get("/api/docs", docsHandler.UI)
 
 	// API routes
 	r.Route("/api/v1", func(r chi.Router) {
 		// Health check
-		r.Get("/health", healthHandler.Check)
+		r.With(timeout("health", cfg.Timeouts.Health)).Get("/health", healthHandler.Check)
 
 		// Authentication routes
 		r.Route("/auth", func(r chi.Router) {
-			r.Post("/register", authHandler.Register)
-			r.Post("/login", authHandler.Login)
+			r.Use(timeout("auth", cfg.Timeouts.Auth))
+			r.With(middleware.RateLimit(limiter, responseWriter, logger, authIPRule)).
+				Post("/register", authHandler.Register)
+			r.With(middleware.RateLimit(limiter, responseWriter, logger, authIPRule, loginEmailRule)).
+				Post("/login", authHandler.Login)
 		})
 
-		// Competency routes
-		r.Route("/competencies", func(r chi.Router) {
-			r.Post("/", competencyHandler.Create)
-			r.Get("/", competencyHandler.GetAll)
-			r.Get("/{id}", competencyHandler.GetByID)
-			r.Patch("/{id}/description", competencyHandler.UpdateDescription)
+		// Token-aware API routes (anonymous requests are allowed, invalid tokens are rejected)
+		// Competencies are read in the locale of the request (?lang= or Accept-Language)
+		r.Group(func(r chi.Router) {
+			r.Use(middleware.RateLimit(limiter, responseWriter, logger, apiIPRule))
+			r.Use(middleware.Authenticate(tokenGenerator, responseWriter, logger))
+			r.Use(middleware.RateLimit(limiter, responseWriter, logger, apiRule))
+			r.Use(middleware.Locale(responseWriter, logger))
+
+			// Bulk competency routes (registered here: "/competencies" is mounted below)
+			r.With(timeout("long_running", cfg.Timeouts.LongRunning)).
+				Post("/competencies:batch", competencyHandler.CreateBatch)
+
+			// Competency routes
+			r.Route("/competencies", func(r chi.Router) {
+				r.Group(func(r chi.Router) {
+					r.Use(timeout("standard", cfg.Timeouts.Standard))
+					r.Post("/", competencyHandler.Create)
+					r.Get("/", competencyHandler.GetAll)
+					r.Get("/search", competencyHandler.Search)
+					r.Get("/suggest", competencyHandler.Suggest)
+					r.Get("/learning-order", relationHandler.GetLearningOrder)
+					r.Get("/graph", relationHandler.ExportGraph)
+					r.Get("/duplicates", mergeHandler.GetDuplicates)
+					r.Get("/stewarded", stewardHandler.GetStewarded)
+					r.Get("/{id}", competencyHandler.GetByID)
+					r.Delete("/{id}", competencyHandler.Delete)
+					r.Patch("/{id}/description", competencyHandler.UpdateDescription)
+					r.Patch("/{id}/name", competencyHandler.Rename)
+					r.Post("/{id}/archive", competencyHandler.Archive)
+					r.Post("/{id}/restore", competencyHandler.Restore)
+					r.Put("/{id}/category", competencyHandler.SetCategory)
+					r.Get("/{id}/history", competencyHandler.History)
+					r.Get("/{id}/history/diff", competencyHandler.Diff)
+					r.Post("/{id}/revert/{rev}", competencyHandler.Revert)
+					r.Post("/{id}/merge", mergeHandler.Merge)
+					r.Get("/{id}/levels", rubricHandler.GetRubric)
+					r.Put("/{id}/levels", rubricHandler.SetRubric)
+					r.Delete("/{id}/levels", rubricHandler.DeleteRubric)
+					r.Put("/{id}/levels/{level}", rubricHandler.SetLevel)
+					r.Delete("/{id}/levels/{level}", rubricHandler.DeleteLevel)
+					r.Get("/{id}/tags", tagHandler.GetCompetencyTags)
+					r.Post("/{id}/tags", tagHandler.TagCompetency)
+					r.Delete("/{id}/tags/{tag_id}", tagHandler.UntagCompetency)
+					r.Get("/{id}/relations", relationHandler.GetRelations)
+					r.Get("/{id}/prerequisites", relationHandler.GetPrerequisites)
+					r.Put("/{id}/prerequisites/{other_id}", relationHandler.AddPrerequisite)
+					r.Delete("/{id}/prerequisites/{other_id}", relationHandler.RemovePrerequisite)
+					r.Put("/{id}/related/{other_id}", relationHandler.AddRelated)
+					r.Delete("/{id}/related/{other_id}", relationHandler.RemoveRelated)
+					r.Get("/{id}/translations", translationHandler.GetTranslations)
+					r.Put("/{id}/translations/{locale}", translationHandler.SetTranslation)
+					r.Delete("/{id}/translations/{locale}", translationHandler.DeleteTranslation)
+					r.Get("/{id}/stewards", stewardHandler.GetStewards)
+					r.Put("/{id}/stewards/{user_id}", stewardHandler.Assign)
+					r.Delete("/{id}/stewards/{user_id}", stewardHandler.Unassign)
+					r.Get("/{id}/reports", stewardHandler.GetReports)
+					r.Post("/{id}/reports", stewardHandler.Report)
+				})
+
+				// Catalogue export and import
+				r.With(timeout("streaming", cfg.Timeouts.Streaming)).Get("/export", catalogueHandler.Export)
+				r.With(timeout("long_running", cfg.Timeouts.LongRunning)).Post("/import", catalogueHandler.Import)
+			})
+
+			// Category routes
+			r.Route("/categories", func(r chi.Router) {
+				r.Use(timeout("standard", cfg.Timeouts.Standard))
+				r.Get("/", categoryHandler.GetTree)
+				r.Post("/", categoryHandler.Create)
+				r.Post("/reorder", categoryHandler.Reorder)
+				r.Get("/{id}", categoryHandler.GetSubtree)
+				r.Patch("/{id}", categoryHandler.Rename)
+				r.Delete("/{id}", categoryHandler.Delete)
+				r.Post("/{id}/move", categoryHandler.Move)
+			})
+
+			// Rating scale routes
+			r.Route("/rating-scales", func(r chi.Router) {
+				r.Use(timeout("standard", cfg.Timeouts.Standard))
+				r.Get("/", rubricHandler.GetScales)
+				r.Post("/", rubricHandler.CreateScale)
+				r.Get("/{id}", rubricHandler.GetScale)
+				r.Put("/{id}", rubricHandler.UpdateScale)
+				r.Delete("/{id}", rubricHandler.DeleteScale)
+			})
+
+			// Tag routes
+			r.Route("/tags", func(r chi.Router) {
+				r.Use(timeout("standard", cfg.Timeouts.Standard))
+				r.Get("/", tagHandler.GetAll)
+				r.Post("/", tagHandler.Create)
+				r.Get("/{id}", tagHandler.GetByID)
+				r.Patch("/{id}", tagHandler.Update)
+				r.Delete("/{id}", tagHandler.Delete)
+			})
+
+			// Proposal routes
+			r.Route("/proposals", func(r chi.Router) {
+				r.Use(timeout("standard", cfg.Timeouts.Standard))
+				r.Get("/", proposalHandler.GetMine)
+				r.Post("/", proposalHandler.Create)
+				r.Get("/queue", proposalHandler.GetQueue)
+				r.Get("/{id}", proposalHandler.GetByID)
+				r.Put("/{id}", proposalHandler.Update)
+				r.Delete("/{id}", proposalHandler.Withdraw)
+				r.Post("/{id}/submit", proposalHandler.Submit)
+				r.Post("/{id}/comments", proposalHandler.Comment)
+				r.Post("/{id}/approve", proposalHandler.Approve)
+				r.Post("/{id}/reject", proposalHandler.Reject)
+			})
+
+			// Notification routes
+			r.Route("/notifications", func(r chi.Router) {
+				r.Use(timeout("standard", cfg.Timeouts.Standard))
+				r.Get("/", notificationHandler.GetMine)
+				r.Post("/{id}/read", notificationHandler.MarkRead)
+			})
+
+			// Framework version routes
+			r.Route("/framework", func(r chi.Router) {
+				r.Use(timeout("standard", cfg.Timeouts.Standard))
+				r.Get("/versions", frameworkHandler.GetVersions)
+				r.Post("/versions", frameworkHandler.Publish)
+				r.Get("/versions/{name}", frameworkHandler.GetVersion)
+				r.Get("/diff", frameworkHandler.Diff)
+			})
+
+			// Translation routes
+			r.Route("/translations", func(r chi.Router) {
+				r.Use(timeout("standard", cfg.Timeouts.Standard))
+				r.Get("/missing", translationHandler.GetMissing)
+			})
 		})
 	})
 
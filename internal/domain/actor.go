@@ -0,0 +1,53 @@
+package domain
+
+import "context"
+
+// actorContextKey is the context key for the authenticated user performing an operation
+type actorContextKey struct{}
+
+// ContextWithActor returns a copy of ctx carrying the authenticated user performing the operation
+func ContextWithActor(ctx context.Context, user *User) context.Context {
+	return context.WithValue(ctx, actorContextKey{}, user)
+}
+
+// ActorFromContext returns the authenticated user stored in ctx
+// Returns false for anonymous requests
+func ActorFromContext(ctx context.Context) (*User, bool) {
+	user, ok := ctx.Value(actorContextKey{}).(*User)
+	return user, ok && user != nil
+}
+
+// authorContextKey is the context key for the user the changes of an operation are credited to
+type authorContextKey struct{}
+
+// ContextWithAuthor returns a copy of ctx crediting the changes made with it to the user authorID rather than
+// to the actor, e.g. to the proposer of an approved proposal; permissions are still those of the actor
+func ContextWithAuthor(ctx context.Context, authorID int32) context.Context {
+	return context.WithValue(ctx, authorContextKey{}, authorID)
+}
+
+// AuthorFromContext returns the ID of the user the changes made with ctx are credited to:
+// the one set by ContextWithAuthor, or else the actor
+// Returns false for anonymous changes
+func AuthorFromContext(ctx context.Context) (int32, bool) {
+	if authorID, ok := ctx.Value(authorContextKey{}).(int32); ok {
+		return authorID, true
+	}
+	if user, ok := ActorFromContext(ctx); ok {
+		return user.ID, true
+	}
+	return 0, false
+}
+
+// RequireAdmin checks that the user performing the operation of ctx is an admin
+// Returns ErrAuthenticationRequired for anonymous requests and ErrForbidden for other users
+func RequireAdmin(ctx context.Context) error {
+	user, ok := ActorFromContext(ctx)
+	if !ok {
+		return ErrAuthenticationRequired
+	}
+	if !user.IsAdmin() {
+		return ErrForbidden
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package domain
+
+import "time"
+
+// Category is a node of the competency taxonomy, e.g. Engineering -> Backend
+// Categories form a tree: root categories have no parent, and siblings are ordered by Position
+type Category struct {
+	ID        int32
+	ParentID  *int32 // Nil for root categories
+	Name      string // Unique among siblings (case-insensitive)
+	Position  int32  // Zero-based order among siblings
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// IsRoot checks if the category has no parent
+func (c *Category) IsRoot() bool {
+	return c.ParentID == nil
+}
+
+// CategoryNode is a category with its children, as returned by tree reads
+type CategoryNode struct {
+	Category        *Category
+	CompetencyCount int64 // Competencies placed directly under the category (archived ones excluded)
+	Children        []*CategoryNode
+}
+
+// BuildCategoryTree links categories into trees, returning the nodes whose parent isn't in categories
+// Children keep the order they have in categories, so it should be sorted by position
+// counts gives the number of competencies of each category; missing entries count zero
+func BuildCategoryTree(categories []*Category, counts map[int32]int64) []*CategoryNode {
+	nodes := make(map[int32]*CategoryNode, len(categories))
+	for _, category := range categories {
+		nodes[category.ID] = &CategoryNode{Category: category, CompetencyCount: counts[category.ID]}
+	}
+
+	roots := make([]*CategoryNode, 0)
+	for _, category := range categories {
+		node := nodes[category.ID]
+		if category.ParentID != nil {
+			if parent, ok := nodes[*category.ParentID]; ok {
+				parent.Children = append(parent.Children, node)
+				continue
+			}
+		}
+		roots = append(roots, node)
+	}
+	return roots
+}
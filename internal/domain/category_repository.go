@@ -0,0 +1,54 @@
+package domain
+
+import "context"
+
+// CategoryRepository defines the contract for competency category data access
+type CategoryRepository interface {
+	// Create creates a category under parentID (a root category when nil), after its siblings
+	// Returns domain.ErrCategoryParentNotFound if the parent doesn't exist
+	// Returns domain.ErrCategoryAlreadyExists if a sibling has the name
+	Create(ctx context.Context, parentID *int32, name string) (*Category, error)
+
+	// GetByID retrieves a category by its ID
+	// Returns domain.ErrCategoryNotFound if the category doesn't exist
+	GetByID(ctx context.Context, id int32) (*Category, error)
+
+	// GetAll retrieves every category, parents before children and siblings in order
+	GetAll(ctx context.Context) ([]*Category, error)
+
+	// GetSubtree retrieves a category and all its descendants, parents before children and siblings in order
+	// Returns an empty slice if the category doesn't exist
+	GetSubtree(ctx context.Context, id int32) ([]*Category, error)
+
+	// GetChildren retrieves the children of parentID (the root categories when nil) in order
+	GetChildren(ctx context.Context, parentID *int32) ([]*Category, error)
+
+	// IsInSubtree reports whether candidateID is rootID or one of its descendants
+	IsInSubtree(ctx context.Context, rootID, candidateID int32) (bool, error)
+
+	// CountCompetencies returns the number of competencies (archived ones excluded) directly under each category
+	// Categories without competencies are left out
+	CountCompetencies(ctx context.Context) (map[int32]int64, error)
+
+	// LockTree blocks changes to categories by other transactions until the transaction of ctx ends
+	// It must be called within a transaction
+	LockTree(ctx context.Context) error
+
+	// Rename renames a category
+	// Returns domain.ErrCategoryNotFound if the category doesn't exist
+	// Returns domain.ErrCategoryAlreadyExists if a sibling has the name
+	Rename(ctx context.Context, id int32, name string) (*Category, error)
+
+	// SetParent moves a category under parentID (the root when nil) without changing positions
+	// Returns domain.ErrCategoryNotFound if the category doesn't exist
+	// Returns domain.ErrCategoryAlreadyExists if a new sibling has the name
+	SetParent(ctx context.Context, id int32, parentID *int32) (*Category, error)
+
+	// SetPositions sets the position of each category to its index in ids
+	SetPositions(ctx context.Context, ids []int32) error
+
+	// Delete deletes a category
+	// Returns domain.ErrCategoryNotFound if the category doesn't exist
+	// Returns domain.ErrCategoryInUse if it has children or competencies
+	Delete(ctx context.Context, id int32) error
+}
@@ -0,0 +1,40 @@
+package domain
+
+import "context"
+
+// CategoryUseCase defines the contract for operations on the competency taxonomy
+type CategoryUseCase interface {
+	// Create creates a category under parentID (a root category when nil), after its siblings; admins only
+	// Possible errors: ErrAuthenticationRequired, ErrForbidden, ErrInvalidCategoryName, ErrCategoryParentNotFound, ErrCategoryAlreadyExists
+	Create(ctx context.Context, parentID *int32, name string) (*Category, error)
+
+	// GetByID retrieves a category by its ID
+	// Possible errors: ErrCategoryNotFound
+	GetByID(ctx context.Context, id int32) (*Category, error)
+
+	// GetTree retrieves the whole taxonomy: the root categories with their descendants and competency counts
+	GetTree(ctx context.Context) ([]*CategoryNode, error)
+
+	// GetSubtree retrieves a category with its descendants and competency counts
+	// Possible errors: ErrCategoryNotFound
+	GetSubtree(ctx context.Context, id int32) (*CategoryNode, error)
+
+	// Rename renames a category; the name is validated like on creation; admins only
+	// Possible errors: ErrAuthenticationRequired, ErrForbidden, ErrInvalidCategoryName, ErrCategoryNotFound, ErrCategoryAlreadyExists
+	Rename(ctx context.Context, id int32, name string) (*Category, error)
+
+	// Move places a category under parentID (the root when nil) at position among its new siblings
+	// A position past the last sibling, or negative, appends the category; its descendants move with it
+	// Moving a category under itself or one of its descendants is refused with ErrCategoryCycle
+	// Possible errors: ErrAuthenticationRequired, ErrForbidden, ErrCategoryNotFound, ErrCategoryParentNotFound, ErrCategoryCycle, ErrCategoryAlreadyExists
+	Move(ctx context.Context, id int32, parentID *int32, position int32) (*Category, error)
+
+	// Reorder sets the order of the children of parentID (the root categories when nil)
+	// ids must list every child exactly once
+	// Possible errors: ErrAuthenticationRequired, ErrForbidden, ErrCategoryParentNotFound, ErrInvalidCategoryOrder
+	Reorder(ctx context.Context, parentID *int32, ids []int32) ([]*Category, error)
+
+	// Delete deletes a category; it is refused while the category has children or competencies; admins only
+	// Possible errors: ErrAuthenticationRequired, ErrForbidden, ErrCategoryNotFound, ErrCategoryInUse
+	Delete(ctx context.Context, id int32) error
+}
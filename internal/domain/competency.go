@@ -2,6 +2,9 @@ package domain
 
 import "time"
 
+// AnyVersion disables the version check of conditional updates
+const AnyVersion int32 = 0
+
 // Competency represents a competency in the domain layer
 // This is the core business entity, independent of database implementation
 type Competency struct {
@@ -10,6 +13,18 @@ type Competency struct {
 	Description string
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	Version     int32      // Incremented on every change, used for optimistic concurrency control
+	ArchivedAt  *time.Time // Set while the competency is archived (hidden from listings and search)
+	CategoryID  *int32     // Category the competency is placed under, nil when uncategorized
+	Locale      Locale     // Locale of Name when read localized (see Localize), empty otherwise
+
+	// Related data, only loaded when requested (see CompetencyInclude)
+	Rubric *CompetencyRubric // Nil when not loaded or when the competency has no rubric
+}
+
+// IsArchived checks if the competency is archived
+func (c *Competency) IsArchived() bool {
+	return c.ArchivedAt != nil
 }
 
 // HasDescription checks if the competency has a description
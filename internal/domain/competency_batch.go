@@ -0,0 +1,42 @@
+package domain
+
+// MaxCompetencyBatchSize is the maximum number of competencies created by one batch
+const MaxCompetencyBatchSize = 500
+
+// CompetencyBatchMode tells how a batch handles items that can't be created
+type CompetencyBatchMode string
+
+const (
+	// CompetencyBatchAllOrNothing creates nothing unless every item can be created
+	CompetencyBatchAllOrNothing CompetencyBatchMode = "all_or_nothing"
+	// CompetencyBatchBestEffort creates the items that can be created and reports the others
+	CompetencyBatchBestEffort CompetencyBatchMode = "best_effort"
+)
+
+// Valid reports whether m is a known batch mode
+func (m CompetencyBatchMode) Valid() bool {
+	return m == CompetencyBatchAllOrNothing || m == CompetencyBatchBestEffort
+}
+
+// CompetencyBatchStatus is the outcome of one item of a batch
+type CompetencyBatchStatus string
+
+const (
+	CompetencyBatchCreated   CompetencyBatchStatus = "created"
+	CompetencyBatchDuplicate CompetencyBatchStatus = "duplicate" // The name exists, or appears earlier in the batch
+	CompetencyBatchInvalid   CompetencyBatchStatus = "invalid"
+	CompetencyBatchSkipped   CompetencyBatchStatus = "skipped" // Valid, but not created because the all-or-nothing batch was rejected
+)
+
+// NewCompetency holds the fields of a competency to create
+type NewCompetency struct {
+	Name        string
+	Description string
+}
+
+// CompetencyBatchResult is the outcome of one item of a batch, in the order of the items
+type CompetencyBatchResult struct {
+	Status     CompetencyBatchStatus
+	Competency *Competency // The created competency
+	Err        error       // Why a duplicate or invalid item wasn't created
+}
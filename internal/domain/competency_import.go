@@ -0,0 +1,53 @@
+package domain
+
+// MaxCompetencyImportSize is the maximum number of records of one import
+const MaxCompetencyImportSize = 10000
+
+// CompetencyImportPolicy tells what an import does with records whose name already exists
+type CompetencyImportPolicy string
+
+const (
+	CompetencyImportSkip   CompetencyImportPolicy = "skip"   // Keep the existing competency
+	CompetencyImportUpdate CompetencyImportPolicy = "update" // Replace the description of the existing competency
+	CompetencyImportFail   CompetencyImportPolicy = "fail"   // Reject the import
+)
+
+// Valid reports whether p is a known import policy
+func (p CompetencyImportPolicy) Valid() bool {
+	return p == CompetencyImportSkip || p == CompetencyImportUpdate || p == CompetencyImportFail
+}
+
+// CompetencyImportAction is what an import does, or would do in a dry run, with one record
+type CompetencyImportAction string
+
+const (
+	CompetencyImportCreated   CompetencyImportAction = "create"
+	CompetencyImportUpdated   CompetencyImportAction = "update"
+	CompetencyImportUnchanged CompetencyImportAction = "unchanged" // Exists with the same description
+	CompetencyImportSkipped   CompetencyImportAction = "skip"
+	CompetencyImportConflict  CompetencyImportAction = "conflict" // Exists and the policy is fail
+	CompetencyImportInvalid   CompetencyImportAction = "invalid"
+)
+
+// CompetencyImportOptions controls an import
+type CompetencyImportOptions struct {
+	OnExisting CompetencyImportPolicy
+	DryRun     bool // Report what the import would do without changing anything
+}
+
+// CompetencyImportResult is the outcome of one record, in the order of the records
+type CompetencyImportResult struct {
+	Name       string
+	Action     CompetencyImportAction
+	Competency *Competency // The created or existing competency
+	Err        error       // Why a record is invalid or conflicts
+}
+
+// CompetencyImportReport is the outcome of an import
+// An import is all-or-nothing: it is rejected, and nothing changes, when any record is invalid or conflicts
+type CompetencyImportReport struct {
+	DryRun   bool
+	Applied  bool // The changes were committed
+	Rejected bool // Some record is invalid or conflicts
+	Results  []*CompetencyImportResult
+}
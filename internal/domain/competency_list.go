@@ -0,0 +1,91 @@
+package domain
+
+import "time"
+
+// Page size limits of competency listings
+const (
+	DefaultCompetencyPageSize int32 = 20
+	MaxCompetencyPageSize     int32 = 100
+)
+
+// CompetencySort is the field competency listings are ordered by (ties are broken by ID)
+type CompetencySort string
+
+const (
+	CompetencySortName      CompetencySort = "name"
+	CompetencySortCreatedAt CompetencySort = "created_at"
+	CompetencySortUpdatedAt CompetencySort = "updated_at"
+)
+
+// Valid reports whether s is a known sort field
+func (s CompetencySort) Valid() bool {
+	switch s {
+	case CompetencySortName, CompetencySortCreatedAt, CompetencySortUpdatedAt:
+		return true
+	}
+	return false
+}
+
+// SortDirection is the direction of an ordering
+type SortDirection string
+
+const (
+	SortAscending  SortDirection = "asc"
+	SortDescending SortDirection = "desc"
+)
+
+// Valid reports whether d is a known direction
+func (d SortDirection) Valid() bool {
+	return d == SortAscending || d == SortDescending
+}
+
+// CompetencyFilter narrows a competency listing; zero fields don't filter
+type CompetencyFilter struct {
+	NamePrefix      string     // Case-insensitive prefix of the name
+	HasDescription  *bool      // Only competencies with (true) or without (false) a description
+	CreatedAfter    *time.Time // Only competencies created strictly after this time
+	IncludeArchived bool       // Archived competencies are left out unless set
+	CategoryID      *int32     // Only competencies of this category or of its descendants
+	Tags            []string   // Only competencies carrying these tags (case-insensitive names)
+	TagMatch        TagMatch   // Whether competencies need any (default) or all of Tags
+}
+
+// CompetencyCursor is the position after which the next page of a listing starts
+// It carries the ordering it was issued for, since a position only makes sense within one ordering
+type CompetencyCursor struct {
+	Sort      CompetencySort
+	Direction SortDirection
+	ID        int32
+	Name      string    // Set when sorted by name
+	Time      time.Time // Set when sorted by created_at or updated_at
+}
+
+// CompetencyListParams selects a page of competencies
+type CompetencyListParams struct {
+	Filter    CompetencyFilter
+	Sort      CompetencySort    // Defaults to created_at
+	Direction SortDirection     // Defaults to desc for time sorts and asc for name
+	Limit     int32             // Page size, defaults to DefaultCompetencyPageSize
+	After     *CompetencyCursor // Nil for the first page
+}
+
+// CompetencyPage is a page of a competency listing
+type CompetencyPage struct {
+	Competencies []*Competency
+	Total        int64             // Competencies matching the filter, across all pages
+	NextCursor   *CompetencyCursor // Nil on the last page
+}
+
+// CursorFor returns the cursor positioned on competency in the ordering of p
+func (p CompetencyListParams) CursorFor(competency *Competency) *CompetencyCursor {
+	cursor := &CompetencyCursor{Sort: p.Sort, Direction: p.Direction, ID: competency.ID}
+	switch p.Sort {
+	case CompetencySortName:
+		cursor.Name = competency.Name
+	case CompetencySortCreatedAt:
+		cursor.Time = competency.CreatedAt
+	case CompetencySortUpdatedAt:
+		cursor.Time = competency.UpdatedAt
+	}
+	return cursor
+}
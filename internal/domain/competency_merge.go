@@ -0,0 +1,28 @@
+package domain
+
+// Defaults and limits of the duplicate report
+// Candidates are found with pg_trgm's default similarity threshold, so lower thresholds wouldn't report more pairs
+const (
+	DefaultDuplicateThreshold float32 = 0.5
+	MinDuplicateThreshold     float32 = 0.3
+	DefaultDuplicateLimit     int32   = 50
+	MaxDuplicateLimit         int32   = 200
+)
+
+// DuplicateCandidate is a pair of competencies that aren't archived and may describe the same thing
+// Similarities are trigram similarities from 0 to 1; Score ranks the pairs (see MergeRepository.GetDuplicates)
+type DuplicateCandidate struct {
+	Competency            CompetencySuggestion
+	Other                 CompetencySuggestion // Always has the higher ID
+	NameSimilarity        float32
+	DescriptionSimilarity float32 // 0 when either competency has no description
+	Score                 float32
+}
+
+// CompetencyMerge describes the merge of a competency into another one
+// The merged competency is deleted after its references are moved to CompetencyID, and its ID redirects there
+type CompetencyMerge struct {
+	CompetencyID int32  // Surviving competency
+	MergedID     int32  // Competency merged into it
+	MergedBy     *int32 // User who merged them, nil for anonymous changes
+}
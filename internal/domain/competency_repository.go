@@ -9,6 +9,10 @@ type CompetencyRepository interface {
 	// Create creates a new competency in the system
 	Create(ctx context.Context, name, description string) (*Competency, error)
 
+	// CreateMany creates competencies in one round trip
+	// The result has one entry per item, nil when the name already exists or appears earlier in items
+	CreateMany(ctx context.Context, items []NewCompetency) ([]*Competency, error)
+
 	// GetByID retrieves a competency by its ID
 	// Returns domain.ErrCompetencyNotFound if the competency doesn't exist
 	GetByID(ctx context.Context, id int32) (*Competency, error)
@@ -17,10 +21,55 @@ type CompetencyRepository interface {
 	// Returns domain.ErrCompetencyNotFound if the competency doesn't exist
 	GetByName(ctx context.Context, name string) (*Competency, error)
 
-	// GetAll retrieves all competencies
-	GetAll(ctx context.Context) ([]*Competency, error)
+	// GetByNames retrieves the competencies with the given names (compared case-insensitively)
+	// Names without a competency are left out
+	GetByNames(ctx context.Context, names []string) ([]*Competency, error)
+
+	// GetAll retrieves a page of the competencies matching params.Filter, in the order of params
+	// params must be complete (sort, direction and limit set), see CompetencyUseCase.GetAll for the defaults
+	GetAll(ctx context.Context, params CompetencyListParams) (*CompetencyPage, error)
+
+	// Search retrieves at most limit competencies matching query, most relevant first
+	// query uses web search syntax ("quoted phrases", OR, -excluded); names also match with typos
+	Search(ctx context.Context, query string, limit int32) ([]*CompetencySearchResult, error)
+
+	// Suggest retrieves at most limit competency names starting with prefix, then names with a word similar to it
+	Suggest(ctx context.Context, prefix string, limit int32) ([]*CompetencySuggestion, error)
+
+	// UpdateDescription updates the description of a competency if it still has expectedVersion (AnyVersion skips the check)
+	// Returns domain.ErrCompetencyNotFound if the competency doesn't exist
+	// Returns domain.ErrCompetencyVersionConflict if the competency has another version
+	UpdateDescription(ctx context.Context, id int32, description string, expectedVersion int32) (*Competency, error)
+
+	// Rename renames a competency if it still has expectedVersion (AnyVersion skips the check)
+	// Returns domain.ErrCompetencyNotFound if the competency doesn't exist
+	// Returns domain.ErrCompetencyVersionConflict if the competency has another version
+	// Returns domain.ErrCompetencyAlreadyExists if another competency has the name
+	Rename(ctx context.Context, id int32, name string, expectedVersion int32) (*Competency, error)
+
+	// Archive archives a competency; an archived competency is returned unchanged
+	// Returns domain.ErrCompetencyNotFound if the competency doesn't exist
+	Archive(ctx context.Context, id int32) (*Competency, error)
+
+	// Restore restores an archived competency; a competency that isn't archived is returned unchanged
+	// Returns domain.ErrCompetencyNotFound if the competency doesn't exist
+	Restore(ctx context.Context, id int32) (*Competency, error)
+
+	// SetCategory places a competency under a category (uncategorized when categoryID is nil)
+	// Returns domain.ErrCompetencyNotFound if the competency doesn't exist
+	// Returns domain.ErrCategoryNotFound if the category doesn't exist
+	SetCategory(ctx context.Context, id int32, categoryID *int32) (*Competency, error)
+
+	// Revert sets the state recorded by a revision if the competency still has expectedVersion (AnyVersion skips the check)
+	// Returns domain.ErrCompetencyNotFound if the competency doesn't exist
+	// Returns domain.ErrCompetencyVersionConflict if the competency has another version
+	// Returns domain.ErrCompetencyAlreadyExists if another competency has the name
+	// Returns domain.ErrCategoryNotFound if the category no longer exists
+	Revert(ctx context.Context, id int32, state CompetencySnapshot, expectedVersion int32) (*Competency, error)
 
-	// UpdateDescription updates the description of a competency
+	// Delete deletes a competency permanently, with its tags, translations and history
+	// Must run in a transaction: the competency is locked while its references are checked
 	// Returns domain.ErrCompetencyNotFound if the competency doesn't exist
-	UpdateDescription(ctx context.Context, id int32, description string) (*Competency, error)
+	// Returns domain.ErrCompetencyInUse if prerequisite or related edges, merge redirects or a rubric reference it
+	Delete(ctx context.Context, id int32) error
 }
@@ -0,0 +1,95 @@
+package domain
+
+import "time"
+
+// Limits of competency history pages
+const (
+	DefaultRevisionPageSize int32 = 50
+	MaxRevisionPageSize     int32 = 200
+)
+
+// RevisionAction is the kind of change a competency revision records
+type RevisionAction string
+
+const (
+	RevisionCreate     RevisionAction = "create"
+	RevisionUpdate     RevisionAction = "update" // Description changed
+	RevisionRename     RevisionAction = "rename"
+	RevisionArchive    RevisionAction = "archive"
+	RevisionRestore    RevisionAction = "restore"
+	RevisionCategorize RevisionAction = "categorize"
+	RevisionRevert     RevisionAction = "revert"
+	RevisionMerge      RevisionAction = "merge" // Another competency was merged into this one
+)
+
+// CompetencySnapshot is the state of a competency recorded by a revision
+type CompetencySnapshot struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	CategoryID  *int32 `json:"category_id"`
+	Archived    bool   `json:"archived"`
+}
+
+// CompetencyRevision is one change in the history of a competency
+// Revisions are numbered per competency from 1; a change that leaves the competency as it was records none
+type CompetencyRevision struct {
+	CompetencyID int32
+	Revision     int32
+	Action       RevisionAction
+	EditorID     *int32              // User who made the change, nil for anonymous changes
+	RevertedFrom *int32              // Revision restored by a revert
+	MergedID     *int32              // Competency merged by a merge (it no longer exists)
+	Before       *CompetencySnapshot // State recorded by the previous revision, nil for the first one
+	After        CompetencySnapshot
+	CreatedAt    time.Time
+}
+
+// RevisionChange describes the change recorded as the next revision of competencies
+// A merge is recorded even when it leaves the state of the competency as it was
+type RevisionChange struct {
+	Action       RevisionAction
+	EditorID     *int32
+	RevertedFrom *int32
+	MergedID     *int32
+}
+
+// FieldChange is a field whose value differs between two competency states
+// Values are the JSON values of the field in CompetencySnapshot
+type FieldChange struct {
+	Field string
+	From  any
+	To    any
+}
+
+// RevisionDiff compares the states recorded by two revisions of a competency
+type RevisionDiff struct {
+	From    *CompetencyRevision
+	To      *CompetencyRevision
+	Changes []FieldChange // Empty when both revisions record the same state
+}
+
+// Diff lists the fields whose value differs from s to other
+func (s CompetencySnapshot) Diff(other CompetencySnapshot) []FieldChange {
+	changes := []FieldChange{}
+	if s.Name != other.Name {
+		changes = append(changes, FieldChange{Field: "name", From: s.Name, To: other.Name})
+	}
+	if s.Description != other.Description {
+		changes = append(changes, FieldChange{Field: "description", From: s.Description, To: other.Description})
+	}
+	if !equalIDs(s.CategoryID, other.CategoryID) {
+		changes = append(changes, FieldChange{Field: "category_id", From: s.CategoryID, To: other.CategoryID})
+	}
+	if s.Archived != other.Archived {
+		changes = append(changes, FieldChange{Field: "archived", From: s.Archived, To: other.Archived})
+	}
+	return changes
+}
+
+// equalIDs reports whether two optional IDs are both unset or equal
+func equalIDs(a, b *int32) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
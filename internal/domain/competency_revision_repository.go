@@ -0,0 +1,18 @@
+package domain
+
+import "context"
+
+// RevisionRepository defines the contract for competency history data access
+type RevisionRepository interface {
+	// Record records the current state of the competencies as their next revision
+	// Competencies whose state didn't change since their last revision get no revision, unless the change is a merge
+	Record(ctx context.Context, change RevisionChange, competencyIDs ...int32) error
+
+	// GetAll retrieves at most limit revisions of a competency, newest first, only those before the revision
+	// before when it is positive
+	GetAll(ctx context.Context, competencyID, before, limit int32) ([]*CompetencyRevision, error)
+
+	// GetByRevision retrieves a revision of a competency
+	// Returns domain.ErrRevisionNotFound if the competency has no such revision
+	GetByRevision(ctx context.Context, competencyID, revision int32) (*CompetencyRevision, error)
+}
@@ -0,0 +1,30 @@
+package domain
+
+// Result limits of competency search and typeahead
+const (
+	DefaultCompetencySearchLimit  int32 = 20
+	MaxCompetencySearchLimit      int32 = 100
+	DefaultCompetencySuggestLimit int32 = 10
+	MaxCompetencySuggestLimit     int32 = 20
+)
+
+// Markers around the matched terms of search highlights (chr(57344) and chr(57345) in the search query)
+// Private-use characters aren't expected in competency text, so it can be escaped before the markers become markup
+const (
+	HighlightStart = "\uE000"
+	HighlightEnd   = "\uE001"
+)
+
+// CompetencySearchResult is a competency matching a search query
+type CompetencySearchResult struct {
+	Competency *Competency
+	Rank       float32 // Relevance, higher is better; only comparable within one search
+	Name       string  // Name with the matched terms between HighlightStart and HighlightEnd
+	Snippet    string  // Fragments of the description around the matched terms, highlighted like Name
+}
+
+// CompetencySuggestion is a competency name proposed while typing
+type CompetencySuggestion struct {
+	ID   int32
+	Name string
+}
@@ -4,24 +4,99 @@ import "context"
 
 // CompetencyUseCase defines the contract for competency-related business operations
 // This interface belongs to the domain layer and will be implemented by the use case layer
+// Changes of an existing competency are allowed to admins and to its stewards (see StewardUseCase)
 type CompetencyUseCase interface {
-	// Create creates a new competency with the provided name and description
+	// Create creates a new competency with the provided name and description, in FallbackLocale
+	// Names are unique per locale: the name must not be shown by another competency in any locale
 	// Returns the created competency or an error if creation fails
-	// Possible errors: ErrCompetencyAlreadyExists, ErrInvalidCompetencyName
+	// Admins only
+	// Possible errors: ErrAuthenticationRequired, ErrForbidden, ErrCompetencyAlreadyExists, ErrInvalidCompetencyName
 	Create(ctx context.Context, name, description string) (*Competency, error)
 
-	// GetByID retrieves a competency by its ID
+	// CreateBatch creates up to MaxCompetencyBatchSize competencies in one transaction
+	// The results are in the order of items. In CompetencyBatchAllOrNothing mode, a batch with a duplicate
+	// or invalid item creates nothing and returns its results with ErrCompetencyBatchRejected
+	// Admins only
+	// Possible errors: ErrAuthenticationRequired, ErrForbidden, ErrInvalidCompetencyBatch, ErrCompetencyBatchRejected
+	CreateBatch(ctx context.Context, items []NewCompetency, mode CompetencyBatchMode) ([]*CompetencyBatchResult, error)
+
+	// GetByID retrieves a competency by its ID, with the related data selected by include, in the locale of ctx
+	// The ID of a competency merged into another one gets that competency, whose ID differs from id
 	// Returns domain.ErrCompetencyNotFound if the competency doesn't exist
-	GetByID(ctx context.Context, id int32) (*Competency, error)
+	GetByID(ctx context.Context, id int32, include CompetencyInclude) (*Competency, error)
 
 	// GetByName retrieves a competency by its name
 	// Returns domain.ErrCompetencyNotFound if the competency doesn't exist
 	GetByName(ctx context.Context, name string) (*Competency, error)
 
-	// GetAll retrieves all competencies
-	GetAll(ctx context.Context) ([]*Competency, error)
+	// GetAll retrieves a page of the competencies matching params.Filter, in the locale of ctx
+	// Unset sort, direction and limit get their defaults, and the limit is capped at MaxCompetencyPageSize
+	// Possible errors: ErrInvalidCompetencySort, ErrInvalidCompetencyCursor
+	GetAll(ctx context.Context, params CompetencyListParams) (*CompetencyPage, error)
+
+	// Search retrieves the competencies matching query, most relevant first, with highlighted names and snippets
+	// A non-positive limit means DefaultCompetencySearchLimit, and it is capped at MaxCompetencySearchLimit
+	// Possible errors: ErrInvalidSearchQuery
+	Search(ctx context.Context, query string, limit int32) ([]*CompetencySearchResult, error)
+
+	// Suggest retrieves competency names for typeahead, names starting with prefix first
+	// A non-positive limit means DefaultCompetencySuggestLimit, and it is capped at MaxCompetencySuggestLimit
+	// Possible errors: ErrInvalidSearchQuery
+	Suggest(ctx context.Context, prefix string, limit int32) ([]*CompetencySuggestion, error)
+
+	// Export calls yield with every competency that isn't archived, ordered by name, fetching them page by page
+	// It stops at the first error returned by yield and returns it
+	Export(ctx context.Context, yield func(*Competency) error) error
 
-	// UpdateDescription updates the description of a competency
+	// Import creates the competencies of records in one transaction, handling existing names per opts.OnExisting
+	// Records are validated like Create does. The import is rejected (ErrCompetencyImportRejected, nothing changes)
+	// when a record is invalid or conflicts; a dry run never changes anything and doesn't return that error
+	// Admins only
+	// Possible errors: ErrAuthenticationRequired, ErrForbidden, ErrInvalidCompetencyImport, ErrCompetencyImportRejected
+	Import(ctx context.Context, records []NewCompetency, opts CompetencyImportOptions) (*CompetencyImportReport, error)
+
+	// UpdateDescription updates the description of a competency if it still has expectedVersion (AnyVersion skips the check)
+	// Returns domain.ErrAuthenticationRequired or domain.ErrForbidden unless the user is an admin or a steward of it
 	// Returns domain.ErrCompetencyNotFound if the competency doesn't exist
-	UpdateDescription(ctx context.Context, id int32, description string) (*Competency, error)
+	// Returns domain.ErrCompetencyVersionConflict if the competency has another version
+	UpdateDescription(ctx context.Context, id int32, description string, expectedVersion int32) (*Competency, error)
+
+	// Rename renames a competency if it still has expectedVersion (AnyVersion skips the check)
+	// The name is validated like on creation
+	// Possible errors: ErrAuthenticationRequired, ErrForbidden, ErrInvalidCompetencyName, ErrCompetencyNotFound,
+	// ErrCompetencyVersionConflict, ErrCompetencyAlreadyExists
+	Rename(ctx context.Context, id int32, name string, expectedVersion int32) (*Competency, error)
+
+	// Archive hides a competency from listings and search without deleting it; archiving twice is a no-op
+	// Possible errors: ErrAuthenticationRequired, ErrForbidden, ErrCompetencyNotFound
+	Archive(ctx context.Context, id int32) (*Competency, error)
+
+	// Restore brings an archived competency back; restoring one that isn't archived is a no-op
+	// Possible errors: ErrAuthenticationRequired, ErrForbidden, ErrCompetencyNotFound
+	Restore(ctx context.Context, id int32) (*Competency, error)
+
+	// SetCategory places a competency under a category, or makes it uncategorized when categoryID is nil
+	// Possible errors: ErrAuthenticationRequired, ErrForbidden, ErrCompetencyNotFound, ErrCategoryNotFound
+	SetCategory(ctx context.Context, id int32, categoryID *int32) (*Competency, error)
+
+	// History retrieves the revisions of a competency, newest first, only those before the revision before when it is positive
+	// A non-positive limit means DefaultRevisionPageSize, and it is capped at MaxRevisionPageSize
+	// Possible errors: ErrCompetencyNotFound
+	History(ctx context.Context, id, before, limit int32) ([]*CompetencyRevision, error)
+
+	// Diff compares the states recorded by two revisions of a competency
+	// Possible errors: ErrCompetencyNotFound, ErrRevisionNotFound
+	Diff(ctx context.Context, id, from, to int32) (*RevisionDiff, error)
+
+	// Revert sets a competency back to the state recorded by a revision if it still has expectedVersion
+	// (AnyVersion skips the check), and records the revert as a new revision
+	// Possible errors: ErrAuthenticationRequired, ErrForbidden, ErrCompetencyNotFound, ErrRevisionNotFound,
+	// ErrCompetencyVersionConflict, ErrCompetencyAlreadyExists, ErrCategoryNotFound
+	Revert(ctx context.Context, id, revision, expectedVersion int32) (*Competency, error)
+
+	// Delete deletes a competency permanently, with its tags, translations and history
+	// It is refused while prerequisite or related edges, merge redirects or a rubric reference the competency
+	// Admins only
+	// Possible errors: ErrAuthenticationRequired, ErrForbidden, ErrCompetencyNotFound, ErrCompetencyInUse
+	Delete(ctx context.Context, id int32) error
 }
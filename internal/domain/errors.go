@@ -23,6 +23,12 @@ var (
 	// ErrInvalidToken is returned when a JWT token is invalid or expired
 	ErrInvalidToken = errors.New("invalid or expired token")
 
+	// ErrAuthenticationRequired is returned when an anonymous request performs an operation reserved to some users
+	ErrAuthenticationRequired = errors.New("authentication required")
+
+	// ErrForbidden is returned when the authenticated user isn't allowed to perform an operation
+	ErrForbidden = errors.New("forbidden")
+
 	// ErrCompetencyNotFound is returned when a competency cannot be found
 	ErrCompetencyNotFound = errors.New("competency not found")
 
@@ -31,4 +37,154 @@ var (
 
 	// ErrInvalidCompetencyName is returned when the competency name is invalid or empty
 	ErrInvalidCompetencyName = errors.New("invalid competency name")
+
+	// ErrCompetencyInUse is returned when deleting a competency that other data still references
+	ErrCompetencyInUse = errors.New("competency in use")
+
+	// ErrCompetencyVersionConflict is returned when a competency was changed since the version the update is based on
+	ErrCompetencyVersionConflict = errors.New("competency version conflict")
+
+	// ErrInvalidCompetencySort is returned when a competency listing is ordered by an unknown field or direction
+	ErrInvalidCompetencySort = errors.New("invalid competency sort")
+
+	// ErrInvalidCompetencyCursor is returned when a pagination cursor is malformed or was issued for another ordering
+	ErrInvalidCompetencyCursor = errors.New("invalid competency cursor")
+
+	// ErrInvalidSearchQuery is returned when a competency search or suggestion has no search text
+	ErrInvalidSearchQuery = errors.New("invalid search query")
+
+	// ErrInvalidCompetencyBatch is returned when a batch is empty, too large or has an unknown mode
+	ErrInvalidCompetencyBatch = errors.New("invalid competency batch")
+
+	// ErrCompetencyBatchRejected is returned when an all-or-nothing batch has items that can't be created
+	// Nothing was created; the batch results tell which items failed
+	ErrCompetencyBatchRejected = errors.New("competency batch rejected")
+
+	// ErrInvalidCompetencyImport is returned when an import is empty, too large or has an unknown policy
+	ErrInvalidCompetencyImport = errors.New("invalid competency import")
+
+	// ErrCompetencyImportRejected is returned when an import has invalid or conflicting records
+	// Nothing was changed; the import report tells which records failed
+	ErrCompetencyImportRejected = errors.New("competency import rejected")
+
+	// ErrDuplicateImportRecord is the error of an import record whose name appears earlier in the import
+	ErrDuplicateImportRecord = errors.New("name appears more than once in the import")
+
+	// ErrCategoryNotFound is returned when a competency category cannot be found
+	ErrCategoryNotFound = errors.New("category not found")
+
+	// ErrCategoryParentNotFound is returned when a category is created or moved under a category that doesn't exist
+	ErrCategoryParentNotFound = errors.New("parent category not found")
+
+	// ErrCategoryAlreadyExists is returned when a category would have the name of one of its siblings
+	ErrCategoryAlreadyExists = errors.New("category already exists")
+
+	// ErrInvalidCategoryName is returned when the category name is invalid or empty
+	ErrInvalidCategoryName = errors.New("invalid category name")
+
+	// ErrCategoryCycle is returned when a category is moved under itself or one of its descendants
+	ErrCategoryCycle = errors.New("category cycle")
+
+	// ErrCategoryInUse is returned when deleting a category that still has children or competencies
+	ErrCategoryInUse = errors.New("category in use")
+
+	// ErrInvalidCategoryOrder is returned when a reordering doesn't list every child of the parent exactly once
+	ErrInvalidCategoryOrder = errors.New("invalid category order")
+
+	// ErrRatingScaleNotFound is returned when a rating scale cannot be found
+	ErrRatingScaleNotFound = errors.New("rating scale not found")
+
+	// ErrRatingScaleAlreadyExists is returned when a rating scale would have the name of another one
+	ErrRatingScaleAlreadyExists = errors.New("rating scale already exists")
+
+	// ErrInvalidRatingScale is returned when a rating scale has an invalid name or levels
+	ErrInvalidRatingScale = errors.New("invalid rating scale")
+
+	// ErrRatingScaleInUse is returned when deleting a scale used by a rubric, or removing a level a rubric describes
+	ErrRatingScaleInUse = errors.New("rating scale in use")
+
+	// ErrRubricNotFound is returned when a competency has no rubric
+	ErrRubricNotFound = errors.New("rubric not found")
+
+	// ErrInvalidCompetencyLevel is returned when a level description or its indicators are invalid
+	ErrInvalidCompetencyLevel = errors.New("invalid competency level")
+
+	// ErrUnknownRatingLevel is returned when a competency level isn't a level of the rubric's scale
+	ErrUnknownRatingLevel = errors.New("unknown rating level")
+
+	// ErrCompetencyLevelNotFound is returned when a competency has no description for a level
+	ErrCompetencyLevelNotFound = errors.New("competency level not found")
+
+	// ErrTagNotFound is returned when a tag cannot be found, or isn't on the competency it is removed from
+	ErrTagNotFound = errors.New("tag not found")
+
+	// ErrTagAlreadyExists is returned when a tag would have the name of another one
+	ErrTagAlreadyExists = errors.New("tag already exists")
+
+	// ErrInvalidTagName is returned when a tag name is empty, too long or has unsupported characters
+	ErrInvalidTagName = errors.New("invalid tag name")
+
+	// ErrInvalidTagMatch is returned when a competency listing filters by tags with an unknown match mode
+	ErrInvalidTagMatch = errors.New("invalid tag match")
+
+	// ErrRevisionNotFound is returned when a competency has no revision with the requested number
+	ErrRevisionNotFound = errors.New("revision not found")
+
+	// ErrInvalidRelation is returned when a competency is related to itself, or with an unknown relation kind
+	ErrInvalidRelation = errors.New("invalid competency relation")
+
+	// ErrRelationCycle is returned when a prerequisite would make a competency require itself
+	ErrRelationCycle = errors.New("competency relation cycle")
+
+	// ErrRelationNotFound is returned when removing an edge that doesn't exist
+	ErrRelationNotFound = errors.New("competency relation not found")
+
+	// ErrInvalidLearningTargets is returned when a learning order has no targets or more than MaxLearningTargets
+	ErrInvalidLearningTargets = errors.New("invalid learning targets")
+
+	// ErrUnsupportedLocale is returned when a locale isn't one of SupportedLocales
+	ErrUnsupportedLocale = errors.New("unsupported locale")
+
+	// ErrInvalidTranslation is returned when a translation has an invalid name or level, or targets the fallback locale
+	ErrInvalidTranslation = errors.New("invalid translation")
+
+	// ErrTranslationNotFound is returned when a competency has no translation in the requested locale
+	ErrTranslationNotFound = errors.New("translation not found")
+
+	// ErrInvalidMerge is returned when a competency would be merged into itself
+	ErrInvalidMerge = errors.New("invalid competency merge")
+
+	// ErrInvalidDuplicateThreshold is returned when the duplicate report threshold is below MinDuplicateThreshold or above 1
+	ErrInvalidDuplicateThreshold = errors.New("invalid duplicate threshold")
+
+	// ErrProposalNotFound is returned when a proposal cannot be found, or isn't visible to the user
+	ErrProposalNotFound = errors.New("proposal not found")
+
+	// ErrInvalidProposal is returned when a proposal changes nothing, or has an invalid name or rationale
+	ErrInvalidProposal = errors.New("invalid proposal")
+
+	// ErrProposalStatusConflict is returned when a proposal isn't in the status an operation needs,
+	// e.g. editing a submitted proposal or approving a draft
+	ErrProposalStatusConflict = errors.New("proposal status conflict")
+
+	// ErrInvalidProposalComment is returned when a proposal comment is empty or too long
+	ErrInvalidProposalComment = errors.New("invalid proposal comment")
+
+	// ErrStewardNotFound is returned when a user isn't a steward of a competency
+	ErrStewardNotFound = errors.New("steward not found")
+
+	// ErrInvalidReport is returned when a stale-content report has no reason or a too long one
+	ErrInvalidReport = errors.New("invalid competency report")
+
+	// ErrNotificationNotFound is returned when a notification cannot be found in the inbox of the user
+	ErrNotificationNotFound = errors.New("notification not found")
+
+	// ErrFrameworkVersionNotFound is returned when a framework version cannot be found
+	ErrFrameworkVersionNotFound = errors.New("framework version not found")
+
+	// ErrFrameworkVersionAlreadyExists is returned when publishing a version under the name of a published one
+	ErrFrameworkVersionAlreadyExists = errors.New("framework version already exists")
+
+	// ErrInvalidFrameworkVersion is returned when a version to publish has an invalid name or too long notes
+	ErrInvalidFrameworkVersion = errors.New("invalid framework version")
 )
@@ -0,0 +1,174 @@
+package domain
+
+import (
+	"reflect"
+	"regexp"
+	"time"
+)
+
+// Limits of framework versions
+const (
+	MaxFrameworkVersionNameLength  = 32
+	MaxFrameworkVersionNotesLength = 2000
+)
+
+// DraftFrameworkVersion names the framework being edited, which becomes the next version when published
+const DraftFrameworkVersion = "draft"
+
+// frameworkVersionNamePattern matches version names such as "2026.2"
+var frameworkVersionNamePattern = regexp.MustCompile(`^[0-9A-Za-z][0-9A-Za-z._-]*$`)
+
+// ValidFrameworkVersionName reports whether name can name a published version
+// Names start with a letter or digit, use letters, digits, '.', '_' and '-', and can't be DraftFrameworkVersion
+func ValidFrameworkVersionName(name string) bool {
+	return len(name) <= MaxFrameworkVersionNameLength &&
+		name != DraftFrameworkVersion &&
+		frameworkVersionNamePattern.MatchString(name)
+}
+
+// FrameworkCategory is a category of a framework snapshot
+type FrameworkCategory struct {
+	ID       int32  `json:"id"`
+	ParentID *int32 `json:"parent_id"`
+	Name     string `json:"name"`
+	Position int32  `json:"position"`
+}
+
+// FrameworkLevel is a level of the rubric of a framework competency; undescribed levels have empty texts
+type FrameworkLevel struct {
+	Value       int32    `json:"value"`
+	Label       string   `json:"label"`
+	Description string   `json:"description"`
+	Indicators  []string `json:"indicators"`
+}
+
+// FrameworkRubric is the rubric of a framework competency, with every level of its scale
+type FrameworkRubric struct {
+	ScaleID   int32            `json:"scale_id"`
+	ScaleName string           `json:"scale_name"`
+	Levels    []FrameworkLevel `json:"levels"`
+}
+
+// FrameworkCompetency is a competency of a framework snapshot
+type FrameworkCompetency struct {
+	ID          int32            `json:"id"`
+	Name        string           `json:"name"`
+	Description string           `json:"description"`
+	CategoryID  *int32           `json:"category_id"`
+	Rubric      *FrameworkRubric `json:"rubric"` // nil without rubric
+}
+
+// FrameworkSnapshot is the competency framework at one point: its categories, and its competencies that
+// aren't archived with their rubrics, in FallbackLocale
+// Snapshots are taken by the database (framework_snapshot), categories and competencies ordered by ID
+type FrameworkSnapshot struct {
+	Categories   []FrameworkCategory   `json:"categories"`
+	Competencies []FrameworkCompetency `json:"competencies"`
+}
+
+// FrameworkVersion is a published, immutable version of the framework, or the draft (ID 0, never published)
+type FrameworkVersion struct {
+	ID              int32
+	Name            string
+	Notes           string
+	CompetencyCount int32
+	PublishedBy     *int32             // Admin who published the version, nil once deleted
+	PublishedAt     time.Time          // Zero for the draft
+	Snapshot        *FrameworkSnapshot // nil in listings
+}
+
+// FrameworkChangeKind tells how a competency or category differs between two framework versions
+type FrameworkChangeKind string
+
+const (
+	FrameworkAdded   FrameworkChangeKind = "added"
+	FrameworkRemoved FrameworkChangeKind = "removed"
+	FrameworkChanged FrameworkChangeKind = "changed"
+)
+
+// FrameworkChange is a competency or category that differs between two framework versions
+type FrameworkChange struct {
+	Kind   FrameworkChangeKind
+	ID     int32
+	Name   string        // Name in the newer version, or in the older one when removed
+	Fields []FieldChange // Changed fields, for FrameworkChanged
+}
+
+// FrameworkDiff compares two framework versions; either can be DraftFrameworkVersion
+type FrameworkDiff struct {
+	From         string
+	To           string
+	Categories   []FrameworkChange // By ID
+	Competencies []FrameworkChange // By ID
+}
+
+// Diff lists the categories and competencies that differ from s to other, matched by ID
+func (s FrameworkSnapshot) Diff(other FrameworkSnapshot) (categories, competencies []FrameworkChange) {
+	categories = diffFrameworkItems(s.Categories, other.Categories,
+		func(c FrameworkCategory) (int32, string) { return c.ID, c.Name },
+		func(a, b FrameworkCategory) []FieldChange {
+			changes := []FieldChange{}
+			if a.Name != b.Name {
+				changes = append(changes, FieldChange{Field: "name", From: a.Name, To: b.Name})
+			}
+			if !equalIDs(a.ParentID, b.ParentID) {
+				changes = append(changes, FieldChange{Field: "parent_id", From: a.ParentID, To: b.ParentID})
+			}
+			if a.Position != b.Position {
+				changes = append(changes, FieldChange{Field: "position", From: a.Position, To: b.Position})
+			}
+			return changes
+		})
+	competencies = diffFrameworkItems(s.Competencies, other.Competencies,
+		func(c FrameworkCompetency) (int32, string) { return c.ID, c.Name },
+		func(a, b FrameworkCompetency) []FieldChange {
+			changes := []FieldChange{}
+			if a.Name != b.Name {
+				changes = append(changes, FieldChange{Field: "name", From: a.Name, To: b.Name})
+			}
+			if a.Description != b.Description {
+				changes = append(changes, FieldChange{Field: "description", From: a.Description, To: b.Description})
+			}
+			if !equalIDs(a.CategoryID, b.CategoryID) {
+				changes = append(changes, FieldChange{Field: "category_id", From: a.CategoryID, To: b.CategoryID})
+			}
+			if !reflect.DeepEqual(a.Rubric, b.Rubric) {
+				changes = append(changes, FieldChange{Field: "rubric", From: a.Rubric, To: b.Rubric})
+			}
+			return changes
+		})
+	return categories, competencies
+}
+
+// diffFrameworkItems matches the items of two snapshots by ID, both ordered by ID, and lists their differences
+func diffFrameworkItems[T any](from, to []T, key func(T) (int32, string), fields func(a, b T) []FieldChange) []FrameworkChange {
+	changes := []FrameworkChange{}
+	i, j := 0, 0
+	for i < len(from) || j < len(to) {
+		var fromID, toID int32
+		if i < len(from) {
+			fromID, _ = key(from[i])
+		}
+		if j < len(to) {
+			toID, _ = key(to[j])
+		}
+		switch {
+		case j == len(to) || (i < len(from) && fromID < toID):
+			_, name := key(from[i])
+			changes = append(changes, FrameworkChange{Kind: FrameworkRemoved, ID: fromID, Name: name})
+			i++
+		case i == len(from) || toID < fromID:
+			_, name := key(to[j])
+			changes = append(changes, FrameworkChange{Kind: FrameworkAdded, ID: toID, Name: name})
+			j++
+		default:
+			if changed := fields(from[i], to[j]); len(changed) > 0 {
+				_, name := key(to[j])
+				changes = append(changes, FrameworkChange{Kind: FrameworkChanged, ID: toID, Name: name, Fields: changed})
+			}
+			i++
+			j++
+		}
+	}
+	return changes
+}
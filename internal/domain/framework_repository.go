@@ -0,0 +1,21 @@
+package domain
+
+import "context"
+
+// FrameworkRepository defines the contract for framework version data access
+// Published versions are immutable: there is no way to change or delete them
+type FrameworkRepository interface {
+	// Publish snapshots the current framework as a version named name
+	// Returns domain.ErrFrameworkVersionAlreadyExists if a version has the name
+	Publish(ctx context.Context, name, notes string, publishedBy *int32) (*FrameworkVersion, error)
+
+	// GetAll retrieves the published versions without their snapshots, latest first
+	GetAll(ctx context.Context) ([]*FrameworkVersion, error)
+
+	// GetByName retrieves a published version with its snapshot
+	// Returns domain.ErrFrameworkVersionNotFound if no version has the name
+	GetByName(ctx context.Context, name string) (*FrameworkVersion, error)
+
+	// GetDraft takes a snapshot of the current framework
+	GetDraft(ctx context.Context) (*FrameworkSnapshot, error)
+}
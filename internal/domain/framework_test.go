@@ -0,0 +1,142 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestFrameworkSnapshotDiff checks that items are matched by ID and every changed field is listed
+func TestFrameworkSnapshotDiff(t *testing.T) {
+	id := func(n int32) *int32 { return &n }
+	rubric := func(label string) *FrameworkRubric {
+		return &FrameworkRubric{ScaleID: 1, ScaleName: "Dreyfus", Levels: []FrameworkLevel{
+			{Value: 1, Label: label, Description: "Follows rules", Indicators: []string{"Needs guidance"}},
+		}}
+	}
+	goCompetency := FrameworkCompetency{ID: 2, Name: "Go", Description: "The language", CategoryID: id(1), Rubric: rubric("Novice")}
+
+	tests := []struct {
+		name             string
+		from, to         FrameworkSnapshot
+		wantCategories   []FrameworkChange
+		wantCompetencies []FrameworkChange
+	}{
+		{
+			name:             "empty snapshots",
+			wantCategories:   []FrameworkChange{},
+			wantCompetencies: []FrameworkChange{},
+		},
+		{
+			name:             "identical snapshots",
+			from:             FrameworkSnapshot{Competencies: []FrameworkCompetency{goCompetency}},
+			to:               FrameworkSnapshot{Competencies: []FrameworkCompetency{goCompetency}},
+			wantCategories:   []FrameworkChange{},
+			wantCompetencies: []FrameworkChange{},
+		},
+		{
+			name: "added, removed and kept, ordered by ID",
+			from: FrameworkSnapshot{Competencies: []FrameworkCompetency{
+				{ID: 1, Name: "Docker"}, {ID: 3, Name: "SQL"}, {ID: 5, Name: "Rust"},
+			}},
+			to: FrameworkSnapshot{Competencies: []FrameworkCompetency{
+				{ID: 2, Name: "Go"}, {ID: 3, Name: "SQL"}, {ID: 6, Name: "Zig"},
+			}},
+			wantCategories: []FrameworkChange{},
+			wantCompetencies: []FrameworkChange{
+				{Kind: FrameworkRemoved, ID: 1, Name: "Docker"},
+				{Kind: FrameworkAdded, ID: 2, Name: "Go"},
+				{Kind: FrameworkRemoved, ID: 5, Name: "Rust"},
+				{Kind: FrameworkAdded, ID: 6, Name: "Zig"},
+			},
+		},
+		{
+			name: "changed competency fields, named as in the newer version",
+			from: FrameworkSnapshot{Competencies: []FrameworkCompetency{goCompetency}},
+			to: FrameworkSnapshot{Competencies: []FrameworkCompetency{
+				{ID: 2, Name: "Golang", Description: "The Go language", CategoryID: nil, Rubric: rubric("Beginner")},
+			}},
+			wantCategories: []FrameworkChange{},
+			wantCompetencies: []FrameworkChange{{Kind: FrameworkChanged, ID: 2, Name: "Golang", Fields: []FieldChange{
+				{Field: "name", From: "Go", To: "Golang"},
+				{Field: "description", From: "The language", To: "The Go language"},
+				{Field: "category_id", From: id(1), To: (*int32)(nil)},
+				{Field: "rubric", From: rubric("Novice"), To: rubric("Beginner")},
+			}}},
+		},
+		{
+			name:             "equal category IDs behind different pointers",
+			from:             FrameworkSnapshot{Competencies: []FrameworkCompetency{{ID: 2, Name: "Go", CategoryID: id(1)}}},
+			to:               FrameworkSnapshot{Competencies: []FrameworkCompetency{{ID: 2, Name: "Go", CategoryID: id(1)}}},
+			wantCategories:   []FrameworkChange{},
+			wantCompetencies: []FrameworkChange{},
+		},
+		{
+			name:             "rubric removed",
+			from:             FrameworkSnapshot{Competencies: []FrameworkCompetency{goCompetency}},
+			to:               FrameworkSnapshot{Competencies: []FrameworkCompetency{{ID: 2, Name: "Go", Description: "The language", CategoryID: id(1)}}},
+			wantCategories:   []FrameworkChange{},
+			wantCompetencies: []FrameworkChange{{Kind: FrameworkChanged, ID: 2, Name: "Go", Fields: []FieldChange{{Field: "rubric", From: rubric("Novice"), To: (*FrameworkRubric)(nil)}}}},
+		},
+		{
+			name: "categories",
+			from: FrameworkSnapshot{Categories: []FrameworkCategory{
+				{ID: 1, Name: "Backend", Position: 0},
+				{ID: 2, Name: "Databases", ParentID: id(1), Position: 0},
+			}},
+			to: FrameworkSnapshot{Categories: []FrameworkCategory{
+				{ID: 1, Name: "Back end", Position: 1},
+				{ID: 2, Name: "Databases", ParentID: nil, Position: 0},
+				{ID: 3, Name: "Frontend", Position: 2},
+			}},
+			wantCategories: []FrameworkChange{
+				{Kind: FrameworkChanged, ID: 1, Name: "Back end", Fields: []FieldChange{
+					{Field: "name", From: "Backend", To: "Back end"},
+					{Field: "position", From: int32(0), To: int32(1)},
+				}},
+				{Kind: FrameworkChanged, ID: 2, Name: "Databases", Fields: []FieldChange{
+					{Field: "parent_id", From: id(1), To: (*int32)(nil)},
+				}},
+				{Kind: FrameworkAdded, ID: 3, Name: "Frontend"},
+			},
+			wantCompetencies: []FrameworkChange{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			categories, competencies := tt.from.Diff(tt.to)
+			if !reflect.DeepEqual(categories, tt.wantCategories) {
+				t.Errorf("categories = %+v, want %+v", categories, tt.wantCategories)
+			}
+			if !reflect.DeepEqual(competencies, tt.wantCompetencies) {
+				t.Errorf("competencies = %+v, want %+v", competencies, tt.wantCompetencies)
+			}
+		})
+	}
+}
+
+// TestValidFrameworkVersionName checks which names can name a published version
+func TestValidFrameworkVersionName(t *testing.T) {
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{name: "2026.2", want: true},
+		{name: "v1_rc-2", want: true},
+		{name: "a", want: true},
+		{name: "12345678901234567890123456789012", want: true},
+		{name: "123456789012345678901234567890123", want: false},
+		{name: "", want: false},
+		{name: DraftFrameworkVersion, want: false},
+		{name: ".hidden", want: false},
+		{name: "-flag", want: false},
+		{name: "2026 Q2", want: false},
+		{name: "2026/2", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ValidFrameworkVersionName(tt.name); got != tt.want {
+				t.Errorf("ValidFrameworkVersionName(%q) = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package domain
+
+import "context"
+
+// FrameworkUseCase defines the contract for publishing the competency framework as versions
+// The catalogue is the draft of the next version (DraftFrameworkVersion): editing it never changes a published one
+type FrameworkUseCase interface {
+	// Publish snapshots the current framework as an immutable version; admins only
+	// Possible errors: ErrAuthenticationRequired, ErrForbidden, ErrInvalidFrameworkVersion, ErrFrameworkVersionAlreadyExists
+	Publish(ctx context.Context, name, notes string) (*FrameworkVersion, error)
+
+	// GetVersions retrieves the published versions without their snapshots, latest first
+	GetVersions(ctx context.Context) ([]*FrameworkVersion, error)
+
+	// GetVersion retrieves a published version with its snapshot; DraftFrameworkVersion gets the current framework,
+	// as an unpublished version named after it
+	// Possible errors: ErrFrameworkVersionNotFound
+	GetVersion(ctx context.Context, name string) (*FrameworkVersion, error)
+
+	// Diff compares two versions; either can be DraftFrameworkVersion
+	// Possible errors: ErrFrameworkVersionNotFound
+	Diff(ctx context.Context, from, to string) (*FrameworkDiff, error)
+}
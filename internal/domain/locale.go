@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"context"
+	"slices"
+)
+
+// Locale is the language competency texts are written in, as a BCP 47 primary language subtag
+type Locale string
+
+const (
+	LocaleEnglish Locale = "en"
+	LocaleGerman  Locale = "de"
+	LocalePersian Locale = "fa"
+)
+
+// FallbackLocale is the locale of the texts stored on competencies and rubrics themselves
+// They are shown in other locales until translated
+const FallbackLocale = LocaleEnglish
+
+// SupportedLocales lists the locales competencies can be read in, the fallback locale first
+var SupportedLocales = []Locale{LocaleEnglish, LocaleGerman, LocalePersian}
+
+// Valid reports whether l is one of SupportedLocales
+func (l Locale) Valid() bool {
+	return slices.Contains(SupportedLocales, l)
+}
+
+// TranslatedLocales returns the supported locales other than the fallback one, those translations are kept for
+func TranslatedLocales() []Locale {
+	return slices.DeleteFunc(slices.Clone(SupportedLocales), func(l Locale) bool { return l == FallbackLocale })
+}
+
+// localeContextKey is the context key for the locale an operation reads competencies in
+type localeContextKey struct{}
+
+// ContextWithLocale returns a copy of ctx reading competencies in locale
+func ContextWithLocale(ctx context.Context, locale Locale) context.Context {
+	return context.WithValue(ctx, localeContextKey{}, locale)
+}
+
+// LocaleFromContext returns the locale stored in ctx, FallbackLocale when there is none
+func LocaleFromContext(ctx context.Context) Locale {
+	if locale, ok := ctx.Value(localeContextKey{}).(Locale); ok && locale.Valid() {
+		return locale
+	}
+	return FallbackLocale
+}
@@ -0,0 +1,22 @@
+package domain
+
+import "context"
+
+// MergeRepository defines the contract for duplicate detection and competency merge data access
+type MergeRepository interface {
+	// GetDuplicates retrieves at most limit pairs of similar competencies scoring at least threshold, highest first
+	// The score is the name similarity, or the mean of the name and description similarities when that is higher
+	GetDuplicates(ctx context.Context, threshold float32, limit int32) ([]*DuplicateCandidate, error)
+
+	// Merge moves the tags, relations, translations, proposals, stewards, reports and rubric of the merged competency to
+	// the surviving one, redirects the merged ID to it and deletes the merged competency
+	// Tags, relations, translations and stewards the surviving competency already has are kept, and its rubric too if it has one;
+	// relations between the two competencies are dropped. It takes the description and category of the merged
+	// competency only when it has none
+	// Returns domain.ErrCompetencyNotFound if either competency doesn't exist
+	Merge(ctx context.Context, merge CompetencyMerge) error
+
+	// GetRedirect retrieves the ID of the competency a merged competency was merged into, following later merges
+	// Returns domain.ErrCompetencyNotFound if the ID isn't the ID of a merged competency
+	GetRedirect(ctx context.Context, mergedID int32) (int32, error)
+}
@@ -0,0 +1,20 @@
+package domain
+
+import "context"
+
+// MergeUseCase defines the contract for finding and merging duplicate competencies; admins only
+// Lookups of merged IDs are redirected by CompetencyUseCase.GetByID
+type MergeUseCase interface {
+	// FindDuplicates reports pairs of competencies that aren't archived and have similar names or descriptions,
+	// highest score first
+	// A zero threshold means DefaultDuplicateThreshold; a non-positive limit means DefaultDuplicateLimit, and it is
+	// capped at MaxDuplicateLimit
+	// Possible errors: ErrAuthenticationRequired, ErrForbidden, ErrInvalidDuplicateThreshold
+	FindDuplicates(ctx context.Context, threshold float32, limit int32) ([]*DuplicateCandidate, error)
+
+	// Merge merges the competency mergedID into the competency id (see MergeRepository.Merge) in one transaction,
+	// and records the merge as a revision of the surviving competency, which it returns
+	// It is refused when moving the prerequisites would make a competency require itself
+	// Possible errors: ErrAuthenticationRequired, ErrForbidden, ErrInvalidMerge, ErrCompetencyNotFound, ErrRelationCycle
+	Merge(ctx context.Context, id, mergedID int32) (*Competency, error)
+}
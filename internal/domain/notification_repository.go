@@ -0,0 +1,16 @@
+package domain
+
+import "context"
+
+// NotificationRepository defines the contract for the inboxes of the users
+type NotificationRepository interface {
+	// NotifyStewards notifies every steward of a competency but the sender, returning how many were notified
+	NotifyStewards(ctx context.Context, notice StewardNotice) (int64, error)
+
+	// GetByUser retrieves the latest notifications of a user, newest first, only unread ones with unreadOnly
+	GetByUser(ctx context.Context, userID int32, unreadOnly bool, limit int32) ([]*Notification, error)
+
+	// MarkRead marks a notification of a user read; marking it twice keeps the first time
+	// Possible errors: ErrNotificationNotFound
+	MarkRead(ctx context.Context, id, userID int32) (*Notification, error)
+}
@@ -0,0 +1,15 @@
+package domain
+
+import "context"
+
+// NotificationUseCase defines the contract for the inbox of the user
+type NotificationUseCase interface {
+	// GetMine retrieves the latest notifications of the user, newest first, only unread ones with unreadOnly
+	// A non-positive limit means DefaultNotificationPageSize, and it is capped at MaxNotificationPageSize
+	// Possible errors: ErrAuthenticationRequired
+	GetMine(ctx context.Context, unreadOnly bool, limit int32) ([]*Notification, error)
+
+	// MarkRead marks a notification of the user read
+	// Possible errors: ErrAuthenticationRequired, ErrNotificationNotFound
+	MarkRead(ctx context.Context, id int32) (*Notification, error)
+}
@@ -0,0 +1,72 @@
+package domain
+
+import "time"
+
+// Limits of proposals
+const (
+	MaxProposalRationaleLength       = 2000
+	MaxProposalCommentLength         = 2000
+	DefaultProposalQueueSize   int32 = 50
+	MaxProposalQueueSize       int32 = 200
+)
+
+// ProposalKind tells whether a proposal creates a competency or edits an existing one
+type ProposalKind string
+
+const (
+	ProposalCreate ProposalKind = "create"
+	ProposalEdit   ProposalKind = "edit"
+)
+
+// ProposalStatus is the review state of a proposal: draft -> submitted -> approved | rejected
+type ProposalStatus string
+
+const (
+	ProposalDraft     ProposalStatus = "draft"     // Only the proposer sees and edits it
+	ProposalSubmitted ProposalStatus = "submitted" // Waiting in the review queue
+	ProposalApproved  ProposalStatus = "approved"  // The change was applied
+	ProposalRejected  ProposalStatus = "rejected"
+)
+
+// Valid reports whether s is a known status
+func (s ProposalStatus) Valid() bool {
+	switch s {
+	case ProposalDraft, ProposalSubmitted, ProposalApproved, ProposalRejected:
+		return true
+	}
+	return false
+}
+
+// ProposalChange is the change a proposal suggests
+// A creation needs a name and has no CompetencyID; an edit has one and changes the fields that are set
+type ProposalChange struct {
+	CompetencyID *int32 // Competency edited, nil for a creation
+	Name         *string
+	Description  *string
+	CategoryID   *int32 // An edit can't make a competency uncategorized
+	Rationale    string // Why the change is needed, for the reviewers
+}
+
+// CompetencyProposal is a change to the catalogue suggested by a user, applied when an admin approves it
+type CompetencyProposal struct {
+	ID          int32
+	Kind        ProposalKind
+	ProposerID  int32
+	Change      ProposalChange // CompetencyID of an approved creation is the competency it created
+	Status      ProposalStatus
+	ReviewerID  *int32 // Admin who approved or rejected it
+	Comments    []*ProposalComment
+	SubmittedAt *time.Time
+	ReviewedAt  *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// ProposalComment is a message of the proposer or a reviewer about a proposal
+type ProposalComment struct {
+	ID         int32
+	ProposalID int32
+	AuthorID   *int32 // nil once the author is deleted
+	Body       string
+	CreatedAt  time.Time
+}
@@ -0,0 +1,41 @@
+package domain
+
+import "context"
+
+// ProposalRepository defines the contract for competency proposal data access
+// Single proposals are retrieved with their comments; listings leave them out
+type ProposalRepository interface {
+	// Create creates a draft proposal of kind
+	// Returns domain.ErrCompetencyNotFound or domain.ErrCategoryNotFound if a referenced row doesn't exist
+	Create(ctx context.Context, kind ProposalKind, proposerID int32, change ProposalChange) (*CompetencyProposal, error)
+
+	// GetByID retrieves a proposal; forUpdate locks it until the end of the transaction of ctx
+	// Returns domain.ErrProposalNotFound if the proposal doesn't exist
+	GetByID(ctx context.Context, id int32, forUpdate bool) (*CompetencyProposal, error)
+
+	// GetByProposer retrieves the proposals of a user, newest first, only those with status when it isn't empty
+	GetByProposer(ctx context.Context, proposerID int32, status ProposalStatus) ([]*CompetencyProposal, error)
+
+	// GetQueue retrieves at most limit submitted proposals, oldest submission first
+	GetQueue(ctx context.Context, limit int32) ([]*CompetencyProposal, error)
+
+	// UpdateDraft replaces the change of a draft; its CompetencyID is ignored
+	// Returns domain.ErrProposalNotFound, domain.ErrProposalStatusConflict if it isn't a draft, or domain.ErrCategoryNotFound
+	UpdateDraft(ctx context.Context, id int32, change ProposalChange) (*CompetencyProposal, error)
+
+	// Submit moves a draft to the review queue
+	// Returns domain.ErrProposalNotFound, or domain.ErrProposalStatusConflict if it isn't a draft
+	Submit(ctx context.Context, id int32) (*CompetencyProposal, error)
+
+	// Review approves or rejects a submitted proposal; competencyID records the competency an approved creation created
+	// Returns domain.ErrProposalNotFound, or domain.ErrProposalStatusConflict if it isn't submitted
+	Review(ctx context.Context, id int32, status ProposalStatus, reviewerID, competencyID *int32) (*CompetencyProposal, error)
+
+	// Delete deletes a proposal that isn't reviewed yet
+	// Returns domain.ErrProposalNotFound, or domain.ErrProposalStatusConflict if it was reviewed
+	Delete(ctx context.Context, id int32) error
+
+	// AddComment adds a comment to a proposal
+	// Returns domain.ErrProposalNotFound if the proposal doesn't exist
+	AddComment(ctx context.Context, proposalID int32, authorID *int32, body string) (*ProposalComment, error)
+}
@@ -0,0 +1,55 @@
+package domain
+
+import "context"
+
+// ProposalUseCase defines the contract for the competency proposal workflow
+// Any user can propose changes; a proposal is seen by its proposer and admins, and only admins review it.
+// Other users get ErrProposalNotFound for proposals they can't see
+type ProposalUseCase interface {
+	// Create creates a draft proposal of the user; an edit needs an existing competency and at least one change,
+	// a creation needs a name. Names are validated like competency names
+	// Possible errors: ErrAuthenticationRequired, ErrInvalidProposal, ErrCompetencyNotFound, ErrCategoryNotFound
+	Create(ctx context.Context, change ProposalChange) (*CompetencyProposal, error)
+
+	// GetByID retrieves a proposal with its comments
+	// Possible errors: ErrAuthenticationRequired, ErrProposalNotFound
+	GetByID(ctx context.Context, id int32) (*CompetencyProposal, error)
+
+	// GetMine retrieves the proposals of the user, newest first, only those with status when it isn't empty
+	// Possible errors: ErrAuthenticationRequired, ErrInvalidProposal
+	GetMine(ctx context.Context, status ProposalStatus) ([]*CompetencyProposal, error)
+
+	// GetQueue retrieves the submitted proposals, oldest submission first
+	// A non-positive limit means DefaultProposalQueueSize, and it is capped at MaxProposalQueueSize
+	// Possible errors: ErrAuthenticationRequired, ErrForbidden
+	GetQueue(ctx context.Context, limit int32) ([]*CompetencyProposal, error)
+
+	// Update replaces the change of a draft of the user, validated like Create; the competency can't change
+	// Possible errors: ErrAuthenticationRequired, ErrProposalNotFound, ErrProposalStatusConflict, ErrInvalidProposal,
+	// ErrCategoryNotFound
+	Update(ctx context.Context, id int32, change ProposalChange) (*CompetencyProposal, error)
+
+	// Submit sends a draft of the user to the review queue; the stewards of an edited competency are notified
+	// Possible errors: ErrAuthenticationRequired, ErrProposalNotFound, ErrProposalStatusConflict
+	Submit(ctx context.Context, id int32) (*CompetencyProposal, error)
+
+	// Withdraw deletes a proposal of the user that isn't reviewed yet
+	// Possible errors: ErrAuthenticationRequired, ErrProposalNotFound, ErrProposalStatusConflict
+	Withdraw(ctx context.Context, id int32) error
+
+	// Comment adds a comment of the proposer or an admin to a proposal
+	// Possible errors: ErrAuthenticationRequired, ErrProposalNotFound, ErrInvalidProposalComment
+	Comment(ctx context.Context, id int32, body string) (*ProposalComment, error)
+
+	// Approve applies a submitted proposal through CompetencyUseCase, credited to the proposer, and marks it
+	// approved, in one transaction; a non-empty comment is added to it. A change that can't be applied leaves
+	// the proposal submitted
+	// Possible errors: ErrAuthenticationRequired, ErrForbidden, ErrProposalNotFound, ErrProposalStatusConflict,
+	// ErrInvalidProposalComment, and those of CompetencyUseCase.Create, Rename, UpdateDescription and SetCategory
+	Approve(ctx context.Context, id int32, comment string) (*CompetencyProposal, error)
+
+	// Reject marks a submitted proposal rejected, with a comment telling the proposer why
+	// Possible errors: ErrAuthenticationRequired, ErrForbidden, ErrProposalNotFound, ErrProposalStatusConflict,
+	// ErrInvalidProposalComment
+	Reject(ctx context.Context, id int32, comment string) (*CompetencyProposal, error)
+}
@@ -0,0 +1,106 @@
+package domain
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Limits of the prerequisite graph
+const MaxLearningTargets = 50
+
+// RelationKind is the kind of an edge between two competencies
+type RelationKind string
+
+const (
+	RelationRequires RelationKind = "requires" // The competency requires the other one first; the edges form no cycle
+	RelationRelated  RelationKind = "related"  // Symmetric
+)
+
+// Valid reports whether k is a known relation kind
+func (k RelationKind) Valid() bool {
+	return k == RelationRequires || k == RelationRelated
+}
+
+// CompetencyNode is a competency in the prerequisite graph
+type CompetencyNode struct {
+	ID    int32
+	Name  string
+	Depth int32 // Steps from the competencies asked about, in prerequisite listings (1 for direct prerequisites)
+}
+
+// CompetencyEdge is an edge of the prerequisite graph
+// A "requires" edge goes from the competency to its prerequisite; a "related" edge has the lower ID in From
+type CompetencyEdge struct {
+	From int32
+	To   int32
+	Kind RelationKind
+}
+
+// NewCompetencyEdge returns the edge of kind between a competency and another one, related pairs in stored order
+func NewCompetencyEdge(competencyID, otherID int32, kind RelationKind) CompetencyEdge {
+	if kind == RelationRelated && otherID < competencyID {
+		competencyID, otherID = otherID, competencyID
+	}
+	return CompetencyEdge{From: competencyID, To: otherID, Kind: kind}
+}
+
+// CompetencyRelations are the competencies directly linked to a competency, ordered by name
+type CompetencyRelations struct {
+	Requires   []*CompetencyNode
+	RequiredBy []*CompetencyNode
+	Related    []*CompetencyNode
+}
+
+// CompetencyGraph is the prerequisite graph: competencies with at least one edge, and the edges
+type CompetencyGraph struct {
+	Nodes []*CompetencyNode // Ordered by name
+	Edges []CompetencyEdge
+}
+
+// LearningStep is a competency of a learning order
+type LearningStep struct {
+	Competency *CompetencyNode
+	Target     bool // Asked for, rather than only needed by a target
+}
+
+// SortLearningOrder orders nodes so every competency comes after the ones it requires (a topological order)
+// Among competencies that can come next, the one with the lowest name comes first, so the order is stable
+// requires are the "requires" edges between nodes; edges leaving nodes are ignored
+func SortLearningOrder(nodes []*CompetencyNode, requires []CompetencyEdge) []*CompetencyNode {
+	byID := make(map[int32]*CompetencyNode, len(nodes))
+	for _, node := range nodes {
+		byID[node.ID] = node
+	}
+	missing := make(map[int32]int, len(nodes))        // Prerequisites not placed yet
+	dependents := make(map[int32][]int32, len(nodes)) // Competencies requiring each one
+	for _, edge := range requires {
+		if byID[edge.From] == nil || byID[edge.To] == nil {
+			continue
+		}
+		missing[edge.From]++
+		dependents[edge.To] = append(dependents[edge.To], edge.From)
+	}
+
+	byName := func(a, b *CompetencyNode) int {
+		return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.ID, b.ID))
+	}
+	ready := make([]*CompetencyNode, 0, len(nodes))
+	for _, node := range nodes {
+		if missing[node.ID] == 0 {
+			ready = append(ready, node)
+		}
+	}
+	order := make([]*CompetencyNode, 0, len(nodes))
+	for len(ready) > 0 {
+		slices.SortFunc(ready, byName)
+		next := ready[0]
+		ready = ready[1:]
+		order = append(order, next)
+		for _, id := range dependents[next.ID] {
+			if missing[id]--; missing[id] == 0 {
+				ready = append(ready, byID[id])
+			}
+		}
+	}
+	return order
+}
@@ -0,0 +1,36 @@
+package domain
+
+import "context"
+
+// RelationRepository defines the contract for prerequisite graph data access
+type RelationRepository interface {
+	// Lock blocks changes to the relations by other transactions until the transaction of ctx ends
+	Lock(ctx context.Context) error
+
+	// Add adds an edge; adding an existing edge changes nothing
+	// Returns domain.ErrCompetencyNotFound if a competency of the edge doesn't exist
+	Add(ctx context.Context, edge CompetencyEdge) error
+
+	// Remove removes an edge
+	// Returns domain.ErrRelationNotFound if there is no such edge
+	Remove(ctx context.Context, edge CompetencyEdge) error
+
+	// IsPrerequisite reports whether competencyID requires candidateID directly or transitively, or is candidateID
+	IsPrerequisite(ctx context.Context, competencyID, candidateID int32) (bool, error)
+
+	// GetRelations retrieves the competencies directly linked to a competency
+	GetRelations(ctx context.Context, competencyID int32) (*CompetencyRelations, error)
+
+	// GetPrerequisites retrieves the competencies required by those of ids, directly or transitively,
+	// with the fewest steps to reach them, nearest first
+	GetPrerequisites(ctx context.Context, ids []int32) ([]*CompetencyNode, error)
+
+	// GetRequiresEdges retrieves the "requires" edges leaving the competencies of ids
+	GetRequiresEdges(ctx context.Context, ids []int32) ([]CompetencyEdge, error)
+
+	// GetNodes retrieves the competencies of ids, ordered by name; unknown IDs are left out
+	GetNodes(ctx context.Context, ids []int32) ([]*CompetencyNode, error)
+
+	// GetGraph retrieves the edges between competencies that aren't archived, and those competencies
+	GetGraph(ctx context.Context) (*CompetencyGraph, error)
+}
@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"slices"
+	"testing"
+)
+
+// TestSortLearningOrder checks that every competency comes after its prerequisites, ties broken by name
+func TestSortLearningOrder(t *testing.T) {
+	node := func(id int32, name string) *CompetencyNode {
+		return &CompetencyNode{ID: id, Name: name}
+	}
+	requires := func(from, to int32) CompetencyEdge {
+		return CompetencyEdge{From: from, To: to, Kind: RelationRequires}
+	}
+
+	tests := []struct {
+		name     string
+		nodes    []*CompetencyNode
+		requires []CompetencyEdge
+		want     []int32
+	}{
+		{
+			name: "empty",
+			want: []int32{},
+		},
+		{
+			name:  "no edges: ordered by name",
+			nodes: []*CompetencyNode{node(1, "SQL"), node(2, "Go"), node(3, "Docker")},
+			want:  []int32{3, 2, 1},
+		},
+		{
+			name:     "chain",
+			nodes:    []*CompetencyNode{node(1, "Kubernetes"), node(2, "Docker"), node(3, "Linux")},
+			requires: []CompetencyEdge{requires(1, 2), requires(2, 3)},
+			want:     []int32{3, 2, 1},
+		},
+		{
+			name:     "prerequisite placed before a lower name",
+			nodes:    []*CompetencyNode{node(1, "A"), node(2, "Z")},
+			requires: []CompetencyEdge{requires(1, 2)},
+			want:     []int32{2, 1},
+		},
+		{
+			name:     "diamond",
+			nodes:    []*CompetencyNode{node(1, "Top"), node(2, "Left"), node(3, "Right"), node(4, "Base")},
+			requires: []CompetencyEdge{requires(1, 2), requires(1, 3), requires(2, 4), requires(3, 4)},
+			want:     []int32{4, 2, 3, 1},
+		},
+		{
+			name:  "equal names ordered by ID",
+			nodes: []*CompetencyNode{node(9, "Go"), node(4, "Go")},
+			want:  []int32{4, 9},
+		},
+		{
+			name:     "edges to competencies outside nodes are ignored",
+			nodes:    []*CompetencyNode{node(1, "B"), node(2, "A")},
+			requires: []CompetencyEdge{requires(1, 99), requires(99, 2)},
+			want:     []int32{2, 1},
+		},
+		{
+			name:     "competencies on a cycle are left out",
+			nodes:    []*CompetencyNode{node(1, "A"), node(2, "B"), node(3, "C")},
+			requires: []CompetencyEdge{requires(1, 2), requires(2, 1)},
+			want:     []int32{3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := SortLearningOrder(tt.nodes, tt.requires)
+			got := make([]int32, len(order))
+			for i, node := range order {
+				got[i] = node.ID
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("SortLearningOrder() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestNewCompetencyEdge checks that related pairs are stored lower ID first and prerequisites as given
+func TestNewCompetencyEdge(t *testing.T) {
+	tests := []struct {
+		name                  string
+		competencyID, otherID int32
+		kind                  RelationKind
+		want                  CompetencyEdge
+	}{
+		{name: "requires", competencyID: 5, otherID: 2, kind: RelationRequires, want: CompetencyEdge{From: 5, To: 2, Kind: RelationRequires}},
+		{name: "related in order", competencyID: 2, otherID: 5, kind: RelationRelated, want: CompetencyEdge{From: 2, To: 5, Kind: RelationRelated}},
+		{name: "related swapped", competencyID: 5, otherID: 2, kind: RelationRelated, want: CompetencyEdge{From: 2, To: 5, Kind: RelationRelated}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewCompetencyEdge(tt.competencyID, tt.otherID, tt.kind); got != tt.want {
+				t.Errorf("NewCompetencyEdge() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package domain
+
+import "context"
+
+// RelationUseCase defines the contract for prerequisite graph operations
+type RelationUseCase interface {
+	// GetRelations retrieves the competencies directly linked to a competency
+	// Possible errors: ErrCompetencyNotFound
+	GetRelations(ctx context.Context, competencyID int32) (*CompetencyRelations, error)
+
+	// Relate adds an edge of kind from a competency to another one; adding an existing edge changes nothing
+	// A "requires" edge is refused when the other competency already requires this one, directly or not
+	// Admins and stewards of the competency only
+	// Possible errors: ErrAuthenticationRequired, ErrForbidden, ErrInvalidRelation, ErrCompetencyNotFound, ErrRelationCycle
+	Relate(ctx context.Context, competencyID, otherID int32, kind RelationKind) (*CompetencyRelations, error)
+
+	// Unrelate removes an edge of kind from a competency to another one; admins and stewards of the competency only
+	// Possible errors: ErrAuthenticationRequired, ErrForbidden, ErrInvalidRelation, ErrRelationNotFound
+	Unrelate(ctx context.Context, competencyID, otherID int32, kind RelationKind) error
+
+	// GetPrerequisites retrieves the competencies a competency requires, directly or transitively, nearest first
+	// Possible errors: ErrCompetencyNotFound
+	GetPrerequisites(ctx context.Context, competencyID int32) ([]*CompetencyNode, error)
+
+	// GetLearningOrder orders the targets and everything they require so prerequisites come first
+	// Possible errors: ErrInvalidLearningTargets, ErrCompetencyNotFound
+	GetLearningOrder(ctx context.Context, targetIDs []int32) ([]*LearningStep, error)
+
+	// GetGraph retrieves the prerequisite graph of the competencies that aren't archived
+	GetGraph(ctx context.Context) (*CompetencyGraph, error)
+}
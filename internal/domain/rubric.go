@@ -0,0 +1,73 @@
+package domain
+
+import "time"
+
+// Limits of rating scales and competency rubrics
+const (
+	MaxRatingScaleLevels            = 10
+	MaxLevelIndicators              = 20
+	MaxLevelDescriptionLength       = 2000
+	MaxLevelIndicatorLength         = 500
+	MaxRatingLevelLabelLength       = 100
+	MaxRatingScaleDescriptionLength = 1000
+)
+
+// RatingScale is a configurable set of proficiency levels competencies are assessed against,
+// e.g. 1 Aware, 2 Practitioner, 3 Advanced, 4 Expert
+type RatingScale struct {
+	ID          int32
+	Name        string // Unique (case-insensitive)
+	Description string
+	Levels      []RatingLevel // Ordered by value
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Level returns the level of the scale with value
+func (s *RatingScale) Level(value int32) (RatingLevel, bool) {
+	for _, level := range s.Levels {
+		if level.Value == value {
+			return level, true
+		}
+	}
+	return RatingLevel{}, false
+}
+
+// RatingLevel is one level of a rating scale
+type RatingLevel struct {
+	Value int32 // Positive, higher is more proficient
+	Label string
+}
+
+// RatingScaleSpec holds the fields of a rating scale to create or update
+type RatingScaleSpec struct {
+	Name        string
+	Description string
+	Levels      []RatingLevel
+}
+
+// CompetencyRubric is the value object describing how a competency is assessed:
+// what every level of its rating scale means for it
+type CompetencyRubric struct {
+	Scale     *RatingScale
+	Levels    []CompetencyLevel // One per level of the scale, in order; undescribed levels have no description nor indicators
+	UpdatedAt time.Time
+}
+
+// CompetencyLevel describes one level of a rating scale for a competency
+type CompetencyLevel struct {
+	Value       int32
+	Label       string   // Label of the level in the scale; ignored on input
+	Description string   // What the level means for the competency
+	Indicators  []string // Observable behaviors showing the level, in display order
+}
+
+// IsDescribed checks if the level has a description or indicators
+func (l *CompetencyLevel) IsDescribed() bool {
+	return l.Description != "" || len(l.Indicators) > 0
+}
+
+// CompetencyInclude selects the related data embedded in a competency read
+type CompetencyInclude struct {
+	Levels bool // Competency.Rubric
+}
@@ -0,0 +1,59 @@
+package domain
+
+import "context"
+
+// RubricRepository defines the contract for rating scale and competency rubric data access
+type RubricRepository interface {
+	// CreateScale creates a rating scale without levels (see SetScaleLevels)
+	// Returns domain.ErrRatingScaleAlreadyExists if another scale has the name
+	CreateScale(ctx context.Context, name, description string) (*RatingScale, error)
+
+	// GetScale retrieves a rating scale with its levels
+	// Returns domain.ErrRatingScaleNotFound if the scale doesn't exist
+	GetScale(ctx context.Context, id int32) (*RatingScale, error)
+
+	// GetScales retrieves every rating scale with its levels, ordered by name
+	GetScales(ctx context.Context) ([]*RatingScale, error)
+
+	// UpdateScale updates the name and description of a rating scale
+	// Returns domain.ErrRatingScaleNotFound if the scale doesn't exist
+	// Returns domain.ErrRatingScaleAlreadyExists if another scale has the name
+	UpdateScale(ctx context.Context, id int32, name, description string) (*RatingScale, error)
+
+	// SetScaleLevels makes levels the levels of a scale: missing ones are added, existing ones relabelled, others removed
+	// Returns domain.ErrRatingScaleInUse if a competency describes a removed level
+	SetScaleLevels(ctx context.Context, scaleID int32, levels []RatingLevel) error
+
+	// DeleteScale deletes a rating scale with its levels
+	// Returns domain.ErrRatingScaleNotFound if the scale doesn't exist
+	// Returns domain.ErrRatingScaleInUse if a competency rubric uses it
+	DeleteScale(ctx context.Context, id int32) error
+
+	// GetRubric retrieves the rubric of a competency, with every level of its scale
+	// Returns domain.ErrRubricNotFound if the competency has no rubric
+	GetRubric(ctx context.Context, competencyID int32) (*CompetencyRubric, error)
+
+	// SetRubricScale creates the rubric of a competency or changes its scale
+	// The level descriptions must be deleted first when the scale changes
+	SetRubricScale(ctx context.Context, competencyID, scaleID int32) error
+
+	// SetRubricLevel creates or replaces the description of one level of a competency rubric using scaleID
+	SetRubricLevel(ctx context.Context, competencyID, scaleID int32, level CompetencyLevel) error
+
+	// DeleteRubricLevels deletes every level description of a competency rubric
+	DeleteRubricLevels(ctx context.Context, competencyID int32) error
+
+	// DeleteRubricLevel deletes the description of one level of a competency rubric
+	// Returns domain.ErrCompetencyLevelNotFound if the level isn't described
+	DeleteRubricLevel(ctx context.Context, competencyID, value int32) error
+
+	// DeleteRubric deletes the rubric of a competency with its level descriptions
+	// Returns domain.ErrRubricNotFound if the competency has no rubric
+	DeleteRubric(ctx context.Context, competencyID int32) error
+
+	// TouchCompetency bumps the version of a competency whose rubric changed
+	TouchCompetency(ctx context.Context, competencyID int32) error
+
+	// TouchScaleCompetencies bumps the version of the competencies whose rubric uses a scale that changed
+	TouchScaleCompetencies(ctx context.Context, scaleID int32) error
+}
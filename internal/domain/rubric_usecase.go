@@ -0,0 +1,53 @@
+package domain
+
+import "context"
+
+// RubricUseCase defines the contract for rating scales and competency rubrics
+// Rubric changes bump the version of their competency, since the rubric is part of it (?include=levels)
+// Rubric changes are allowed to admins and to the stewards of the competency; rating scales to admins only
+type RubricUseCase interface {
+	// CreateScale creates a rating scale with 1 to MaxRatingScaleLevels levels of distinct positive values; admins only
+	// Possible errors: ErrAuthenticationRequired, ErrForbidden, ErrInvalidRatingScale, ErrRatingScaleAlreadyExists
+	CreateScale(ctx context.Context, spec RatingScaleSpec) (*RatingScale, error)
+
+	// GetScale retrieves a rating scale with its levels
+	// Possible errors: ErrRatingScaleNotFound
+	GetScale(ctx context.Context, id int32) (*RatingScale, error)
+
+	// GetScales retrieves every rating scale, ordered by name
+	GetScales(ctx context.Context) ([]*RatingScale, error)
+
+	// UpdateScale replaces the name, description and levels of a rating scale
+	// Levels that competencies describe can be relabelled but not removed
+	// Possible errors: ErrAuthenticationRequired, ErrForbidden, ErrInvalidRatingScale, ErrRatingScaleNotFound,
+	// ErrRatingScaleAlreadyExists, ErrRatingScaleInUse
+	UpdateScale(ctx context.Context, id int32, spec RatingScaleSpec) (*RatingScale, error)
+
+	// DeleteScale deletes a rating scale; it is refused while a competency rubric uses it; admins only
+	// Possible errors: ErrAuthenticationRequired, ErrForbidden, ErrRatingScaleNotFound, ErrRatingScaleInUse
+	DeleteScale(ctx context.Context, id int32) error
+
+	// GetRubric retrieves the rubric of a competency, with its level texts in the locale of ctx
+	// Possible errors: ErrCompetencyNotFound, ErrRubricNotFound
+	GetRubric(ctx context.Context, competencyID int32) (*CompetencyRubric, error)
+
+	// SetRubric replaces the rubric of a competency: its scale and the descriptions of its levels
+	// Levels must be levels of the scale, each at most once; levels left out are undescribed
+	// Possible errors: ErrAuthenticationRequired, ErrForbidden, ErrCompetencyNotFound, ErrRatingScaleNotFound,
+	// ErrInvalidCompetencyLevel, ErrUnknownRatingLevel
+	SetRubric(ctx context.Context, competencyID, scaleID int32, levels []CompetencyLevel) (*CompetencyRubric, error)
+
+	// SetLevel creates or replaces the description of one level of an existing rubric
+	// Possible errors: ErrAuthenticationRequired, ErrForbidden, ErrCompetencyNotFound, ErrRubricNotFound,
+	// ErrInvalidCompetencyLevel, ErrUnknownRatingLevel
+	SetLevel(ctx context.Context, competencyID int32, level CompetencyLevel) (*CompetencyRubric, error)
+
+	// DeleteLevel deletes the description of one level of a rubric
+	// Possible errors: ErrAuthenticationRequired, ErrForbidden, ErrCompetencyNotFound, ErrRubricNotFound,
+	// ErrCompetencyLevelNotFound
+	DeleteLevel(ctx context.Context, competencyID, value int32) error
+
+	// DeleteRubric deletes the rubric of a competency
+	// Possible errors: ErrAuthenticationRequired, ErrForbidden, ErrCompetencyNotFound, ErrRubricNotFound
+	DeleteRubric(ctx context.Context, competencyID int32) error
+}
@@ -0,0 +1,59 @@
+package domain
+
+import "time"
+
+// Limits of stale-content reports and notifications
+const (
+	MaxReportReasonLength             = 1000
+	DefaultReportPageSize       int32 = 50
+	MaxReportPageSize           int32 = 200
+	DefaultNotificationPageSize int32 = 50
+	MaxNotificationPageSize     int32 = 200
+)
+
+// CompetencySteward is a user responsible for keeping a competency current
+// Stewards can edit their competencies without being admins
+type CompetencySteward struct {
+	CompetencyID int32
+	UserID       int32
+	AssignedBy   *int32 // Admin who assigned the steward, nil once deleted
+	AssignedAt   time.Time
+}
+
+// CompetencyReport is a report that a competency is outdated, sent to its stewards
+type CompetencyReport struct {
+	ID           int32
+	CompetencyID int32
+	ReporterID   *int32 // nil once the reporter is deleted
+	Reason       string
+	CreatedAt    time.Time
+}
+
+// NotificationKind tells what a notification is about
+type NotificationKind string
+
+const (
+	NotificationProposalSubmitted NotificationKind = "proposal_submitted" // An edit of the competency was submitted
+	NotificationStaleReport       NotificationKind = "stale_report"       // The competency was reported outdated
+)
+
+// Notification is a message in the inbox of a user
+type Notification struct {
+	ID           int32
+	UserID       int32
+	Kind         NotificationKind
+	CompetencyID int32
+	ProposalID   *int32 // Set for NotificationProposalSubmitted
+	ReportID     *int32 // Set for NotificationStaleReport
+	ReadAt       *time.Time
+	CreatedAt    time.Time
+}
+
+// StewardNotice describes what the stewards of a competency are notified of
+type StewardNotice struct {
+	Kind         NotificationKind
+	CompetencyID int32
+	ProposalID   *int32
+	ReportID     *int32
+	SenderID     *int32 // User causing the notice, who isn't notified
+}
@@ -0,0 +1,30 @@
+package domain
+
+import "context"
+
+// StewardRepository defines the contract for steward assignments and stale-content reports
+type StewardRepository interface {
+	// Assign makes a user a steward of a competency; assigning a steward twice is a no-op
+	// Possible errors: ErrCompetencyNotFound, ErrUserNotFound
+	Assign(ctx context.Context, competencyID, userID int32, assignedBy *int32) error
+
+	// Unassign removes a steward of a competency
+	// Possible errors: ErrStewardNotFound
+	Unassign(ctx context.Context, competencyID, userID int32) error
+
+	// GetByCompetency retrieves the stewards of a competency, first assigned first
+	GetByCompetency(ctx context.Context, competencyID int32) ([]*CompetencySteward, error)
+
+	// IsSteward reports whether a user is a steward of a competency
+	IsSteward(ctx context.Context, competencyID, userID int32) (bool, error)
+
+	// GetStewarded retrieves the competencies a user is a steward of, by name
+	GetStewarded(ctx context.Context, userID int32) ([]*Competency, error)
+
+	// CreateReport records that a competency is outdated
+	// Possible errors: ErrCompetencyNotFound
+	CreateReport(ctx context.Context, competencyID int32, reporterID *int32, reason string) (*CompetencyReport, error)
+
+	// GetReports retrieves the latest reports of a competency, newest first
+	GetReports(ctx context.Context, competencyID, limit int32) ([]*CompetencyReport, error)
+}
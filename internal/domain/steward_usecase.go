@@ -0,0 +1,33 @@
+package domain
+
+import "context"
+
+// StewardUseCase defines the contract for competency stewards and stale-content reports
+// Edits of a competency (CompetencyUseCase, RubricUseCase and TranslationUseCase) are allowed to admins and
+// to its stewards
+type StewardUseCase interface {
+	// Assign makes a user a steward of a competency and returns its stewards; admins only
+	// Possible errors: ErrAuthenticationRequired, ErrForbidden, ErrCompetencyNotFound, ErrUserNotFound
+	Assign(ctx context.Context, competencyID, userID int32) ([]*CompetencySteward, error)
+
+	// Unassign removes a steward of a competency; admins only
+	// Possible errors: ErrAuthenticationRequired, ErrForbidden, ErrStewardNotFound
+	Unassign(ctx context.Context, competencyID, userID int32) error
+
+	// GetStewards retrieves the stewards of a competency, first assigned first
+	// Possible errors: ErrCompetencyNotFound
+	GetStewards(ctx context.Context, competencyID int32) ([]*CompetencySteward, error)
+
+	// GetStewarded retrieves the competencies the user is a steward of, by name, in the locale of ctx
+	// Possible errors: ErrAuthenticationRequired
+	GetStewarded(ctx context.Context) ([]*Competency, error)
+
+	// Report records that a competency is outdated and notifies its stewards, in one transaction
+	// Possible errors: ErrAuthenticationRequired, ErrInvalidReport, ErrCompetencyNotFound
+	Report(ctx context.Context, competencyID int32, reason string) (*CompetencyReport, error)
+
+	// GetReports retrieves the latest reports of a competency, newest first; admins and its stewards only
+	// A non-positive limit means DefaultReportPageSize, and it is capped at MaxReportPageSize
+	// Possible errors: ErrAuthenticationRequired, ErrForbidden
+	GetReports(ctx context.Context, competencyID, limit int32) ([]*CompetencyReport, error)
+}
@@ -0,0 +1,42 @@
+package domain
+
+import "time"
+
+// Limits of tags
+const (
+	MaxTagNameLength         = 50
+	MaxTagsPerRequest        = 20
+	DefaultTagPageSize int32 = 100
+	MaxTagPageSize     int32 = 500
+)
+
+// Tag labels competencies across categories, e.g. "go", "cloud" or "soft-skill"
+// Free-form tags are created by tagging a competency; curated ones are managed by admins
+type Tag struct {
+	ID         int32
+	Name       string // Unique (case-insensitive)
+	Curated    bool
+	UsageCount int64 // Competencies carrying the tag, archived ones excluded
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// TagFilter narrows a tag listing; zero fields don't filter
+type TagFilter struct {
+	NamePrefix string // Case-insensitive prefix of the name
+	Curated    *bool  // Only curated (true) or free-form (false) tags
+	Limit      int32  // Defaults to DefaultTagPageSize
+}
+
+// TagMatch tells whether a competency filtered by several tags needs any or all of them
+type TagMatch string
+
+const (
+	TagMatchAny TagMatch = "any"
+	TagMatchAll TagMatch = "all"
+)
+
+// Valid reports whether m is a known match mode
+func (m TagMatch) Valid() bool {
+	return m == TagMatchAny || m == TagMatchAll
+}
@@ -0,0 +1,41 @@
+package domain
+
+import "context"
+
+// TagRepository defines the contract for tag data access
+type TagRepository interface {
+	// Create creates a tag
+	// Returns domain.ErrTagAlreadyExists if a tag has the name
+	Create(ctx context.Context, name string, curated bool) (*Tag, error)
+
+	// GetByID retrieves a tag with its usage count
+	// Returns domain.ErrTagNotFound if the tag doesn't exist
+	GetByID(ctx context.Context, id int32) (*Tag, error)
+
+	// GetAll retrieves the tags matching filter with their usage counts, most used first
+	GetAll(ctx context.Context, filter TagFilter) ([]*Tag, error)
+
+	// Update renames a tag and sets whether it is curated
+	// Returns domain.ErrTagNotFound if the tag doesn't exist
+	// Returns domain.ErrTagAlreadyExists if another tag has the name
+	Update(ctx context.Context, id int32, name string, curated bool) (*Tag, error)
+
+	// Delete deletes a tag and removes it from its competencies
+	// Returns domain.ErrTagNotFound if the tag doesn't exist
+	Delete(ctx context.Context, id int32) error
+
+	// Ensure retrieves the tags with the given names, creating the missing ones as free-form tags
+	// Usage counts aren't loaded
+	Ensure(ctx context.Context, names []string) ([]*Tag, error)
+
+	// GetByCompetency retrieves the tags of a competency by name, with their usage counts
+	GetByCompetency(ctx context.Context, competencyID int32) ([]*Tag, error)
+
+	// AddToCompetency adds tags to a competency; tags it already carries are left as they are
+	// Returns domain.ErrCompetencyNotFound if the competency doesn't exist
+	AddToCompetency(ctx context.Context, competencyID int32, tagIDs []int32) error
+
+	// RemoveFromCompetency removes a tag from a competency
+	// Returns domain.ErrTagNotFound if the competency doesn't carry the tag
+	RemoveFromCompetency(ctx context.Context, competencyID, tagID int32) error
+}
@@ -0,0 +1,42 @@
+package domain
+
+import "context"
+
+// TagUseCase defines the contract for tag operations
+// Signed-in users tag competencies, creating free-form tags; curated tags are attached and removed by admins and
+// stewards of the competency only, and managing tags directly is reserved to admins
+type TagUseCase interface {
+	// Create creates a tag; admins only
+	// Possible errors: ErrAuthenticationRequired, ErrForbidden, ErrInvalidTagName, ErrTagAlreadyExists
+	Create(ctx context.Context, name string, curated bool) (*Tag, error)
+
+	// GetByID retrieves a tag with its usage count
+	// Possible errors: ErrTagNotFound
+	GetByID(ctx context.Context, id int32) (*Tag, error)
+
+	// GetAll retrieves the tags matching filter with their usage counts, most used first
+	GetAll(ctx context.Context, filter TagFilter) ([]*Tag, error)
+
+	// Update renames a tag and/or sets whether it is curated (nil fields are left as they are); admins only
+	// Possible errors: ErrAuthenticationRequired, ErrForbidden, ErrInvalidTagName, ErrTagNotFound, ErrTagAlreadyExists
+	Update(ctx context.Context, id int32, name *string, curated *bool) (*Tag, error)
+
+	// Delete deletes a tag and removes it from its competencies; admins only
+	// Possible errors: ErrAuthenticationRequired, ErrForbidden, ErrTagNotFound
+	Delete(ctx context.Context, id int32) error
+
+	// GetCompetencyTags retrieves the tags of a competency by name
+	// Possible errors: ErrCompetencyNotFound
+	GetCompetencyTags(ctx context.Context, competencyID int32) ([]*Tag, error)
+
+	// TagCompetency adds tags to a competency by name, creating unknown names as free-form tags
+	// It returns every tag of the competency
+	// The user must be authenticated, and an admin or a steward of the competency to attach a curated tag
+	// Possible errors: ErrAuthenticationRequired, ErrForbidden, ErrInvalidTagName, ErrCompetencyNotFound
+	TagCompetency(ctx context.Context, competencyID int32, names []string) ([]*Tag, error)
+
+	// UntagCompetency removes a tag from a competency; the tag itself is kept
+	// The user must be authenticated, and an admin or a steward of the competency to remove a curated tag
+	// Possible errors: ErrAuthenticationRequired, ErrForbidden, ErrCompetencyNotFound, ErrTagNotFound
+	UntagCompetency(ctx context.Context, competencyID, tagID int32) error
+}
@@ -0,0 +1,11 @@
+package domain
+
+import "context"
+
+// Transactor runs operations atomically
+// Repositories called with the context passed to fn take part in the transaction
+type Transactor interface {
+	// WithinTx runs fn in a transaction, committed when fn returns nil and rolled back otherwise
+	// Calls nested in fn join the outer transaction
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,70 @@
+package domain
+
+import "time"
+
+// CompetencyTranslation holds the texts of a competency in a locale other than FallbackLocale
+type CompetencyTranslation struct {
+	CompetencyID int32
+	Locale       Locale
+	Name         string            // Unique per locale (case-insensitive), like competency names
+	Description  string            // Empty when not translated; the fallback description is shown then
+	Levels       []CompetencyLevel // Translated rubric levels, ordered by value; labels are unused
+	UpdatedAt    time.Time
+}
+
+// Level returns the translation of the rubric level with value
+func (t *CompetencyTranslation) Level(value int32) (CompetencyLevel, bool) {
+	for _, level := range t.Levels {
+		if level.Value == value {
+			return level, true
+		}
+	}
+	return CompetencyLevel{}, false
+}
+
+// Localize replaces the texts of c, and of its rubric when loaded, with their translation t
+// Texts t leaves empty keep their fallback value; c.Locale becomes the locale of its name
+func (c *Competency) Localize(t *CompetencyTranslation) {
+	if t == nil {
+		c.Locale = FallbackLocale
+		return
+	}
+	c.Locale = t.Locale
+	c.Name = t.Name
+	if t.Description != "" {
+		c.Description = t.Description
+	}
+	if c.Rubric != nil {
+		c.Rubric.Localize(t)
+	}
+}
+
+// Localize replaces the description and indicators of the described levels of r with their translation t
+func (r *CompetencyRubric) Localize(t *CompetencyTranslation) {
+	if t == nil {
+		return
+	}
+	for i := range r.Levels {
+		level := &r.Levels[i]
+		translated, ok := t.Level(level.Value)
+		if !ok || !level.IsDescribed() {
+			continue
+		}
+		if translated.Description != "" {
+			level.Description = translated.Description
+		}
+		if len(translated.Indicators) > 0 {
+			level.Indicators = translated.Indicators
+		}
+	}
+}
+
+// MissingTranslation lists the texts of a competency that have no translation in a locale
+type MissingTranslation struct {
+	CompetencyID       int32
+	Name               string // Fallback name
+	Locale             Locale
+	MissingName        bool    // No translation at all
+	MissingDescription bool    // The competency has a description but the translation hasn't
+	MissingLevels      []int32 // Described rubric levels without translation, ordered by value
+}
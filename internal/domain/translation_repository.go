@@ -0,0 +1,34 @@
+package domain
+
+import "context"
+
+// TranslationRepository defines the contract for competency translation data access
+type TranslationRepository interface {
+	// GetAll retrieves the translations of a competency in every locale, ordered by locale
+	GetAll(ctx context.Context, competencyID int32) ([]*CompetencyTranslation, error)
+
+	// GetByLocale retrieves the translations into locale of the competencies of ids, by competency ID
+	// Competencies without translation are left out
+	GetByLocale(ctx context.Context, locale Locale, ids []int32) (map[int32]*CompetencyTranslation, error)
+
+	// Set creates or replaces the translation of a competency into translation.Locale, with its levels
+	// Returns domain.ErrCompetencyNotFound if the competency doesn't exist
+	// Returns domain.ErrRubricNotFound if levels are given and the competency has no rubric
+	// Returns domain.ErrCompetencyAlreadyExists if another competency has the name in the locale
+	Set(ctx context.Context, translation CompetencyTranslation) (*CompetencyTranslation, error)
+
+	// Delete deletes the translation of a competency into locale, with its levels
+	// Returns domain.ErrTranslationNotFound if the competency has no translation into locale
+	Delete(ctx context.Context, competencyID int32, locale Locale) error
+
+	// GetNameConflicts retrieves the locales among locales where another competency than excludeID shows name:
+	// as its translation, or as its own name when it has no translation there
+	GetNameConflicts(ctx context.Context, name string, locales []Locale, excludeID int32) ([]Locale, error)
+
+	// GetMissing retrieves the competencies that aren't archived and lack translations into locales,
+	// ordered by locale then name
+	GetMissing(ctx context.Context, locales []Locale) ([]*MissingTranslation, error)
+
+	// TouchCompetency bumps the version of a competency whose translations changed
+	TouchCompetency(ctx context.Context, competencyID int32) error
+}
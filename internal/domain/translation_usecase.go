@@ -0,0 +1,29 @@
+package domain
+
+import "context"
+
+// TranslationUseCase defines the contract for managing competency translations
+// The translations of a competency are managed by admins and its stewards; the missing translations report is for admins
+// Competencies are read in the locale of the request (see ContextWithLocale) by CompetencyUseCase and RubricUseCase
+// Translation changes bump the version of their competency, since they are part of its localized representations
+type TranslationUseCase interface {
+	// GetTranslations retrieves the translations of a competency in every locale
+	// Possible errors: ErrAuthenticationRequired, ErrForbidden, ErrCompetencyNotFound
+	GetTranslations(ctx context.Context, competencyID int32) ([]*CompetencyTranslation, error)
+
+	// SetTranslation creates or replaces the translation of a competency into a locale other than FallbackLocale
+	// Its name is validated like competency names and must not be shown by another competency in the locale;
+	// its levels must be described levels of the competency rubric, each at most once
+	// Possible errors: ErrAuthenticationRequired, ErrForbidden, ErrUnsupportedLocale, ErrInvalidTranslation,
+	// ErrInvalidCompetencyLevel, ErrCompetencyNotFound, ErrRubricNotFound, ErrCompetencyLevelNotFound, ErrCompetencyAlreadyExists
+	SetTranslation(ctx context.Context, translation CompetencyTranslation) (*CompetencyTranslation, error)
+
+	// DeleteTranslation deletes the translation of a competency into a locale
+	// Possible errors: ErrAuthenticationRequired, ErrForbidden, ErrUnsupportedLocale, ErrTranslationNotFound
+	DeleteTranslation(ctx context.Context, competencyID int32, locale Locale) error
+
+	// GetMissing reports the competencies that aren't archived and lack translations into locale,
+	// or into any locale but the fallback one when locale is empty
+	// Possible errors: ErrAuthenticationRequired, ErrForbidden, ErrUnsupportedLocale
+	GetMissing(ctx context.Context, locale Locale) ([]*MissingTranslation, error)
+}
@@ -0,0 +1,64 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+)
+
+// ContextHandler is a slog.Handler that enriches records with request-scoped attributes
+// Every *Context logging call (InfoContext, ErrorContext, ...) gets:
+//   - request_id: set by the request ID middleware
+//   - user_id: the authenticated user, if any
+//   - route: the matched chi route pattern, e.g. /api/v1/competencies/{id}
+//
+// Calls without a context (Info, Error, ...) are passed through unchanged
+type ContextHandler struct {
+	next slog.Handler
+}
+
+// NewContextHandler wraps next with request-scoped attributes
+func NewContextHandler(next slog.Handler) *ContextHandler {
+	return &ContextHandler{next: next}
+}
+
+// Enabled reports whether the wrapped handler handles records at the given level
+func (h *ContextHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+// Handle adds the request-scoped attributes found in ctx and forwards the record
+func (h *ContextHandler) Handle(ctx context.Context, record slog.Record) error {
+	if ctx == nil {
+		return h.next.Handle(ctx, record)
+	}
+
+	if requestID := RequestIDFromContext(ctx); requestID != "" {
+		record.AddAttrs(slog.String("request_id", requestID))
+	}
+
+	if user, ok := domain.ActorFromContext(ctx); ok {
+		record.AddAttrs(slog.Int("user_id", int(user.ID)))
+	}
+
+	// The route pattern is filled in by chi while routing, so it is read lazily here
+	if routeCtx := chi.RouteContext(ctx); routeCtx != nil {
+		if route := routeCtx.RoutePattern(); route != "" {
+			record.AddAttrs(slog.String("route", route))
+		}
+	}
+
+	return h.next.Handle(ctx, record)
+}
+
+// WithAttrs returns a ContextHandler whose wrapped handler has the given attributes
+func (h *ContextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &ContextHandler{next: h.next.WithAttrs(attrs)}
+}
+
+// WithGroup returns a ContextHandler whose wrapped handler has the given group
+func (h *ContextHandler) WithGroup(name string) slog.Handler {
+	return &ContextHandler{next: h.next.WithGroup(name)}
+}
@@ -0,0 +1,14 @@
+package metrics
+
+import "expvar"
+
+// Process-wide counters published through expvar (served at /debug/vars)
+// For POC: expvar keeps metrics dependency-free
+// Production: export to Prometheus/OpenTelemetry instead
+var (
+	// PanicsTotal counts handler panics recovered by the recovery middleware
+	PanicsTotal = expvar.NewInt("http_panics_total")
+
+	// TimeoutsTotal counts requests that exceeded their deadline, keyed by route group
+	TimeoutsTotal = expvar.NewMap("http_timeouts_total")
+)
@@ -0,0 +1,253 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/mehrnoosh-hk/devnorth-back/db/sqlc"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+)
+
+// categoryRepository implements domain.CategoryRepository using SQLC
+type categoryRepository struct {
+	queries *sqlc.Queries
+	logger  *slog.Logger
+}
+
+// NewCategoryRepository creates a new instance of CategoryRepository
+func NewCategoryRepository(pool *pgxpool.Pool, logger *slog.Logger) (domain.CategoryRepository, error) {
+	if pool == nil {
+		return nil, ErrPoolNil
+	}
+	if logger == nil {
+		return nil, ErrLoggerNil
+	}
+	return &categoryRepository{
+		queries: sqlc.New(pool),
+		logger:  logger,
+	}, nil
+}
+
+// q returns the queries to run, in the transaction of ctx if there is one (see transactor)
+func (r *categoryRepository) q(ctx context.Context) *sqlc.Queries {
+	return queriesFromContext(ctx, r.queries)
+}
+
+// Create creates a category after its siblings
+func (r *categoryRepository) Create(ctx context.Context, parentID *int32, name string) (*domain.Category, error) {
+	r.logger.InfoContext(ctx, "creating category", "parent_id", parentID, "name", name)
+
+	sqlcCategory, err := r.q(ctx).CreateCategory(ctx, sqlc.CreateCategoryParams{
+		ParentID: toPgInt4(parentID),
+		Name:     name,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case "23505":
+				r.logger.WarnContext(ctx, "duplicate category name", "parent_id", parentID, "name", name)
+				return nil, domain.ErrCategoryAlreadyExists
+			case "23503":
+				r.logger.InfoContext(ctx, "parent category not found", "parent_id", parentID)
+				return nil, domain.ErrCategoryParentNotFound
+			}
+		}
+		r.logger.ErrorContext(ctx, "failed to create category", "error", err, "name", name)
+		return nil, fmt.Errorf("%w: %w", ErrCreateCategoryFailed, err)
+	}
+
+	r.logger.InfoContext(ctx, "category created successfully", "category_id", sqlcCategory.ID)
+	return toDomainCategory(sqlcCategory), nil
+}
+
+// GetByID retrieves a category by ID
+func (r *categoryRepository) GetByID(ctx context.Context, id int32) (*domain.Category, error) {
+	sqlcCategory, err := r.q(ctx).GetCategoryByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			r.logger.InfoContext(ctx, "category not found", "id", id)
+			return nil, domain.ErrCategoryNotFound
+		}
+		r.logger.ErrorContext(ctx, "failed to get category by ID", "error", err, "id", id)
+		return nil, fmt.Errorf("%w: %w", ErrGetCategoryByIDFailed, err)
+	}
+	return toDomainCategory(sqlcCategory), nil
+}
+
+// GetAll retrieves every category
+func (r *categoryRepository) GetAll(ctx context.Context) ([]*domain.Category, error) {
+	sqlcCategories, err := r.q(ctx).ListCategories(ctx)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to list categories", "error", err)
+		return nil, fmt.Errorf("%w: %w", ErrListCategoriesFailed, err)
+	}
+	return toDomainCategories(sqlcCategories), nil
+}
+
+// GetSubtree retrieves a category and its descendants
+func (r *categoryRepository) GetSubtree(ctx context.Context, id int32) ([]*domain.Category, error) {
+	sqlcCategories, err := r.q(ctx).ListCategorySubtree(ctx, id)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to list category subtree", "error", err, "id", id)
+		return nil, fmt.Errorf("%w: %w", ErrListCategoriesFailed, err)
+	}
+	return toDomainCategories(sqlcCategories), nil
+}
+
+// GetChildren retrieves the children of a category, or the root categories
+func (r *categoryRepository) GetChildren(ctx context.Context, parentID *int32) ([]*domain.Category, error) {
+	sqlcCategories, err := r.q(ctx).ListCategoryChildren(ctx, toPgInt4(parentID))
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to list category children", "error", err, "parent_id", parentID)
+		return nil, fmt.Errorf("%w: %w", ErrListCategoriesFailed, err)
+	}
+	return toDomainCategories(sqlcCategories), nil
+}
+
+// IsInSubtree reports whether candidateID is rootID or one of its descendants
+func (r *categoryRepository) IsInSubtree(ctx context.Context, rootID, candidateID int32) (bool, error) {
+	inSubtree, err := r.q(ctx).IsCategoryInSubtree(ctx, sqlc.IsCategoryInSubtreeParams{
+		RootID:      rootID,
+		CandidateID: candidateID,
+	})
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to check category subtree", "error", err, "root_id", rootID, "candidate_id", candidateID)
+		return false, fmt.Errorf("%w: %w", ErrCheckCategorySubtreeFailed, err)
+	}
+	return inSubtree, nil
+}
+
+// CountCompetencies returns the number of competencies directly under each category
+func (r *categoryRepository) CountCompetencies(ctx context.Context) (map[int32]int64, error) {
+	rows, err := r.q(ctx).CountCompetenciesByCategory(ctx)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to count category competencies", "error", err)
+		return nil, fmt.Errorf("%w: %w", ErrCountCategoryCompetenciesFailed, err)
+	}
+
+	counts := make(map[int32]int64, len(rows))
+	for _, row := range rows {
+		counts[row.CategoryID] = row.Competencies
+	}
+	return counts, nil
+}
+
+// LockTree locks the categories table until the end of the transaction
+func (r *categoryRepository) LockTree(ctx context.Context) error {
+	if err := r.q(ctx).LockCategories(ctx); err != nil {
+		r.logger.ErrorContext(ctx, "failed to lock categories", "error", err)
+		return fmt.Errorf("%w: %w", ErrLockCategoriesFailed, err)
+	}
+	return nil
+}
+
+// Rename renames a category
+func (r *categoryRepository) Rename(ctx context.Context, id int32, name string) (*domain.Category, error) {
+	r.logger.InfoContext(ctx, "renaming category", "id", id, "name", name)
+
+	sqlcCategory, err := r.q(ctx).RenameCategory(ctx, sqlc.RenameCategoryParams{ID: id, Name: name})
+	if err != nil {
+		return nil, r.updateError(ctx, err, id)
+	}
+
+	r.logger.InfoContext(ctx, "category renamed successfully", "category_id", sqlcCategory.ID)
+	return toDomainCategory(sqlcCategory), nil
+}
+
+// SetParent moves a category under another parent
+func (r *categoryRepository) SetParent(ctx context.Context, id int32, parentID *int32) (*domain.Category, error) {
+	r.logger.InfoContext(ctx, "setting category parent", "id", id, "parent_id", parentID)
+
+	sqlcCategory, err := r.q(ctx).SetCategoryParent(ctx, sqlc.SetCategoryParentParams{ID: id, ParentID: toPgInt4(parentID)})
+	if err != nil {
+		return nil, r.updateError(ctx, err, id)
+	}
+
+	r.logger.InfoContext(ctx, "category parent set successfully", "category_id", sqlcCategory.ID)
+	return toDomainCategory(sqlcCategory), nil
+}
+
+// SetPositions sets the position of each category to its index in ids
+func (r *categoryRepository) SetPositions(ctx context.Context, ids []int32) error {
+	if err := r.q(ctx).SetCategoryPositions(ctx, ids); err != nil {
+		r.logger.ErrorContext(ctx, "failed to set category positions", "error", err)
+		return fmt.Errorf("%w: %w", ErrUpdateCategoryFailed, err)
+	}
+	return nil
+}
+
+// Delete deletes a category
+func (r *categoryRepository) Delete(ctx context.Context, id int32) error {
+	r.logger.InfoContext(ctx, "deleting category", "id", id)
+
+	deleted, err := r.q(ctx).DeleteCategory(ctx, id)
+	if err != nil {
+		// Check for foreign key violation (children or competencies)
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			r.logger.WarnContext(ctx, "category still referenced", "id", id, "constraint", pgErr.ConstraintName)
+			return domain.ErrCategoryInUse
+		}
+		r.logger.ErrorContext(ctx, "failed to delete category", "error", err, "id", id)
+		return fmt.Errorf("%w: %w", ErrDeleteCategoryFailed, err)
+	}
+	if deleted == 0 {
+		r.logger.InfoContext(ctx, "category not found", "id", id)
+		return domain.ErrCategoryNotFound
+	}
+
+	r.logger.InfoContext(ctx, "category deleted successfully", "category_id", id)
+	return nil
+}
+
+// updateError converts the error of a category update to a domain error when it has a business meaning
+func (r *categoryRepository) updateError(ctx context.Context, err error, id int32) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		r.logger.InfoContext(ctx, "category not found", "id", id)
+		return domain.ErrCategoryNotFound
+	}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case "23505":
+			r.logger.WarnContext(ctx, "duplicate category name among siblings", "id", id)
+			return domain.ErrCategoryAlreadyExists
+		case "23503":
+			r.logger.InfoContext(ctx, "parent category not found", "id", id)
+			return domain.ErrCategoryParentNotFound
+		}
+	}
+	r.logger.ErrorContext(ctx, "failed to update category", "error", err, "id", id)
+	return fmt.Errorf("%w: %w", ErrUpdateCategoryFailed, err)
+}
+
+// toDomainCategories converts SQLC CompetencyCategory models to domain Category models
+func toDomainCategories(sqlcCategories []sqlc.CompetencyCategory) []*domain.Category {
+	categories := make([]*domain.Category, len(sqlcCategories))
+	for i, sqlcCategory := range sqlcCategories {
+		categories[i] = toDomainCategory(sqlcCategory)
+	}
+	return categories
+}
+
+// toDomainCategory converts SQLC CompetencyCategory model to domain Category model
+func toDomainCategory(sqlcCategory sqlc.CompetencyCategory) *domain.Category {
+	category := &domain.Category{
+		ID:        sqlcCategory.ID,
+		Name:      sqlcCategory.Name,
+		Position:  sqlcCategory.Position,
+		CreatedAt: sqlcCategory.CreatedAt.Time,
+		UpdatedAt: sqlcCategory.UpdatedAt.Time,
+	}
+	if sqlcCategory.ParentID.Valid {
+		parentID := sqlcCategory.ParentID.Int32
+		category.ParentID = &parentID
+	}
+	return category
+}
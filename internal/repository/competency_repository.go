@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
 	"time"
 
 	"github.com/jackc/pgx/v5"
@@ -35,123 +36,520 @@ func NewCompetencyRepository(pool *pgxpool.Pool, logger *slog.Logger) (domain.Co
 	}, nil
 }
 
+// q returns the queries to run, in the transaction of ctx if there is one (see transactor)
+func (r *competencyRepository) q(ctx context.Context) *sqlc.Queries {
+	return queriesFromContext(ctx, r.queries)
+}
+
 // Create creates a new competency in the database
 func (r *competencyRepository) Create(ctx context.Context, name, description string) (*domain.Competency, error) {
-	r.logger.Info("creating competency", "name", name)
+	r.logger.InfoContext(ctx, "creating competency", "name", name)
 
 	params := sqlc.CreateCompetencyParams{
 		Name:        name,
 		Description: pgtype.Text{String: description, Valid: true},
 	}
 
-	sqlcCompetency, err := r.queries.CreateCompetency(ctx, params)
+	sqlcCompetency, err := r.q(ctx).CreateCompetency(ctx, params)
 	if err != nil {
 		// Check for unique constraint violation (duplicate name)
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
-			r.logger.Warn("duplicate competency name", "name", name)
+			r.logger.WarnContext(ctx, "duplicate competency name", "name", name)
 			return nil, domain.ErrCompetencyAlreadyExists
 		}
-		r.logger.Error("failed to create competency", "error", err, "name", name)
+		r.logger.ErrorContext(ctx, "failed to create competency", "error", err, "name", name)
 		return nil, fmt.Errorf("%w: %w", ErrCreateCompetencyFailed, err)
 	}
 
-	r.logger.Info("competency created successfully", "competency_id", sqlcCompetency.ID)
+	r.logger.InfoContext(ctx, "competency created successfully", "competency_id", sqlcCompetency.ID)
 
 	// Convert SQLC model to domain model
-	return toDomainCompetency(sqlcCompetency), nil
+	return toDomainCompetency(competencyRow(sqlcCompetency)), nil
+}
+
+// CreateMany creates competencies with a single batch of inserts, skipping existing names
+func (r *competencyRepository) CreateMany(ctx context.Context, items []domain.NewCompetency) ([]*domain.Competency, error) {
+	r.logger.InfoContext(ctx, "creating competencies", "count", len(items))
+
+	params := make([]sqlc.CreateCompetenciesParams, len(items))
+	for i, item := range items {
+		params[i] = sqlc.CreateCompetenciesParams{
+			Name:        item.Name,
+			Description: pgtype.Text{String: item.Description, Valid: true},
+		}
+	}
+
+	created := make([]*domain.Competency, len(items))
+	var batchErr error
+	r.q(ctx).CreateCompetencies(ctx, params).QueryRow(func(i int, sqlcCompetency sqlc.CreateCompetenciesRow, err error) {
+		switch {
+		case batchErr != nil:
+		case errors.Is(err, pgx.ErrNoRows):
+			// ON CONFLICT DO NOTHING: the name is taken
+		case err != nil:
+			batchErr = err
+		default:
+			created[i] = toDomainCompetency(competencyRow(sqlcCompetency))
+		}
+	})
+	if batchErr != nil {
+		r.logger.ErrorContext(ctx, "failed to create competencies", "error", batchErr)
+		return nil, fmt.Errorf("%w: %w", ErrCreateCompetenciesFailed, batchErr)
+	}
+
+	r.logger.InfoContext(ctx, "competency batch inserted successfully", "items", len(items))
+	return created, nil
 }
 
 // GetByID retrieves a competency by ID
 func (r *competencyRepository) GetByID(ctx context.Context, id int32) (*domain.Competency, error) {
-	r.logger.Info("getting competency by ID", "id", id)
+	r.logger.InfoContext(ctx, "getting competency by ID", "id", id)
 
-	sqlcCompetency, err := r.queries.GetCompetencyByID(ctx, id)
+	sqlcCompetency, err := r.q(ctx).GetCompetencyByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			r.logger.Info("competency not found", "id", id)
+			r.logger.InfoContext(ctx, "competency not found", "id", id)
 			return nil, domain.ErrCompetencyNotFound
 		}
-		r.logger.Error("failed to get competency by ID", "error", err, "id", id)
+		r.logger.ErrorContext(ctx, "failed to get competency by ID", "error", err, "id", id)
 		return nil, fmt.Errorf("%w: %w", ErrGetCompetencyByIDFailed, err)
 	}
 
-	r.logger.Info("competency retrieved successfully", "competency_id", sqlcCompetency.ID)
+	r.logger.InfoContext(ctx, "competency retrieved successfully", "competency_id", sqlcCompetency.ID)
 
 	// Convert SQLC model to domain model
-	return toDomainCompetency(sqlcCompetency), nil
+	return toDomainCompetency(competencyRow(sqlcCompetency)), nil
 }
 
 // GetByName retrieves a competency by name
 func (r *competencyRepository) GetByName(ctx context.Context, name string) (*domain.Competency, error) {
-	r.logger.Info("getting competency by name", "name", name)
+	r.logger.InfoContext(ctx, "getting competency by name", "name", name)
 
-	sqlcCompetency, err := r.queries.GetCompetencyByName(ctx, name)
+	sqlcCompetency, err := r.q(ctx).GetCompetencyByName(ctx, name)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			r.logger.Info("competency not found", "name", name)
+			r.logger.InfoContext(ctx, "competency not found", "name", name)
 			return nil, domain.ErrCompetencyNotFound
 		}
-		r.logger.Error("failed to get competency by name", "error", err, "name", name)
+		r.logger.ErrorContext(ctx, "failed to get competency by name", "error", err, "name", name)
 		return nil, fmt.Errorf("%w: %w", ErrGetCompetencyByNameFailed, err)
 	}
 
-	r.logger.Info("competency retrieved successfully", "competency_id", sqlcCompetency.ID)
+	r.logger.InfoContext(ctx, "competency retrieved successfully", "competency_id", sqlcCompetency.ID)
 
 	// Convert SQLC model to domain model
-	return toDomainCompetency(sqlcCompetency), nil
+	return toDomainCompetency(competencyRow(sqlcCompetency)), nil
 }
 
-// GetAll retrieves all competencies ordered by creation date
-func (r *competencyRepository) GetAll(ctx context.Context) ([]*domain.Competency, error) {
-	r.logger.Info("getting all competencies")
+// GetByNames retrieves the competencies with the given names
+func (r *competencyRepository) GetByNames(ctx context.Context, names []string) ([]*domain.Competency, error) {
+	r.logger.InfoContext(ctx, "getting competencies by names", "count", len(names))
 
-	sqlcCompetencies, err := r.queries.GetAllCompetencies(ctx)
+	sqlcCompetencies, err := r.q(ctx).GetCompetenciesByNames(ctx, names)
 	if err != nil {
-		r.logger.Error("failed to get all competencies", "error", err)
-		return nil, fmt.Errorf("%w: %w", ErrGetAllCompetenciesFailed, err)
+		r.logger.ErrorContext(ctx, "failed to get competencies by names", "error", err)
+		return nil, fmt.Errorf("%w: %w", ErrGetCompetenciesByNamesFailed, err)
 	}
 
-	r.logger.Info("competencies retrieved successfully", "count", len(sqlcCompetencies))
-
 	// Convert SQLC models to domain models
 	competencies := make([]*domain.Competency, len(sqlcCompetencies))
 	for i, sqlcComp := range sqlcCompetencies {
-		competencies[i] = toDomainCompetency(sqlcComp)
+		competencies[i] = toDomainCompetency(competencyRow(sqlcComp))
 	}
 
 	return competencies, nil
 }
 
-// UpdateDescription updates the description of a competency
-func (r *competencyRepository) UpdateDescription(ctx context.Context, id int32, description string) (*domain.Competency, error) {
-	r.logger.Info("updating competency description", "id", id)
+// GetAll retrieves a page of competencies and the total number matching the filter
+// One row more than the limit is fetched to know whether a next page exists
+func (r *competencyRepository) GetAll(ctx context.Context, params domain.CompetencyListParams) (*domain.CompetencyPage, error) {
+	r.logger.InfoContext(ctx, "listing competencies", "sort", params.Sort, "direction", params.Direction, "limit", params.Limit)
+
+	filter := toCompetencyFilterParams(params.Filter)
+	listParams := sqlc.ListCompetenciesParams{
+		NamePattern:     filter.NamePattern,
+		HasDescription:  filter.HasDescription,
+		CreatedAfter:    filter.CreatedAfter,
+		IncludeArchived: filter.IncludeArchived,
+		CategoryID:      filter.CategoryID,
+		Tags:            filter.Tags,
+		MatchAllTags:    filter.MatchAllTags,
+		SortBy:          string(params.Sort),
+		Descending:      params.Direction == domain.SortDescending,
+		RowLimit:        params.Limit + 1,
+	}
+	if after := params.After; after != nil {
+		listParams.AfterID = pgtype.Int4{Int32: after.ID, Valid: true}
+		listParams.AfterName = pgtype.Text{String: after.Name, Valid: params.Sort == domain.CompetencySortName}
+		listParams.AfterTime = pgtype.Timestamp{Time: after.Time.UTC(), Valid: params.Sort != domain.CompetencySortName}
+	}
+
+	sqlcCompetencies, err := r.q(ctx).ListCompetencies(ctx, listParams)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to list competencies", "error", err)
+		return nil, fmt.Errorf("%w: %w", ErrListCompetenciesFailed, err)
+	}
+
+	total, err := r.q(ctx).CountCompetencies(ctx, filter)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to count competencies", "error", err)
+		return nil, fmt.Errorf("%w: %w", ErrCountCompetenciesFailed, err)
+	}
+
+	hasMore := len(sqlcCompetencies) > int(params.Limit)
+	if hasMore {
+		sqlcCompetencies = sqlcCompetencies[:params.Limit]
+	}
+
+	// Convert SQLC models to domain models
+	page := &domain.CompetencyPage{
+		Competencies: make([]*domain.Competency, len(sqlcCompetencies)),
+		Total:        total,
+	}
+	for i, sqlcComp := range sqlcCompetencies {
+		page.Competencies[i] = toDomainCompetency(competencyRow(sqlcComp))
+	}
+	if hasMore {
+		page.NextCursor = params.CursorFor(page.Competencies[len(page.Competencies)-1])
+	}
+
+	r.logger.InfoContext(ctx, "competencies listed successfully", "count", len(page.Competencies), "total", total)
+	return page, nil
+}
+
+// Search retrieves the competencies matching a web search query, most relevant first
+func (r *competencyRepository) Search(ctx context.Context, query string, limit int32) ([]*domain.CompetencySearchResult, error) {
+	r.logger.InfoContext(ctx, "searching competencies", "query", query, "limit", limit)
+
+	rows, err := r.q(ctx).SearchCompetencies(ctx, sqlc.SearchCompetenciesParams{
+		Query:    query,
+		RowLimit: limit,
+	})
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to search competencies", "error", err, "query", query)
+		return nil, fmt.Errorf("%w: %w", ErrSearchCompetenciesFailed, err)
+	}
+
+	results := make([]*domain.CompetencySearchResult, len(rows))
+	for i, row := range rows {
+		results[i] = &domain.CompetencySearchResult{
+			Competency: toDomainCompetency(competencyRow{
+				ID:          row.ID,
+				Name:        row.Name,
+				Description: row.Description,
+				CreatedAt:   row.CreatedAt,
+				UpdatedAt:   row.UpdatedAt,
+				Version:     row.Version,
+			}),
+			Rank:    row.Rank,
+			Name:    row.NameHighlight,
+			Snippet: row.DescriptionSnippet,
+		}
+	}
+
+	r.logger.InfoContext(ctx, "competencies searched successfully", "count", len(results))
+	return results, nil
+}
+
+// Suggest retrieves competency names for typeahead
+func (r *competencyRepository) Suggest(ctx context.Context, prefix string, limit int32) ([]*domain.CompetencySuggestion, error) {
+	r.logger.InfoContext(ctx, "suggesting competencies", "prefix", prefix, "limit", limit)
+
+	rows, err := r.q(ctx).SuggestCompetencies(ctx, sqlc.SuggestCompetenciesParams{
+		NamePattern: escapeLike(prefix) + "%",
+		Prefix:      prefix,
+		RowLimit:    limit,
+	})
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to suggest competencies", "error", err, "prefix", prefix)
+		return nil, fmt.Errorf("%w: %w", ErrSuggestCompetenciesFailed, err)
+	}
+
+	suggestions := make([]*domain.CompetencySuggestion, len(rows))
+	for i, row := range rows {
+		suggestions[i] = &domain.CompetencySuggestion{ID: row.ID, Name: row.Name}
+	}
+
+	return suggestions, nil
+}
+
+// UpdateDescription updates the description of a competency if it still has the expected version
+func (r *competencyRepository) UpdateDescription(ctx context.Context, id int32, description string, expectedVersion int32) (*domain.Competency, error) {
+	r.logger.InfoContext(ctx, "updating competency description", "id", id)
 
 	params := sqlc.UpdateCompetencyDescriptionParams{
-		ID:          id,
-		Description: pgtype.Text{String: description, Valid: true},
+		ID:              id,
+		Description:     pgtype.Text{String: description, Valid: true},
+		ExpectedVersion: pgtype.Int4{Int32: expectedVersion, Valid: expectedVersion != domain.AnyVersion},
 	}
 
-	sqlcCompetency, err := r.queries.UpdateCompetencyDescription(ctx, params)
+	sqlcCompetency, err := r.q(ctx).UpdateCompetencyDescription(ctx, params)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			r.logger.Info("competency not found", "id", id)
-			return nil, domain.ErrCompetencyNotFound
+			return nil, r.missingOrConflict(ctx, id)
 		}
-		r.logger.Error("failed to update competency description", "error", err, "id", id)
+		r.logger.ErrorContext(ctx, "failed to update competency description", "error", err, "id", id)
 		return nil, fmt.Errorf("%w: %w", ErrUpdateCompetencyDescriptionFailed, err)
 	}
 
-	r.logger.Info("competency description updated successfully", "competency_id", sqlcCompetency.ID)
+	r.logger.InfoContext(ctx, "competency description updated successfully", "competency_id", sqlcCompetency.ID)
+
+	// Convert SQLC model to domain model
+	return toDomainCompetency(competencyRow(sqlcCompetency)), nil
+}
+
+// Rename renames a competency if it still has the expected version
+func (r *competencyRepository) Rename(ctx context.Context, id int32, name string, expectedVersion int32) (*domain.Competency, error) {
+	r.logger.InfoContext(ctx, "renaming competency", "id", id, "name", name)
+
+	params := sqlc.RenameCompetencyParams{
+		ID:              id,
+		Name:            name,
+		ExpectedVersion: pgtype.Int4{Int32: expectedVersion, Valid: expectedVersion != domain.AnyVersion},
+	}
+
+	sqlcCompetency, err := r.q(ctx).RenameCompetency(ctx, params)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, r.missingOrConflict(ctx, id)
+		}
+		// Check for unique constraint violation (duplicate name)
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			r.logger.WarnContext(ctx, "duplicate competency name", "name", name)
+			return nil, domain.ErrCompetencyAlreadyExists
+		}
+		r.logger.ErrorContext(ctx, "failed to rename competency", "error", err, "id", id)
+		return nil, fmt.Errorf("%w: %w", ErrRenameCompetencyFailed, err)
+	}
+
+	r.logger.InfoContext(ctx, "competency renamed successfully", "competency_id", sqlcCompetency.ID)
 
 	// Convert SQLC model to domain model
-	return toDomainCompetency(sqlcCompetency), nil
+	return toDomainCompetency(competencyRow(sqlcCompetency)), nil
+}
+
+// Archive archives a competency
+func (r *competencyRepository) Archive(ctx context.Context, id int32) (*domain.Competency, error) {
+	r.logger.InfoContext(ctx, "archiving competency", "id", id)
+
+	sqlcCompetency, err := r.q(ctx).ArchiveCompetency(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			// Missing or already archived
+			return r.GetByID(ctx, id)
+		}
+		r.logger.ErrorContext(ctx, "failed to archive competency", "error", err, "id", id)
+		return nil, fmt.Errorf("%w: %w", ErrArchiveCompetencyFailed, err)
+	}
+
+	r.logger.InfoContext(ctx, "competency archived successfully", "competency_id", sqlcCompetency.ID)
+	return toDomainCompetency(competencyRow(sqlcCompetency)), nil
+}
+
+// Restore restores an archived competency
+func (r *competencyRepository) Restore(ctx context.Context, id int32) (*domain.Competency, error) {
+	r.logger.InfoContext(ctx, "restoring competency", "id", id)
+
+	sqlcCompetency, err := r.q(ctx).RestoreCompetency(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			// Missing or not archived
+			return r.GetByID(ctx, id)
+		}
+		r.logger.ErrorContext(ctx, "failed to restore competency", "error", err, "id", id)
+		return nil, fmt.Errorf("%w: %w", ErrRestoreCompetencyFailed, err)
+	}
+
+	r.logger.InfoContext(ctx, "competency restored successfully", "competency_id", sqlcCompetency.ID)
+	return toDomainCompetency(competencyRow(sqlcCompetency)), nil
 }
 
-// toDomainCompetency converts SQLC Competency model to domain Competency model
-func toDomainCompetency(sqlcCompetency sqlc.Competency) *domain.Competency {
+// SetCategory places a competency under a category
+func (r *competencyRepository) SetCategory(ctx context.Context, id int32, categoryID *int32) (*domain.Competency, error) {
+	r.logger.InfoContext(ctx, "setting competency category", "id", id, "category_id", categoryID)
+
+	sqlcCompetency, err := r.q(ctx).SetCompetencyCategory(ctx, sqlc.SetCompetencyCategoryParams{
+		ID:         id,
+		CategoryID: toPgInt4(categoryID),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			r.logger.InfoContext(ctx, "competency not found", "id", id)
+			return nil, domain.ErrCompetencyNotFound
+		}
+		// Check for foreign key violation (unknown category)
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			r.logger.InfoContext(ctx, "category not found", "category_id", categoryID)
+			return nil, domain.ErrCategoryNotFound
+		}
+		r.logger.ErrorContext(ctx, "failed to set competency category", "error", err, "id", id)
+		return nil, fmt.Errorf("%w: %w", ErrSetCompetencyCategoryFailed, err)
+	}
+
+	r.logger.InfoContext(ctx, "competency category set successfully", "competency_id", sqlcCompetency.ID)
+	return toDomainCompetency(competencyRow(sqlcCompetency)), nil
+}
+
+// Revert sets the state recorded by a revision if the competency still has the expected version
+func (r *competencyRepository) Revert(ctx context.Context, id int32, state domain.CompetencySnapshot, expectedVersion int32) (*domain.Competency, error) {
+	r.logger.InfoContext(ctx, "reverting competency", "id", id)
+
+	params := sqlc.RevertCompetencyParams{
+		ID:              id,
+		Name:            state.Name,
+		Description:     pgtype.Text{String: state.Description, Valid: true},
+		CategoryID:      toPgInt4(state.CategoryID),
+		Archived:        state.Archived,
+		ExpectedVersion: pgtype.Int4{Int32: expectedVersion, Valid: expectedVersion != domain.AnyVersion},
+	}
+
+	sqlcCompetency, err := r.q(ctx).RevertCompetency(ctx, params)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, r.missingOrConflict(ctx, id)
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case "23505":
+				r.logger.WarnContext(ctx, "duplicate competency name", "name", state.Name)
+				return nil, domain.ErrCompetencyAlreadyExists
+			case "23503":
+				r.logger.InfoContext(ctx, "category not found", "category_id", state.CategoryID)
+				return nil, domain.ErrCategoryNotFound
+			}
+		}
+		r.logger.ErrorContext(ctx, "failed to revert competency", "error", err, "id", id)
+		return nil, fmt.Errorf("%w: %w", ErrRevertCompetencyFailed, err)
+	}
+
+	r.logger.InfoContext(ctx, "competency reverted successfully", "competency_id", sqlcCompetency.ID)
+	return toDomainCompetency(competencyRow(sqlcCompetency)), nil
+}
+
+// Delete deletes a competency permanently, unless edges, merge redirects or a rubric reference it
+// Those references would be deleted with it (ON DELETE CASCADE, which merges rely on), so they are checked first
+func (r *competencyRepository) Delete(ctx context.Context, id int32) error {
+	r.logger.InfoContext(ctx, "deleting competency", "id", id)
+
+	referenced, err := r.q(ctx).IsCompetencyReferenced(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			r.logger.InfoContext(ctx, "competency not found", "id", id)
+			return domain.ErrCompetencyNotFound
+		}
+		r.logger.ErrorContext(ctx, "failed to check competency references", "error", err, "id", id)
+		return fmt.Errorf("%w: %w", ErrDeleteCompetencyFailed, err)
+	}
+	if referenced {
+		r.logger.WarnContext(ctx, "competency still referenced", "id", id)
+		return domain.ErrCompetencyInUse
+	}
+
+	deleted, err := r.q(ctx).DeleteCompetency(ctx, id)
+	if err != nil {
+		// Check for foreign key violation (still referenced)
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			r.logger.WarnContext(ctx, "competency still referenced", "id", id, "constraint", pgErr.ConstraintName)
+			return domain.ErrCompetencyInUse
+		}
+		r.logger.ErrorContext(ctx, "failed to delete competency", "error", err, "id", id)
+		return fmt.Errorf("%w: %w", ErrDeleteCompetencyFailed, err)
+	}
+	if deleted == 0 {
+		r.logger.InfoContext(ctx, "competency not found", "id", id)
+		return domain.ErrCompetencyNotFound
+	}
+
+	r.logger.InfoContext(ctx, "competency deleted successfully", "competency_id", id)
+	return nil
+}
+
+// missingOrConflict tells apart why a conditional update matched no row:
+// the competency doesn't exist, or it has another version than expected
+func (r *competencyRepository) missingOrConflict(ctx context.Context, id int32) error {
+	_, err := r.q(ctx).GetCompetencyByID(ctx, id)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		r.logger.InfoContext(ctx, "competency not found", "id", id)
+		return domain.ErrCompetencyNotFound
+	case err != nil:
+		r.logger.ErrorContext(ctx, "failed to get competency by ID", "error", err, "id", id)
+		return fmt.Errorf("%w: %w", ErrGetCompetencyByIDFailed, err)
+	default:
+		r.logger.InfoContext(ctx, "competency version conflict", "id", id)
+		return domain.ErrCompetencyVersionConflict
+	}
+}
+
+// toCompetencyFilterParams converts a domain filter to the filter parameters shared by the list and count queries
+func toCompetencyFilterParams(filter domain.CompetencyFilter) sqlc.CountCompetenciesParams {
+	params := sqlc.CountCompetenciesParams{IncludeArchived: filter.IncludeArchived}
+	if filter.NamePrefix != "" {
+		params.NamePattern = pgtype.Text{String: escapeLike(filter.NamePrefix) + "%", Valid: true}
+	}
+	if filter.HasDescription != nil {
+		params.HasDescription = pgtype.Bool{Bool: *filter.HasDescription, Valid: true}
+	}
+	if filter.CreatedAfter != nil {
+		params.CreatedAfter = pgtype.Timestamp{Time: filter.CreatedAfter.UTC(), Valid: true}
+	}
+	params.CategoryID = toPgInt4(filter.CategoryID)
+	// A NULL array would match nothing, so no tags are an empty one
+	params.Tags = filter.Tags
+	if params.Tags == nil {
+		params.Tags = []string{}
+	}
+	params.MatchAllTags = filter.TagMatch == domain.TagMatchAll
+	return params
+}
+
+// toPgInt4 converts an optional ID to a nullable integer parameter
+func toPgInt4(id *int32) pgtype.Int4 {
+	if id == nil {
+		return pgtype.Int4{}
+	}
+	return pgtype.Int4{Int32: *id, Valid: true}
+}
+
+// fromPgInt4 converts a nullable integer to an optional ID
+func fromPgInt4(id pgtype.Int4) *int32 {
+	if !id.Valid {
+		return nil
+	}
+	return &id.Int32
+}
+
+// toPgText converts an optional string to a nullable text parameter
+func toPgText(s *string) pgtype.Text {
+	if s == nil {
+		return pgtype.Text{}
+	}
+	return pgtype.Text{String: *s, Valid: true}
+}
+
+// likeEscaper escapes the LIKE wildcards, so user input only matches literally
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// escapeLike escapes s for use in a LIKE pattern (backslash is the default escape character)
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
+
+// competencyRow holds the columns competency queries return: all but search_vector, which only serves search
+// sqlc generates an identical row type per query; they convert to this one
+type competencyRow = sqlc.GetCompetencyByIDRow
+
+// toDomainCompetency converts a SQLC competency row to domain Competency model
+func toDomainCompetency(sqlcCompetency competencyRow) *domain.Competency {
 	var createdAt, updatedAt time.Time
 	var description string
+	var archivedAt *time.Time
+	var categoryID *int32
 
 	if sqlcCompetency.CreatedAt.Valid {
 		createdAt = sqlcCompetency.CreatedAt.Time
@@ -165,11 +563,22 @@ func toDomainCompetency(sqlcCompetency sqlc.Competency) *domain.Competency {
 		description = sqlcCompetency.Description.String
 	}
 
+	if sqlcCompetency.ArchivedAt.Valid {
+		archivedAt = &sqlcCompetency.ArchivedAt.Time
+	}
+
+	if sqlcCompetency.CategoryID.Valid {
+		categoryID = &sqlcCompetency.CategoryID.Int32
+	}
+
 	return &domain.Competency{
 		ID:          sqlcCompetency.ID,
 		Name:        sqlcCompetency.Name,
 		Description: description,
 		CreatedAt:   createdAt,
 		UpdatedAt:   updatedAt,
+		Version:     sqlcCompetency.Version,
+		ArchivedAt:  archivedAt,
+		CategoryID:  categoryID,
 	}
 }
@@ -7,10 +7,98 @@ var (
 	ErrPoolNil   = errors.New("database pool cannot be nil")
 	ErrLoggerNil = errors.New("logger cannot be nil")
 
+	// Transaction errors
+	ErrBeginTxFailed  = errors.New("failed to begin transaction")
+	ErrCommitTxFailed = errors.New("failed to commit transaction")
+
 	// Competency repository errors
 	ErrCreateCompetencyFailed            = errors.New("failed to create competency")
+	ErrCreateCompetenciesFailed          = errors.New("failed to create competencies")
 	ErrGetCompetencyByIDFailed           = errors.New("failed to get competency by ID")
 	ErrGetCompetencyByNameFailed         = errors.New("failed to get competency by name")
-	ErrGetAllCompetenciesFailed          = errors.New("failed to get all competencies")
+	ErrGetCompetenciesByNamesFailed      = errors.New("failed to get competencies by names")
+	ErrListCompetenciesFailed            = errors.New("failed to list competencies")
+	ErrCountCompetenciesFailed           = errors.New("failed to count competencies")
+	ErrSearchCompetenciesFailed          = errors.New("failed to search competencies")
+	ErrSuggestCompetenciesFailed         = errors.New("failed to suggest competencies")
 	ErrUpdateCompetencyDescriptionFailed = errors.New("failed to update competency description")
+	ErrRenameCompetencyFailed            = errors.New("failed to rename competency")
+	ErrArchiveCompetencyFailed           = errors.New("failed to archive competency")
+	ErrRestoreCompetencyFailed           = errors.New("failed to restore competency")
+	ErrSetCompetencyCategoryFailed       = errors.New("failed to set competency category")
+	ErrRevertCompetencyFailed            = errors.New("failed to revert competency")
+	ErrDeleteCompetencyFailed            = errors.New("failed to delete competency")
+
+	// Category repository errors
+	ErrCreateCategoryFailed            = errors.New("failed to create category")
+	ErrGetCategoryByIDFailed           = errors.New("failed to get category by ID")
+	ErrListCategoriesFailed            = errors.New("failed to list categories")
+	ErrCheckCategorySubtreeFailed      = errors.New("failed to check category subtree")
+	ErrCountCategoryCompetenciesFailed = errors.New("failed to count category competencies")
+	ErrLockCategoriesFailed            = errors.New("failed to lock categories")
+	ErrUpdateCategoryFailed            = errors.New("failed to update category")
+	ErrDeleteCategoryFailed            = errors.New("failed to delete category")
+
+	// Rubric repository errors
+	ErrCreateRatingScaleFailed = errors.New("failed to create rating scale")
+	ErrGetRatingScaleFailed    = errors.New("failed to get rating scale")
+	ErrUpdateRatingScaleFailed = errors.New("failed to update rating scale")
+	ErrDeleteRatingScaleFailed = errors.New("failed to delete rating scale")
+	ErrGetRubricFailed         = errors.New("failed to get rubric")
+	ErrUpdateRubricFailed      = errors.New("failed to update rubric")
+
+	// Tag repository errors
+	ErrCreateTagFailed            = errors.New("failed to create tag")
+	ErrGetTagFailed               = errors.New("failed to get tag")
+	ErrListTagsFailed             = errors.New("failed to list tags")
+	ErrUpdateTagFailed            = errors.New("failed to update tag")
+	ErrDeleteTagFailed            = errors.New("failed to delete tag")
+	ErrUpdateCompetencyTagsFailed = errors.New("failed to update competency tags")
+
+	// Revision repository errors
+	ErrRecordRevisionFailed = errors.New("failed to record competency revision")
+	ErrGetRevisionFailed    = errors.New("failed to get competency revision")
+	ErrListRevisionsFailed  = errors.New("failed to list competency revisions")
+	ErrDecodeRevisionFailed = errors.New("failed to decode competency revision")
+
+	// Relation repository errors
+	ErrLockRelationsFailed   = errors.New("failed to lock competency relations")
+	ErrGetRelationsFailed    = errors.New("failed to get competency relations")
+	ErrUpdateRelationsFailed = errors.New("failed to update competency relations")
+
+	// Translation repository errors
+	ErrGetTranslationsFailed   = errors.New("failed to get competency translations")
+	ErrUpdateTranslationFailed = errors.New("failed to update competency translation")
+
+	// Merge repository errors
+	ErrListDuplicatesFailed    = errors.New("failed to list duplicate competencies")
+	ErrMergeCompetenciesFailed = errors.New("failed to merge competencies")
+	ErrGetMergeFailed          = errors.New("failed to get competency merge")
+
+	// Proposal repository errors
+	ErrCreateProposalFailed = errors.New("failed to create competency proposal")
+	ErrGetProposalFailed    = errors.New("failed to get competency proposal")
+	ErrListProposalsFailed  = errors.New("failed to list competency proposals")
+	ErrUpdateProposalFailed = errors.New("failed to update competency proposal")
+	ErrDeleteProposalFailed = errors.New("failed to delete competency proposal")
+
+	// Steward repository errors
+	ErrGetStewardsFailed    = errors.New("failed to get competency stewards")
+	ErrUpdateStewardsFailed = errors.New("failed to update competency stewards")
+	ErrCreateReportFailed   = errors.New("failed to create competency report")
+	ErrGetReportsFailed     = errors.New("failed to get competency reports")
+
+	// Notification repository errors
+	ErrNotifyFailed             = errors.New("failed to create notifications")
+	ErrGetNotificationsFailed   = errors.New("failed to get notifications")
+	ErrUpdateNotificationFailed = errors.New("failed to update notification")
+
+	// Framework repository errors
+	ErrPublishFrameworkFailed = errors.New("failed to publish framework version")
+	ErrGetFrameworkFailed     = errors.New("failed to get framework version")
+	ErrDecodeFrameworkFailed  = errors.New("failed to decode framework snapshot")
+
+	// Rate limit store errors
+	ErrIncrementRateLimitFailed = errors.New("failed to increment rate limit counter")
+	ErrCleanupRateLimitFailed   = errors.New("failed to clean up rate limit counters")
 )
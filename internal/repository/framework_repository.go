@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/mehrnoosh-hk/devnorth-back/db/sqlc"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+)
+
+// frameworkRepository implements domain.FrameworkRepository using SQLC
+// Snapshots are taken by the database (framework_snapshot), in one statement, so they are consistent
+type frameworkRepository struct {
+	queries *sqlc.Queries
+	logger  *slog.Logger
+}
+
+// NewFrameworkRepository creates a new instance of FrameworkRepository
+func NewFrameworkRepository(pool *pgxpool.Pool, logger *slog.Logger) (domain.FrameworkRepository, error) {
+	if pool == nil {
+		return nil, ErrPoolNil
+	}
+	if logger == nil {
+		return nil, ErrLoggerNil
+	}
+	return &frameworkRepository{
+		queries: sqlc.New(pool),
+		logger:  logger,
+	}, nil
+}
+
+// q returns the queries to run, in the transaction of ctx if there is one (see transactor)
+func (r *frameworkRepository) q(ctx context.Context) *sqlc.Queries {
+	return queriesFromContext(ctx, r.queries)
+}
+
+// Publish snapshots the current framework as a version named name
+func (r *frameworkRepository) Publish(ctx context.Context, name, notes string, publishedBy *int32) (*domain.FrameworkVersion, error) {
+	row, err := r.q(ctx).PublishFrameworkVersion(ctx, sqlc.PublishFrameworkVersionParams{
+		Name:        name,
+		Notes:       notes,
+		PublishedBy: toPgInt4(publishedBy),
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			r.logger.WarnContext(ctx, "duplicate framework version name", "name", name)
+			return nil, domain.ErrFrameworkVersionAlreadyExists
+		}
+		r.logger.ErrorContext(ctx, "failed to publish framework version", "error", err, "name", name)
+		return nil, fmt.Errorf("%w: %w", ErrPublishFrameworkFailed, err)
+	}
+
+	r.logger.InfoContext(ctx, "framework version published", "version_id", row.ID, "name", name, "competencies", row.CompetencyCount)
+	return toDomainFrameworkVersion(sqlc.ListFrameworkVersionsRow(row)), nil
+}
+
+// GetAll retrieves the published versions without their snapshots, latest first
+func (r *frameworkRepository) GetAll(ctx context.Context) ([]*domain.FrameworkVersion, error) {
+	rows, err := r.q(ctx).ListFrameworkVersions(ctx)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to list framework versions", "error", err)
+		return nil, fmt.Errorf("%w: %w", ErrGetFrameworkFailed, err)
+	}
+
+	versions := make([]*domain.FrameworkVersion, len(rows))
+	for i, row := range rows {
+		versions[i] = toDomainFrameworkVersion(row)
+	}
+	return versions, nil
+}
+
+// GetByName retrieves a published version with its snapshot
+func (r *frameworkRepository) GetByName(ctx context.Context, name string) (*domain.FrameworkVersion, error) {
+	row, err := r.q(ctx).GetFrameworkVersion(ctx, name)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			r.logger.InfoContext(ctx, "framework version not found", "name", name)
+			return nil, domain.ErrFrameworkVersionNotFound
+		}
+		r.logger.ErrorContext(ctx, "failed to get framework version", "error", err, "name", name)
+		return nil, fmt.Errorf("%w: %w", ErrGetFrameworkFailed, err)
+	}
+
+	version := toDomainFrameworkVersion(sqlc.ListFrameworkVersionsRow{
+		ID:              row.ID,
+		Name:            row.Name,
+		Notes:           row.Notes,
+		CompetencyCount: row.CompetencyCount,
+		PublishedBy:     row.PublishedBy,
+		PublishedAt:     row.PublishedAt,
+	})
+	if version.Snapshot, err = decodeFrameworkSnapshot(row.Snapshot); err != nil {
+		r.logger.ErrorContext(ctx, "failed to decode framework version", "error", err, "name", name)
+		return nil, err
+	}
+	return version, nil
+}
+
+// GetDraft takes a snapshot of the current framework
+func (r *frameworkRepository) GetDraft(ctx context.Context) (*domain.FrameworkSnapshot, error) {
+	raw, err := r.q(ctx).GetDraftFramework(ctx)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to snapshot draft framework", "error", err)
+		return nil, fmt.Errorf("%w: %w", ErrGetFrameworkFailed, err)
+	}
+
+	snapshot, err := decodeFrameworkSnapshot(raw)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to decode draft framework", "error", err)
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// decodeFrameworkSnapshot decodes a snapshot taken by framework_snapshot
+func decodeFrameworkSnapshot(raw []byte) (*domain.FrameworkSnapshot, error) {
+	var snapshot domain.FrameworkSnapshot
+	if err := json.Unmarshal(raw, &snapshot); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrDecodeFrameworkFailed, err)
+	}
+	return &snapshot, nil
+}
+
+// toDomainFrameworkVersion converts a framework version row without snapshot to domain FrameworkVersion model
+func toDomainFrameworkVersion(row sqlc.ListFrameworkVersionsRow) *domain.FrameworkVersion {
+	return &domain.FrameworkVersion{
+		ID:              row.ID,
+		Name:            row.Name,
+		Notes:           row.Notes,
+		CompetencyCount: row.CompetencyCount,
+		PublishedBy:     fromPgInt4(row.PublishedBy),
+		PublishedAt:     row.PublishedAt.Time,
+	}
+}
@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/mehrnoosh-hk/devnorth-back/db/sqlc"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+)
+
+// mergeRepository implements domain.MergeRepository using SQLC
+// Similarities are computed by pg_trgm, with the trigram indexes on names and descriptions
+type mergeRepository struct {
+	queries *sqlc.Queries
+	logger  *slog.Logger
+}
+
+// NewMergeRepository creates a new instance of MergeRepository
+func NewMergeRepository(pool *pgxpool.Pool, logger *slog.Logger) (domain.MergeRepository, error) {
+	if pool == nil {
+		return nil, ErrPoolNil
+	}
+	if logger == nil {
+		return nil, ErrLoggerNil
+	}
+	return &mergeRepository{
+		queries: sqlc.New(pool),
+		logger:  logger,
+	}, nil
+}
+
+// q returns the queries to run, in the transaction of ctx if there is one (see transactor)
+func (r *mergeRepository) q(ctx context.Context) *sqlc.Queries {
+	return queriesFromContext(ctx, r.queries)
+}
+
+// GetDuplicates retrieves pairs of similar competencies, highest score first
+func (r *mergeRepository) GetDuplicates(ctx context.Context, threshold float32, limit int32) ([]*domain.DuplicateCandidate, error) {
+	rows, err := r.q(ctx).ListDuplicateCandidates(ctx, sqlc.ListDuplicateCandidatesParams{Threshold: threshold, RowLimit: limit})
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to list duplicate competencies", "error", err, "threshold", threshold)
+		return nil, fmt.Errorf("%w: %w", ErrListDuplicatesFailed, err)
+	}
+
+	candidates := make([]*domain.DuplicateCandidate, len(rows))
+	for i, row := range rows {
+		candidates[i] = &domain.DuplicateCandidate{
+			Competency:            domain.CompetencySuggestion{ID: row.CompetencyID, Name: row.Name},
+			Other:                 domain.CompetencySuggestion{ID: row.OtherID, Name: row.OtherName},
+			NameSimilarity:        row.NameSimilarity,
+			DescriptionSimilarity: row.DescriptionSimilarity,
+			Score:                 row.Score,
+		}
+	}
+	return candidates, nil
+}
+
+// Merge moves the references of the merged competency to the surviving one and deletes the merged competency
+// The steps must run in one transaction: the deletion cascades to whatever wasn't moved
+func (r *mergeRepository) Merge(ctx context.Context, merge domain.CompetencyMerge) error {
+	q := r.q(ctx)
+	ids := sqlc.AbsorbCompetencyParams{CompetencyID: merge.CompetencyID, MergedID: merge.MergedID}
+
+	absorbed, err := q.AbsorbCompetency(ctx, ids)
+	if err != nil {
+		return r.mergeFailed(ctx, merge, "absorb", err)
+	}
+	if absorbed == 0 {
+		r.logger.InfoContext(ctx, "competency not found for merge", "competency_id", merge.CompetencyID, "merged_id", merge.MergedID)
+		return domain.ErrCompetencyNotFound
+	}
+
+	if err := q.MoveCompetencyTags(ctx, sqlc.MoveCompetencyTagsParams(ids)); err != nil {
+		return r.mergeFailed(ctx, merge, "move tags", err)
+	}
+	if err := q.MoveCompetencyRelations(ctx, sqlc.MoveCompetencyRelationsParams{MergedID: merge.MergedID, CompetencyID: merge.CompetencyID}); err != nil {
+		return r.mergeFailed(ctx, merge, "move relations", err)
+	}
+	if err := q.MoveCompetencyTranslations(ctx, sqlc.MoveCompetencyTranslationsParams(ids)); err != nil {
+		return r.mergeFailed(ctx, merge, "move translations", err)
+	}
+	if err := q.MoveCompetencyProposals(ctx, sqlc.MoveCompetencyProposalsParams(ids)); err != nil {
+		return r.mergeFailed(ctx, merge, "move proposals", err)
+	}
+	if err := q.MoveCompetencyStewards(ctx, sqlc.MoveCompetencyStewardsParams(ids)); err != nil {
+		return r.mergeFailed(ctx, merge, "move stewards", err)
+	}
+	if err := q.MoveCompetencyReports(ctx, sqlc.MoveCompetencyReportsParams(ids)); err != nil {
+		return r.mergeFailed(ctx, merge, "move reports", err)
+	}
+	if err := q.MoveCompetencyNotifications(ctx, sqlc.MoveCompetencyNotificationsParams(ids)); err != nil {
+		return r.mergeFailed(ctx, merge, "move notifications", err)
+	}
+
+	// The rubric is copied with its levels (their translations need the rubric), unless the survivor has one
+	copied, err := q.CopyCompetencyRubric(ctx, sqlc.CopyCompetencyRubricParams(ids))
+	if err != nil {
+		return r.mergeFailed(ctx, merge, "copy rubric", err)
+	}
+	if copied > 0 {
+		if err := q.CopyCompetencyLevels(ctx, sqlc.CopyCompetencyLevelsParams(ids)); err != nil {
+			return r.mergeFailed(ctx, merge, "copy levels", err)
+		}
+		if err := q.CopyCompetencyLevelTranslations(ctx, sqlc.CopyCompetencyLevelTranslationsParams(ids)); err != nil {
+			return r.mergeFailed(ctx, merge, "copy level translations", err)
+		}
+	}
+
+	// Redirect the merged ID, and the IDs merged into it earlier, before the deletion cascades to them
+	if err := q.RedirectCompetencyMerges(ctx, sqlc.RedirectCompetencyMergesParams(ids)); err != nil {
+		return r.mergeFailed(ctx, merge, "redirect merges", err)
+	}
+	if err := q.InsertCompetencyMerge(ctx, sqlc.InsertCompetencyMergeParams{
+		CompetencyID: merge.CompetencyID,
+		MergedBy:     toPgInt4(merge.MergedBy),
+		MergedID:     merge.MergedID,
+	}); err != nil {
+		return r.mergeFailed(ctx, merge, "record merge", err)
+	}
+	if _, err := q.DeleteCompetency(ctx, merge.MergedID); err != nil {
+		return r.mergeFailed(ctx, merge, "delete merged competency", err)
+	}
+
+	r.logger.InfoContext(ctx, "competencies merged", "competency_id", merge.CompetencyID, "merged_id", merge.MergedID, "rubric_copied", copied > 0)
+	return nil
+}
+
+// mergeFailed logs a failed merge step and wraps its error
+func (r *mergeRepository) mergeFailed(ctx context.Context, merge domain.CompetencyMerge, step string, err error) error {
+	r.logger.ErrorContext(ctx, "failed to merge competencies", "error", err, "step", step, "competency_id", merge.CompetencyID, "merged_id", merge.MergedID)
+	return fmt.Errorf("%w: %s: %w", ErrMergeCompetenciesFailed, step, err)
+}
+
+// GetRedirect retrieves the ID of the competency a merged competency was merged into
+func (r *mergeRepository) GetRedirect(ctx context.Context, mergedID int32) (int32, error) {
+	competencyID, err := r.q(ctx).GetCompetencyMerge(ctx, mergedID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, domain.ErrCompetencyNotFound
+		}
+		r.logger.ErrorContext(ctx, "failed to get competency merge", "error", err, "merged_id", mergedID)
+		return 0, fmt.Errorf("%w: %w", ErrGetMergeFailed, err)
+	}
+	return competencyID, nil
+}
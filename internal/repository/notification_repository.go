@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/mehrnoosh-hk/devnorth-back/db/sqlc"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+)
+
+// notificationRepository implements domain.NotificationRepository using SQLC
+type notificationRepository struct {
+	queries *sqlc.Queries
+	logger  *slog.Logger
+}
+
+// NewNotificationRepository creates a new instance of NotificationRepository
+func NewNotificationRepository(pool *pgxpool.Pool, logger *slog.Logger) (domain.NotificationRepository, error) {
+	if pool == nil {
+		return nil, ErrPoolNil
+	}
+	if logger == nil {
+		return nil, ErrLoggerNil
+	}
+	return &notificationRepository{
+		queries: sqlc.New(pool),
+		logger:  logger,
+	}, nil
+}
+
+// q returns the queries to run, in the transaction of ctx if there is one (see transactor)
+func (r *notificationRepository) q(ctx context.Context) *sqlc.Queries {
+	return queriesFromContext(ctx, r.queries)
+}
+
+// NotifyStewards notifies every steward of a competency but the sender
+func (r *notificationRepository) NotifyStewards(ctx context.Context, notice domain.StewardNotice) (int64, error) {
+	notified, err := r.q(ctx).NotifyStewards(ctx, sqlc.NotifyStewardsParams{
+		Kind:         string(notice.Kind),
+		CompetencyID: notice.CompetencyID,
+		ProposalID:   toPgInt4(notice.ProposalID),
+		ReportID:     toPgInt4(notice.ReportID),
+		SenderID:     toPgInt4(notice.SenderID),
+	})
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to notify stewards", "error", err, "kind", notice.Kind, "competency_id", notice.CompetencyID)
+		return 0, fmt.Errorf("%w: %w", ErrNotifyFailed, err)
+	}
+
+	r.logger.InfoContext(ctx, "stewards notified", "kind", notice.Kind, "competency_id", notice.CompetencyID, "count", notified)
+	return notified, nil
+}
+
+// GetByUser retrieves the latest notifications of a user
+func (r *notificationRepository) GetByUser(ctx context.Context, userID int32, unreadOnly bool, limit int32) ([]*domain.Notification, error) {
+	rows, err := r.q(ctx).ListNotifications(ctx, sqlc.ListNotificationsParams{UserID: userID, UnreadOnly: unreadOnly, RowLimit: limit})
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to list notifications", "error", err, "user_id", userID)
+		return nil, fmt.Errorf("%w: %w", ErrGetNotificationsFailed, err)
+	}
+
+	notifications := make([]*domain.Notification, len(rows))
+	for i, row := range rows {
+		notifications[i] = toDomainNotification(row)
+	}
+	return notifications, nil
+}
+
+// MarkRead marks a notification of a user read
+func (r *notificationRepository) MarkRead(ctx context.Context, id, userID int32) (*domain.Notification, error) {
+	row, err := r.q(ctx).MarkNotificationRead(ctx, sqlc.MarkNotificationReadParams{ID: id, UserID: userID})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			r.logger.InfoContext(ctx, "notification not found", "id", id, "user_id", userID)
+			return nil, domain.ErrNotificationNotFound
+		}
+		r.logger.ErrorContext(ctx, "failed to mark notification read", "error", err, "id", id)
+		return nil, fmt.Errorf("%w: %w", ErrUpdateNotificationFailed, err)
+	}
+	return toDomainNotification(row), nil
+}
+
+// toDomainNotification converts SQLC Notification model to domain Notification model
+func toDomainNotification(row sqlc.Notification) *domain.Notification {
+	notification := &domain.Notification{
+		ID:           row.ID,
+		UserID:       row.UserID,
+		Kind:         domain.NotificationKind(row.Kind),
+		CompetencyID: row.CompetencyID,
+		ProposalID:   fromPgInt4(row.ProposalID),
+		ReportID:     fromPgInt4(row.ReportID),
+		CreatedAt:    row.CreatedAt.Time,
+	}
+	if row.ReadAt.Valid {
+		notification.ReadAt = &row.ReadAt.Time
+	}
+	return notification
+}
@@ -0,0 +1,290 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/mehrnoosh-hk/devnorth-back/db/sqlc"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+)
+
+// proposalRepository implements domain.ProposalRepository using SQLC
+// Status transitions are conditional updates, so concurrent requests can't move a proposal twice
+type proposalRepository struct {
+	queries *sqlc.Queries
+	logger  *slog.Logger
+}
+
+// NewProposalRepository creates a new instance of ProposalRepository
+func NewProposalRepository(pool *pgxpool.Pool, logger *slog.Logger) (domain.ProposalRepository, error) {
+	if pool == nil {
+		return nil, ErrPoolNil
+	}
+	if logger == nil {
+		return nil, ErrLoggerNil
+	}
+	return &proposalRepository{
+		queries: sqlc.New(pool),
+		logger:  logger,
+	}, nil
+}
+
+// q returns the queries to run, in the transaction of ctx if there is one (see transactor)
+func (r *proposalRepository) q(ctx context.Context) *sqlc.Queries {
+	return queriesFromContext(ctx, r.queries)
+}
+
+// Create creates a draft proposal
+func (r *proposalRepository) Create(ctx context.Context, kind domain.ProposalKind, proposerID int32, change domain.ProposalChange) (*domain.CompetencyProposal, error) {
+	row, err := r.q(ctx).CreateProposal(ctx, sqlc.CreateProposalParams{
+		Kind:         string(kind),
+		CompetencyID: toPgInt4(change.CompetencyID),
+		ProposerID:   proposerID,
+		Name:         toPgText(change.Name),
+		Description:  toPgText(change.Description),
+		CategoryID:   toPgInt4(change.CategoryID),
+		Rationale:    change.Rationale,
+	})
+	if err != nil {
+		if err := missingReference(err); err != nil {
+			r.logger.InfoContext(ctx, "proposal references a missing row", "error", err, "competency_id", change.CompetencyID, "category_id", change.CategoryID)
+			return nil, err
+		}
+		r.logger.ErrorContext(ctx, "failed to create proposal", "error", err, "proposer_id", proposerID)
+		return nil, fmt.Errorf("%w: %w", ErrCreateProposalFailed, err)
+	}
+
+	r.logger.InfoContext(ctx, "proposal created successfully", "proposal_id", row.ID, "kind", kind)
+	return toDomainProposal(row), nil
+}
+
+// GetByID retrieves a proposal with its comments, locking it when forUpdate is set
+func (r *proposalRepository) GetByID(ctx context.Context, id int32, forUpdate bool) (*domain.CompetencyProposal, error) {
+	var row sqlc.CompetencyProposal
+	var err error
+	if forUpdate {
+		row, err = r.q(ctx).GetProposalForUpdate(ctx, id)
+	} else {
+		row, err = r.q(ctx).GetProposal(ctx, id)
+	}
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			r.logger.InfoContext(ctx, "proposal not found", "id", id)
+			return nil, domain.ErrProposalNotFound
+		}
+		r.logger.ErrorContext(ctx, "failed to get proposal", "error", err, "id", id)
+		return nil, fmt.Errorf("%w: %w", ErrGetProposalFailed, err)
+	}
+	return r.withComments(ctx, row)
+}
+
+// GetByProposer retrieves the proposals of a user, newest first
+func (r *proposalRepository) GetByProposer(ctx context.Context, proposerID int32, status domain.ProposalStatus) ([]*domain.CompetencyProposal, error) {
+	rows, err := r.q(ctx).ListProposalsByProposer(ctx, sqlc.ListProposalsByProposerParams{
+		ProposerID: proposerID,
+		Status:     pgtype.Text{String: string(status), Valid: status != ""},
+	})
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to list proposals", "error", err, "proposer_id", proposerID)
+		return nil, fmt.Errorf("%w: %w", ErrListProposalsFailed, err)
+	}
+	return toDomainProposals(rows), nil
+}
+
+// GetQueue retrieves submitted proposals, oldest submission first
+func (r *proposalRepository) GetQueue(ctx context.Context, limit int32) ([]*domain.CompetencyProposal, error) {
+	rows, err := r.q(ctx).ListSubmittedProposals(ctx, limit)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to list submitted proposals", "error", err)
+		return nil, fmt.Errorf("%w: %w", ErrListProposalsFailed, err)
+	}
+	return toDomainProposals(rows), nil
+}
+
+// UpdateDraft replaces the change of a draft
+func (r *proposalRepository) UpdateDraft(ctx context.Context, id int32, change domain.ProposalChange) (*domain.CompetencyProposal, error) {
+	row, err := r.q(ctx).UpdateProposalDraft(ctx, sqlc.UpdateProposalDraftParams{
+		Name:        toPgText(change.Name),
+		Description: toPgText(change.Description),
+		CategoryID:  toPgInt4(change.CategoryID),
+		Rationale:   change.Rationale,
+		ID:          id,
+	})
+	if err != nil {
+		return nil, r.transitionError(ctx, err, id, "update")
+	}
+	return r.withComments(ctx, row)
+}
+
+// Submit moves a draft to the review queue
+func (r *proposalRepository) Submit(ctx context.Context, id int32) (*domain.CompetencyProposal, error) {
+	row, err := r.q(ctx).SubmitProposal(ctx, id)
+	if err != nil {
+		return nil, r.transitionError(ctx, err, id, "submit")
+	}
+
+	r.logger.InfoContext(ctx, "proposal submitted", "proposal_id", id)
+	return r.withComments(ctx, row)
+}
+
+// Review approves or rejects a submitted proposal
+func (r *proposalRepository) Review(ctx context.Context, id int32, status domain.ProposalStatus, reviewerID, competencyID *int32) (*domain.CompetencyProposal, error) {
+	row, err := r.q(ctx).ReviewProposal(ctx, sqlc.ReviewProposalParams{
+		Status:       string(status),
+		ReviewerID:   toPgInt4(reviewerID),
+		CompetencyID: toPgInt4(competencyID),
+		ID:           id,
+	})
+	if err != nil {
+		return nil, r.transitionError(ctx, err, id, "review")
+	}
+
+	r.logger.InfoContext(ctx, "proposal reviewed", "proposal_id", id, "status", status)
+	return r.withComments(ctx, row)
+}
+
+// Delete deletes a proposal that isn't reviewed yet
+func (r *proposalRepository) Delete(ctx context.Context, id int32) error {
+	deleted, err := r.q(ctx).DeleteProposal(ctx, id)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to delete proposal", "error", err, "id", id)
+		return fmt.Errorf("%w: %w", ErrDeleteProposalFailed, err)
+	}
+	if deleted == 0 {
+		return r.transitionError(ctx, pgx.ErrNoRows, id, "delete")
+	}
+
+	r.logger.InfoContext(ctx, "proposal deleted", "proposal_id", id)
+	return nil
+}
+
+// AddComment adds a comment to a proposal
+func (r *proposalRepository) AddComment(ctx context.Context, proposalID int32, authorID *int32, body string) (*domain.ProposalComment, error) {
+	row, err := r.q(ctx).CreateProposalComment(ctx, sqlc.CreateProposalCommentParams{
+		ProposalID: proposalID,
+		AuthorID:   toPgInt4(authorID),
+		Body:       body,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			r.logger.InfoContext(ctx, "proposal not found for comment", "proposal_id", proposalID)
+			return nil, domain.ErrProposalNotFound
+		}
+		r.logger.ErrorContext(ctx, "failed to add proposal comment", "error", err, "proposal_id", proposalID)
+		return nil, fmt.Errorf("%w: %w", ErrUpdateProposalFailed, err)
+	}
+	return toDomainProposalComment(row), nil
+}
+
+// withComments converts a proposal row to a domain proposal with its comments
+func (r *proposalRepository) withComments(ctx context.Context, row sqlc.CompetencyProposal) (*domain.CompetencyProposal, error) {
+	rows, err := r.q(ctx).ListProposalComments(ctx, row.ID)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to list proposal comments", "error", err, "proposal_id", row.ID)
+		return nil, fmt.Errorf("%w: %w", ErrGetProposalFailed, err)
+	}
+
+	proposal := toDomainProposal(row)
+	proposal.Comments = make([]*domain.ProposalComment, len(rows))
+	for i, comment := range rows {
+		proposal.Comments[i] = toDomainProposalComment(comment)
+	}
+	return proposal, nil
+}
+
+// transitionError tells apart why a conditional update of a proposal matched no row:
+// the proposal doesn't exist, or it isn't in the status the operation needs
+func (r *proposalRepository) transitionError(ctx context.Context, err error, id int32, operation string) error {
+	if !errors.Is(err, pgx.ErrNoRows) {
+		if err := missingReference(err); err != nil {
+			r.logger.InfoContext(ctx, "proposal references a missing row", "error", err, "id", id)
+			return err
+		}
+		r.logger.ErrorContext(ctx, "failed to update proposal", "error", err, "id", id, "operation", operation)
+		return fmt.Errorf("%w: %w", ErrUpdateProposalFailed, err)
+	}
+
+	_, err = r.q(ctx).GetProposal(ctx, id)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		r.logger.InfoContext(ctx, "proposal not found", "id", id)
+		return domain.ErrProposalNotFound
+	case err != nil:
+		r.logger.ErrorContext(ctx, "failed to get proposal", "error", err, "id", id)
+		return fmt.Errorf("%w: %w", ErrGetProposalFailed, err)
+	default:
+		r.logger.InfoContext(ctx, "proposal status conflict", "id", id, "operation", operation)
+		return domain.ErrProposalStatusConflict
+	}
+}
+
+// missingReference maps a foreign key violation of a proposal to the error of the missing row, nil for other errors
+func missingReference(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) || pgErr.Code != "23503" {
+		return nil
+	}
+	if strings.Contains(pgErr.ConstraintName, "category") {
+		return domain.ErrCategoryNotFound
+	}
+	return domain.ErrCompetencyNotFound
+}
+
+// toDomainProposals converts SQLC CompetencyProposal models to domain proposals, without comments
+func toDomainProposals(rows []sqlc.CompetencyProposal) []*domain.CompetencyProposal {
+	proposals := make([]*domain.CompetencyProposal, len(rows))
+	for i, row := range rows {
+		proposals[i] = toDomainProposal(row)
+	}
+	return proposals
+}
+
+// toDomainProposal converts SQLC CompetencyProposal model to domain CompetencyProposal model
+func toDomainProposal(row sqlc.CompetencyProposal) *domain.CompetencyProposal {
+	proposal := &domain.CompetencyProposal{
+		ID:         row.ID,
+		Kind:       domain.ProposalKind(row.Kind),
+		ProposerID: row.ProposerID,
+		Change: domain.ProposalChange{
+			CompetencyID: fromPgInt4(row.CompetencyID),
+			CategoryID:   fromPgInt4(row.CategoryID),
+			Rationale:    row.Rationale,
+		},
+		Status:     domain.ProposalStatus(row.Status),
+		ReviewerID: fromPgInt4(row.ReviewerID),
+		CreatedAt:  row.CreatedAt.Time,
+		UpdatedAt:  row.UpdatedAt.Time,
+	}
+	if row.Name.Valid {
+		proposal.Change.Name = &row.Name.String
+	}
+	if row.Description.Valid {
+		proposal.Change.Description = &row.Description.String
+	}
+	if row.SubmittedAt.Valid {
+		proposal.SubmittedAt = &row.SubmittedAt.Time
+	}
+	if row.ReviewedAt.Valid {
+		proposal.ReviewedAt = &row.ReviewedAt.Time
+	}
+	return proposal
+}
+
+// toDomainProposalComment converts SQLC ProposalComment model to domain ProposalComment model
+func toDomainProposalComment(row sqlc.ProposalComment) *domain.ProposalComment {
+	return &domain.ProposalComment{
+		ID:         row.ID,
+		ProposalID: row.ProposalID,
+		AuthorID:   fromPgInt4(row.AuthorID),
+		Body:       row.Body,
+		CreatedAt:  row.CreatedAt.Time,
+	}
+}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/mehrnoosh-hk/devnorth-back/db/sqlc"
+	"github.com/mehrnoosh-hk/devnorth-back/pkg/ratelimit"
+)
+
+// rateLimitStore implements ratelimit.Store on top of PostgreSQL using SQLC
+// Counters are shared by every API instance, so limits hold across replicas
+// It uses fixed windows with an atomic conditional upsert per request
+type rateLimitStore struct {
+	queries *sqlc.Queries
+	logger  *slog.Logger
+}
+
+// NewRateLimitStore creates a new PostgreSQL-backed rate limit store
+func NewRateLimitStore(pool *pgxpool.Pool, logger *slog.Logger) (ratelimit.Store, error) {
+	if pool == nil {
+		return nil, ErrPoolNil
+	}
+	if logger == nil {
+		return nil, ErrLoggerNil
+	}
+	return &rateLimitStore{
+		queries: sqlc.New(pool),
+		logger:  logger,
+	}, nil
+}
+
+// Allow counts the request in the current window of the key unless the limit is already reached
+func (s *rateLimitStore) Allow(ctx context.Context, key string, limit ratelimit.RateLimit) (bool, error) {
+	params := sqlc.IncrementRateLimitCounterParams{
+		Key:           key,
+		WindowSeconds: int32(math.Ceil(limit.Window.Seconds())),
+		MaxHits:       int32(limit.MaxRequests),
+	}
+
+	_, err := s.queries.IncrementRateLimitCounter(ctx, params)
+	if err != nil {
+		// No row means the conditional update was skipped: the limit is reached
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		s.logger.ErrorContext(ctx, "failed to increment rate limit counter", "error", err, "key", key)
+		return false, fmt.Errorf("%w: %w", ErrIncrementRateLimitFailed, err)
+	}
+
+	return true, nil
+}
+
+// Cleanup deletes counters whose window has ended
+func (s *rateLimitStore) Cleanup(ctx context.Context) error {
+	deleted, err := s.queries.DeleteExpiredRateLimitCounters(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to delete expired rate limit counters", "error", err)
+		return fmt.Errorf("%w: %w", ErrCleanupRateLimitFailed, err)
+	}
+
+	s.logger.DebugContext(ctx, "expired rate limit counters deleted", "count", deleted)
+	return nil
+}
@@ -0,0 +1,191 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/mehrnoosh-hk/devnorth-back/db/sqlc"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+)
+
+// relationRepository implements domain.RelationRepository using SQLC
+type relationRepository struct {
+	queries *sqlc.Queries
+	logger  *slog.Logger
+}
+
+// NewRelationRepository creates a new instance of RelationRepository
+func NewRelationRepository(pool *pgxpool.Pool, logger *slog.Logger) (domain.RelationRepository, error) {
+	if pool == nil {
+		return nil, ErrPoolNil
+	}
+	if logger == nil {
+		return nil, ErrLoggerNil
+	}
+	return &relationRepository{
+		queries: sqlc.New(pool),
+		logger:  logger,
+	}, nil
+}
+
+// q returns the queries to run, in the transaction of ctx if there is one (see transactor)
+func (r *relationRepository) q(ctx context.Context) *sqlc.Queries {
+	return queriesFromContext(ctx, r.queries)
+}
+
+// Lock blocks changes to the relations until the transaction of ctx ends
+func (r *relationRepository) Lock(ctx context.Context) error {
+	if err := r.q(ctx).LockCompetencyRelations(ctx); err != nil {
+		r.logger.ErrorContext(ctx, "failed to lock competency relations", "error", err)
+		return fmt.Errorf("%w: %w", ErrLockRelationsFailed, err)
+	}
+	return nil
+}
+
+// Add adds an edge
+func (r *relationRepository) Add(ctx context.Context, edge domain.CompetencyEdge) error {
+	r.logger.InfoContext(ctx, "adding competency relation", "from", edge.From, "to", edge.To, "kind", edge.Kind)
+
+	err := r.q(ctx).AddCompetencyRelation(ctx, sqlc.AddCompetencyRelationParams{
+		CompetencyID: edge.From,
+		RelatedID:    edge.To,
+		Kind:         string(edge.Kind),
+	})
+	if err != nil {
+		// Check for foreign key violation (unknown competency)
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			r.logger.InfoContext(ctx, "competency not found for relation", "from", edge.From, "to", edge.To)
+			return domain.ErrCompetencyNotFound
+		}
+		r.logger.ErrorContext(ctx, "failed to add competency relation", "error", err, "from", edge.From, "to", edge.To)
+		return fmt.Errorf("%w: %w", ErrUpdateRelationsFailed, err)
+	}
+	return nil
+}
+
+// Remove removes an edge
+func (r *relationRepository) Remove(ctx context.Context, edge domain.CompetencyEdge) error {
+	r.logger.InfoContext(ctx, "removing competency relation", "from", edge.From, "to", edge.To, "kind", edge.Kind)
+
+	removed, err := r.q(ctx).RemoveCompetencyRelation(ctx, sqlc.RemoveCompetencyRelationParams{
+		CompetencyID: edge.From,
+		RelatedID:    edge.To,
+		Kind:         string(edge.Kind),
+	})
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to remove competency relation", "error", err, "from", edge.From, "to", edge.To)
+		return fmt.Errorf("%w: %w", ErrUpdateRelationsFailed, err)
+	}
+	if removed == 0 {
+		r.logger.InfoContext(ctx, "competency relation not found", "from", edge.From, "to", edge.To, "kind", edge.Kind)
+		return domain.ErrRelationNotFound
+	}
+	return nil
+}
+
+// IsPrerequisite reports whether competencyID requires candidateID, directly or transitively
+func (r *relationRepository) IsPrerequisite(ctx context.Context, competencyID, candidateID int32) (bool, error) {
+	found, err := r.q(ctx).IsPrerequisite(ctx, sqlc.IsPrerequisiteParams{CompetencyID: competencyID, CandidateID: candidateID})
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to check prerequisite", "error", err, "competency_id", competencyID, "candidate_id", candidateID)
+		return false, fmt.Errorf("%w: %w", ErrGetRelationsFailed, err)
+	}
+	return found, nil
+}
+
+// GetRelations retrieves the competencies directly linked to a competency
+func (r *relationRepository) GetRelations(ctx context.Context, competencyID int32) (*domain.CompetencyRelations, error) {
+	rows, err := r.q(ctx).ListDirectRelations(ctx, competencyID)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to list competency relations", "error", err, "competency_id", competencyID)
+		return nil, fmt.Errorf("%w: %w", ErrGetRelationsFailed, err)
+	}
+
+	relations := &domain.CompetencyRelations{
+		Requires:   []*domain.CompetencyNode{},
+		RequiredBy: []*domain.CompetencyNode{},
+		Related:    []*domain.CompetencyNode{},
+	}
+	for _, row := range rows {
+		node := &domain.CompetencyNode{ID: row.ID, Name: row.Name}
+		switch row.Direction {
+		case "requires":
+			relations.Requires = append(relations.Requires, node)
+		case "required_by":
+			relations.RequiredBy = append(relations.RequiredBy, node)
+		default:
+			relations.Related = append(relations.Related, node)
+		}
+	}
+	return relations, nil
+}
+
+// GetPrerequisites retrieves the competencies required by those of ids, nearest first
+func (r *relationRepository) GetPrerequisites(ctx context.Context, ids []int32) ([]*domain.CompetencyNode, error) {
+	rows, err := r.q(ctx).ListPrerequisites(ctx, ids)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to list prerequisites", "error", err, "ids", ids)
+		return nil, fmt.Errorf("%w: %w", ErrGetRelationsFailed, err)
+	}
+
+	nodes := make([]*domain.CompetencyNode, len(rows))
+	for i, row := range rows {
+		nodes[i] = &domain.CompetencyNode{ID: row.ID, Name: row.Name, Depth: row.Depth}
+	}
+	return nodes, nil
+}
+
+// GetRequiresEdges retrieves the "requires" edges leaving the competencies of ids
+func (r *relationRepository) GetRequiresEdges(ctx context.Context, ids []int32) ([]domain.CompetencyEdge, error) {
+	rows, err := r.q(ctx).ListRequiresEdges(ctx, ids)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to list prerequisite edges", "error", err)
+		return nil, fmt.Errorf("%w: %w", ErrGetRelationsFailed, err)
+	}
+
+	edges := make([]domain.CompetencyEdge, len(rows))
+	for i, row := range rows {
+		edges[i] = domain.CompetencyEdge{From: row.CompetencyID, To: row.RelatedID, Kind: domain.RelationRequires}
+	}
+	return edges, nil
+}
+
+// GetNodes retrieves the competencies of ids, ordered by name
+func (r *relationRepository) GetNodes(ctx context.Context, ids []int32) ([]*domain.CompetencyNode, error) {
+	rows, err := r.q(ctx).ListCompetencyNames(ctx, ids)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to list competency names", "error", err)
+		return nil, fmt.Errorf("%w: %w", ErrGetRelationsFailed, err)
+	}
+
+	nodes := make([]*domain.CompetencyNode, len(rows))
+	for i, row := range rows {
+		nodes[i] = &domain.CompetencyNode{ID: row.ID, Name: row.Name}
+	}
+	return nodes, nil
+}
+
+// GetGraph retrieves the edges between competencies that aren't archived, and those competencies
+func (r *relationRepository) GetGraph(ctx context.Context) (*domain.CompetencyGraph, error) {
+	rows, err := r.q(ctx).ListGraphEdges(ctx)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to list competency graph edges", "error", err)
+		return nil, fmt.Errorf("%w: %w", ErrGetRelationsFailed, err)
+	}
+
+	graph := &domain.CompetencyGraph{Edges: make([]domain.CompetencyEdge, len(rows))}
+	ids := make([]int32, 0, 2*len(rows))
+	for i, row := range rows {
+		graph.Edges[i] = domain.CompetencyEdge{From: row.CompetencyID, To: row.RelatedID, Kind: domain.RelationKind(row.Kind)}
+		ids = append(ids, row.CompetencyID, row.RelatedID)
+	}
+	if graph.Nodes, err = r.GetNodes(ctx, ids); err != nil {
+		return nil, err
+	}
+	return graph, nil
+}
@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/mehrnoosh-hk/devnorth-back/db/sqlc"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+)
+
+// revisionRepository implements domain.RevisionRepository using SQLC
+// Snapshots are taken by the database (competency_snapshot), so a revision always matches the row it records
+type revisionRepository struct {
+	queries *sqlc.Queries
+	logger  *slog.Logger
+}
+
+// NewRevisionRepository creates a new instance of RevisionRepository
+func NewRevisionRepository(pool *pgxpool.Pool, logger *slog.Logger) (domain.RevisionRepository, error) {
+	if pool == nil {
+		return nil, ErrPoolNil
+	}
+	if logger == nil {
+		return nil, ErrLoggerNil
+	}
+	return &revisionRepository{
+		queries: sqlc.New(pool),
+		logger:  logger,
+	}, nil
+}
+
+// q returns the queries to run, in the transaction of ctx if there is one (see transactor)
+func (r *revisionRepository) q(ctx context.Context) *sqlc.Queries {
+	return queriesFromContext(ctx, r.queries)
+}
+
+// Record records the current state of the competencies as their next revision
+func (r *revisionRepository) Record(ctx context.Context, change domain.RevisionChange, competencyIDs ...int32) error {
+	recorded, err := r.q(ctx).RecordCompetencyRevisions(ctx, sqlc.RecordCompetencyRevisionsParams{
+		Action:        string(change.Action),
+		EditorID:      toPgInt4(change.EditorID),
+		RevertedFrom:  toPgInt4(change.RevertedFrom),
+		MergedID:      toPgInt4(change.MergedID),
+		CompetencyIds: competencyIDs,
+	})
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to record competency revisions", "error", err, "action", change.Action)
+		return fmt.Errorf("%w: %w", ErrRecordRevisionFailed, err)
+	}
+
+	r.logger.InfoContext(ctx, "competency revisions recorded", "action", change.Action, "recorded", recorded)
+	return nil
+}
+
+// GetAll retrieves revisions of a competency, newest first
+func (r *revisionRepository) GetAll(ctx context.Context, competencyID, before, limit int32) ([]*domain.CompetencyRevision, error) {
+	rows, err := r.q(ctx).ListCompetencyRevisions(ctx, sqlc.ListCompetencyRevisionsParams{
+		CompetencyID:   competencyID,
+		BeforeRevision: pgtype.Int4{Int32: before, Valid: before > 0},
+		RowLimit:       limit,
+	})
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to list competency revisions", "error", err, "competency_id", competencyID)
+		return nil, fmt.Errorf("%w: %w", ErrListRevisionsFailed, err)
+	}
+
+	revisions := make([]*domain.CompetencyRevision, len(rows))
+	for i, row := range rows {
+		if revisions[i], err = toDomainRevision(row); err != nil {
+			r.logger.ErrorContext(ctx, "failed to decode competency revision", "error", err, "competency_id", competencyID, "revision", row.Revision)
+			return nil, err
+		}
+	}
+	return revisions, nil
+}
+
+// GetByRevision retrieves a revision of a competency
+func (r *revisionRepository) GetByRevision(ctx context.Context, competencyID, revision int32) (*domain.CompetencyRevision, error) {
+	row, err := r.q(ctx).GetCompetencyRevision(ctx, sqlc.GetCompetencyRevisionParams{CompetencyID: competencyID, Revision: revision})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			r.logger.InfoContext(ctx, "competency revision not found", "competency_id", competencyID, "revision", revision)
+			return nil, domain.ErrRevisionNotFound
+		}
+		r.logger.ErrorContext(ctx, "failed to get competency revision", "error", err, "competency_id", competencyID, "revision", revision)
+		return nil, fmt.Errorf("%w: %w", ErrGetRevisionFailed, err)
+	}
+
+	result, err := toDomainRevision(row)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to decode competency revision", "error", err, "competency_id", competencyID, "revision", revision)
+		return nil, err
+	}
+	return result, nil
+}
+
+// toDomainRevision converts SQLC CompetencyRevision model to domain CompetencyRevision model
+func toDomainRevision(row sqlc.CompetencyRevision) (*domain.CompetencyRevision, error) {
+	revision := &domain.CompetencyRevision{
+		CompetencyID: row.CompetencyID,
+		Revision:     row.Revision,
+		Action:       domain.RevisionAction(row.Action),
+		CreatedAt:    row.CreatedAt.Time,
+	}
+	if row.EditorID.Valid {
+		editorID := row.EditorID.Int32
+		revision.EditorID = &editorID
+	}
+	if row.RevertedFrom.Valid {
+		revertedFrom := row.RevertedFrom.Int32
+		revision.RevertedFrom = &revertedFrom
+	}
+	if row.MergedID.Valid {
+		mergedID := row.MergedID.Int32
+		revision.MergedID = &mergedID
+	}
+	if row.Before != nil {
+		revision.Before = &domain.CompetencySnapshot{}
+		if err := json.Unmarshal(row.Before, revision.Before); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrDecodeRevisionFailed, err)
+		}
+	}
+	if err := json.Unmarshal(row.After, &revision.After); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrDecodeRevisionFailed, err)
+	}
+	return revision, nil
+}
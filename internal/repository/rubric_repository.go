@@ -0,0 +1,350 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/mehrnoosh-hk/devnorth-back/db/sqlc"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+)
+
+// rubricRepository implements domain.RubricRepository using SQLC
+type rubricRepository struct {
+	queries *sqlc.Queries
+	logger  *slog.Logger
+}
+
+// NewRubricRepository creates a new instance of RubricRepository
+func NewRubricRepository(pool *pgxpool.Pool, logger *slog.Logger) (domain.RubricRepository, error) {
+	if pool == nil {
+		return nil, ErrPoolNil
+	}
+	if logger == nil {
+		return nil, ErrLoggerNil
+	}
+	return &rubricRepository{
+		queries: sqlc.New(pool),
+		logger:  logger,
+	}, nil
+}
+
+// q returns the queries to run, in the transaction of ctx if there is one (see transactor)
+func (r *rubricRepository) q(ctx context.Context) *sqlc.Queries {
+	return queriesFromContext(ctx, r.queries)
+}
+
+// CreateScale creates a rating scale without levels
+func (r *rubricRepository) CreateScale(ctx context.Context, name, description string) (*domain.RatingScale, error) {
+	r.logger.InfoContext(ctx, "creating rating scale", "name", name)
+
+	sqlcScale, err := r.q(ctx).CreateRatingScale(ctx, sqlc.CreateRatingScaleParams{Name: name, Description: description})
+	if err != nil {
+		// Check for unique constraint violation (duplicate name)
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			r.logger.WarnContext(ctx, "duplicate rating scale name", "name", name)
+			return nil, domain.ErrRatingScaleAlreadyExists
+		}
+		r.logger.ErrorContext(ctx, "failed to create rating scale", "error", err, "name", name)
+		return nil, fmt.Errorf("%w: %w", ErrCreateRatingScaleFailed, err)
+	}
+
+	r.logger.InfoContext(ctx, "rating scale created successfully", "scale_id", sqlcScale.ID)
+	return toDomainRatingScale(sqlcScale, nil), nil
+}
+
+// GetScale retrieves a rating scale with its levels
+func (r *rubricRepository) GetScale(ctx context.Context, id int32) (*domain.RatingScale, error) {
+	sqlcScale, err := r.q(ctx).GetRatingScaleByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			r.logger.InfoContext(ctx, "rating scale not found", "id", id)
+			return nil, domain.ErrRatingScaleNotFound
+		}
+		r.logger.ErrorContext(ctx, "failed to get rating scale by ID", "error", err, "id", id)
+		return nil, fmt.Errorf("%w: %w", ErrGetRatingScaleFailed, err)
+	}
+
+	levels, err := r.scaleLevels(ctx, []int32{id})
+	if err != nil {
+		return nil, err
+	}
+	return toDomainRatingScale(sqlcScale, levels[id]), nil
+}
+
+// GetScales retrieves every rating scale with its levels
+func (r *rubricRepository) GetScales(ctx context.Context) ([]*domain.RatingScale, error) {
+	sqlcScales, err := r.q(ctx).ListRatingScales(ctx)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to list rating scales", "error", err)
+		return nil, fmt.Errorf("%w: %w", ErrGetRatingScaleFailed, err)
+	}
+
+	ids := make([]int32, len(sqlcScales))
+	for i, sqlcScale := range sqlcScales {
+		ids[i] = sqlcScale.ID
+	}
+	levels, err := r.scaleLevels(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	scales := make([]*domain.RatingScale, len(sqlcScales))
+	for i, sqlcScale := range sqlcScales {
+		scales[i] = toDomainRatingScale(sqlcScale, levels[sqlcScale.ID])
+	}
+	return scales, nil
+}
+
+// scaleLevels retrieves the levels of the given scales in one query, in order
+func (r *rubricRepository) scaleLevels(ctx context.Context, scaleIDs []int32) (map[int32][]domain.RatingLevel, error) {
+	rows, err := r.q(ctx).ListRatingScaleLevels(ctx, scaleIDs)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to list rating scale levels", "error", err)
+		return nil, fmt.Errorf("%w: %w", ErrGetRatingScaleFailed, err)
+	}
+
+	levels := make(map[int32][]domain.RatingLevel, len(scaleIDs))
+	for _, row := range rows {
+		levels[row.ScaleID] = append(levels[row.ScaleID], domain.RatingLevel{Value: row.Value, Label: row.Label})
+	}
+	return levels, nil
+}
+
+// UpdateScale updates the name and description of a rating scale
+func (r *rubricRepository) UpdateScale(ctx context.Context, id int32, name, description string) (*domain.RatingScale, error) {
+	r.logger.InfoContext(ctx, "updating rating scale", "id", id)
+
+	sqlcScale, err := r.q(ctx).UpdateRatingScale(ctx, sqlc.UpdateRatingScaleParams{ID: id, Name: name, Description: description})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			r.logger.InfoContext(ctx, "rating scale not found", "id", id)
+			return nil, domain.ErrRatingScaleNotFound
+		}
+		// Check for unique constraint violation (duplicate name)
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			r.logger.WarnContext(ctx, "duplicate rating scale name", "name", name)
+			return nil, domain.ErrRatingScaleAlreadyExists
+		}
+		r.logger.ErrorContext(ctx, "failed to update rating scale", "error", err, "id", id)
+		return nil, fmt.Errorf("%w: %w", ErrUpdateRatingScaleFailed, err)
+	}
+
+	r.logger.InfoContext(ctx, "rating scale updated successfully", "scale_id", sqlcScale.ID)
+	return toDomainRatingScale(sqlcScale, nil), nil
+}
+
+// SetScaleLevels replaces the levels of a rating scale
+// Levels are removed before the others are upserted, and only when they are left out, so described levels that
+// are kept never stop existing
+func (r *rubricRepository) SetScaleLevels(ctx context.Context, scaleID int32, levels []domain.RatingLevel) error {
+	values := make([]int32, len(levels))
+	labels := make([]string, len(levels))
+	for i, level := range levels {
+		values[i] = level.Value
+		labels[i] = level.Label
+	}
+
+	err := r.q(ctx).DeleteRatingScaleLevelsExcept(ctx, sqlc.DeleteRatingScaleLevelsExceptParams{ScaleID: scaleID, LevelValues: values})
+	if err != nil {
+		// Check for foreign key violation (a competency describes a removed level)
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			r.logger.WarnContext(ctx, "rating scale level still described", "scale_id", scaleID)
+			return domain.ErrRatingScaleInUse
+		}
+		r.logger.ErrorContext(ctx, "failed to delete rating scale levels", "error", err, "scale_id", scaleID)
+		return fmt.Errorf("%w: %w", ErrUpdateRatingScaleFailed, err)
+	}
+
+	err = r.q(ctx).UpsertRatingScaleLevels(ctx, sqlc.UpsertRatingScaleLevelsParams{ScaleID: scaleID, LevelValues: values, Labels: labels})
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to upsert rating scale levels", "error", err, "scale_id", scaleID)
+		return fmt.Errorf("%w: %w", ErrUpdateRatingScaleFailed, err)
+	}
+	return nil
+}
+
+// DeleteScale deletes a rating scale
+func (r *rubricRepository) DeleteScale(ctx context.Context, id int32) error {
+	r.logger.InfoContext(ctx, "deleting rating scale", "id", id)
+
+	deleted, err := r.q(ctx).DeleteRatingScale(ctx, id)
+	if err != nil {
+		// Check for foreign key violation (used by a rubric)
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			r.logger.WarnContext(ctx, "rating scale still used", "id", id)
+			return domain.ErrRatingScaleInUse
+		}
+		r.logger.ErrorContext(ctx, "failed to delete rating scale", "error", err, "id", id)
+		return fmt.Errorf("%w: %w", ErrDeleteRatingScaleFailed, err)
+	}
+	if deleted == 0 {
+		r.logger.InfoContext(ctx, "rating scale not found", "id", id)
+		return domain.ErrRatingScaleNotFound
+	}
+
+	r.logger.InfoContext(ctx, "rating scale deleted successfully", "scale_id", id)
+	return nil
+}
+
+// GetRubric retrieves the rubric of a competency, merging the levels of its scale with their descriptions
+func (r *rubricRepository) GetRubric(ctx context.Context, competencyID int32) (*domain.CompetencyRubric, error) {
+	sqlcRubric, err := r.q(ctx).GetCompetencyRubric(ctx, competencyID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrRubricNotFound
+		}
+		r.logger.ErrorContext(ctx, "failed to get competency rubric", "error", err, "competency_id", competencyID)
+		return nil, fmt.Errorf("%w: %w", ErrGetRubricFailed, err)
+	}
+
+	scale, err := r.GetScale(ctx, sqlcRubric.ScaleID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.q(ctx).ListCompetencyLevels(ctx, competencyID)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to list competency levels", "error", err, "competency_id", competencyID)
+		return nil, fmt.Errorf("%w: %w", ErrGetRubricFailed, err)
+	}
+	described := make(map[int32]sqlc.CompetencyLevel, len(rows))
+	for _, row := range rows {
+		described[row.Level] = row
+	}
+
+	rubric := &domain.CompetencyRubric{
+		Scale:     scale,
+		Levels:    make([]domain.CompetencyLevel, len(scale.Levels)),
+		UpdatedAt: sqlcRubric.UpdatedAt.Time,
+	}
+	for i, level := range scale.Levels {
+		rubric.Levels[i] = domain.CompetencyLevel{Value: level.Value, Label: level.Label, Indicators: []string{}}
+		if row, ok := described[level.Value]; ok {
+			rubric.Levels[i].Description = row.Description
+			rubric.Levels[i].Indicators = row.Indicators
+		}
+	}
+	return rubric, nil
+}
+
+// SetRubricScale creates the rubric of a competency or changes its scale
+func (r *rubricRepository) SetRubricScale(ctx context.Context, competencyID, scaleID int32) error {
+	_, err := r.q(ctx).UpsertCompetencyRubric(ctx, sqlc.UpsertCompetencyRubricParams{CompetencyID: competencyID, ScaleID: scaleID})
+	if err != nil {
+		// Check for foreign key violation (competency or scale deleted meanwhile)
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			if strings.Contains(pgErr.ConstraintName, "scale") {
+				return domain.ErrRatingScaleNotFound
+			}
+			return domain.ErrCompetencyNotFound
+		}
+		r.logger.ErrorContext(ctx, "failed to set competency rubric scale", "error", err, "competency_id", competencyID)
+		return fmt.Errorf("%w: %w", ErrUpdateRubricFailed, err)
+	}
+	return nil
+}
+
+// SetRubricLevel creates or replaces the description of one level
+func (r *rubricRepository) SetRubricLevel(ctx context.Context, competencyID, scaleID int32, level domain.CompetencyLevel) error {
+	indicators := level.Indicators
+	if indicators == nil {
+		indicators = []string{}
+	}
+	err := r.q(ctx).UpsertCompetencyLevel(ctx, sqlc.UpsertCompetencyLevelParams{
+		CompetencyID: competencyID,
+		ScaleID:      scaleID,
+		Level:        level.Value,
+		Description:  level.Description,
+		Indicators:   indicators,
+	})
+	if err != nil {
+		// Check for foreign key violation (the scale has no such level)
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			r.logger.InfoContext(ctx, "unknown rating level", "competency_id", competencyID, "level", level.Value)
+			return domain.ErrUnknownRatingLevel
+		}
+		r.logger.ErrorContext(ctx, "failed to set competency level", "error", err, "competency_id", competencyID)
+		return fmt.Errorf("%w: %w", ErrUpdateRubricFailed, err)
+	}
+	return nil
+}
+
+// DeleteRubricLevels deletes every level description of a competency rubric
+func (r *rubricRepository) DeleteRubricLevels(ctx context.Context, competencyID int32) error {
+	if err := r.q(ctx).DeleteCompetencyLevels(ctx, competencyID); err != nil {
+		r.logger.ErrorContext(ctx, "failed to delete competency levels", "error", err, "competency_id", competencyID)
+		return fmt.Errorf("%w: %w", ErrUpdateRubricFailed, err)
+	}
+	return nil
+}
+
+// DeleteRubricLevel deletes the description of one level
+func (r *rubricRepository) DeleteRubricLevel(ctx context.Context, competencyID, value int32) error {
+	deleted, err := r.q(ctx).DeleteCompetencyLevel(ctx, sqlc.DeleteCompetencyLevelParams{CompetencyID: competencyID, Level: value})
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to delete competency level", "error", err, "competency_id", competencyID)
+		return fmt.Errorf("%w: %w", ErrUpdateRubricFailed, err)
+	}
+	if deleted == 0 {
+		return domain.ErrCompetencyLevelNotFound
+	}
+	return nil
+}
+
+// DeleteRubric deletes the rubric of a competency
+func (r *rubricRepository) DeleteRubric(ctx context.Context, competencyID int32) error {
+	deleted, err := r.q(ctx).DeleteCompetencyRubric(ctx, competencyID)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to delete competency rubric", "error", err, "competency_id", competencyID)
+		return fmt.Errorf("%w: %w", ErrUpdateRubricFailed, err)
+	}
+	if deleted == 0 {
+		return domain.ErrRubricNotFound
+	}
+	return nil
+}
+
+// TouchCompetency bumps the version of a competency
+func (r *rubricRepository) TouchCompetency(ctx context.Context, competencyID int32) error {
+	if err := r.q(ctx).TouchCompetency(ctx, competencyID); err != nil {
+		r.logger.ErrorContext(ctx, "failed to touch competency", "error", err, "competency_id", competencyID)
+		return fmt.Errorf("%w: %w", ErrUpdateRubricFailed, err)
+	}
+	return nil
+}
+
+// TouchScaleCompetencies bumps the version of the competencies using a scale
+func (r *rubricRepository) TouchScaleCompetencies(ctx context.Context, scaleID int32) error {
+	if err := r.q(ctx).TouchCompetenciesByScale(ctx, scaleID); err != nil {
+		r.logger.ErrorContext(ctx, "failed to touch competencies of rating scale", "error", err, "scale_id", scaleID)
+		return fmt.Errorf("%w: %w", ErrUpdateRatingScaleFailed, err)
+	}
+	return nil
+}
+
+// toDomainRatingScale converts SQLC RatingScale model to domain RatingScale model
+func toDomainRatingScale(sqlcScale sqlc.RatingScale, levels []domain.RatingLevel) *domain.RatingScale {
+	if levels == nil {
+		levels = []domain.RatingLevel{}
+	}
+	return &domain.RatingScale{
+		ID:          sqlcScale.ID,
+		Name:        sqlcScale.Name,
+		Description: sqlcScale.Description,
+		Levels:      levels,
+		CreatedAt:   sqlcScale.CreatedAt.Time,
+		UpdatedAt:   sqlcScale.UpdatedAt.Time,
+	}
+}
@@ -0,0 +1,171 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/mehrnoosh-hk/devnorth-back/db/sqlc"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+)
+
+// stewardRepository implements domain.StewardRepository using SQLC
+type stewardRepository struct {
+	queries *sqlc.Queries
+	logger  *slog.Logger
+}
+
+// NewStewardRepository creates a new instance of StewardRepository
+func NewStewardRepository(pool *pgxpool.Pool, logger *slog.Logger) (domain.StewardRepository, error) {
+	if pool == nil {
+		return nil, ErrPoolNil
+	}
+	if logger == nil {
+		return nil, ErrLoggerNil
+	}
+	return &stewardRepository{
+		queries: sqlc.New(pool),
+		logger:  logger,
+	}, nil
+}
+
+// q returns the queries to run, in the transaction of ctx if there is one (see transactor)
+func (r *stewardRepository) q(ctx context.Context) *sqlc.Queries {
+	return queriesFromContext(ctx, r.queries)
+}
+
+// Assign makes a user a steward of a competency
+func (r *stewardRepository) Assign(ctx context.Context, competencyID, userID int32, assignedBy *int32) error {
+	assigned, err := r.q(ctx).AssignSteward(ctx, sqlc.AssignStewardParams{
+		CompetencyID: competencyID,
+		UserID:       userID,
+		AssignedBy:   toPgInt4(assignedBy),
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			r.logger.InfoContext(ctx, "steward references a missing row", "constraint", pgErr.ConstraintName, "competency_id", competencyID, "user_id", userID)
+			if strings.Contains(pgErr.ConstraintName, "user_id") {
+				return domain.ErrUserNotFound
+			}
+			return domain.ErrCompetencyNotFound
+		}
+		r.logger.ErrorContext(ctx, "failed to assign steward", "error", err, "competency_id", competencyID, "user_id", userID)
+		return fmt.Errorf("%w: %w", ErrUpdateStewardsFailed, err)
+	}
+
+	r.logger.InfoContext(ctx, "steward assigned", "competency_id", competencyID, "user_id", userID, "already_assigned", assigned == 0)
+	return nil
+}
+
+// Unassign removes a steward of a competency
+func (r *stewardRepository) Unassign(ctx context.Context, competencyID, userID int32) error {
+	removed, err := r.q(ctx).UnassignSteward(ctx, sqlc.UnassignStewardParams{CompetencyID: competencyID, UserID: userID})
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to unassign steward", "error", err, "competency_id", competencyID, "user_id", userID)
+		return fmt.Errorf("%w: %w", ErrUpdateStewardsFailed, err)
+	}
+	if removed == 0 {
+		r.logger.InfoContext(ctx, "steward not found", "competency_id", competencyID, "user_id", userID)
+		return domain.ErrStewardNotFound
+	}
+
+	r.logger.InfoContext(ctx, "steward unassigned", "competency_id", competencyID, "user_id", userID)
+	return nil
+}
+
+// GetByCompetency retrieves the stewards of a competency
+func (r *stewardRepository) GetByCompetency(ctx context.Context, competencyID int32) ([]*domain.CompetencySteward, error) {
+	rows, err := r.q(ctx).ListCompetencyStewards(ctx, competencyID)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to list stewards", "error", err, "competency_id", competencyID)
+		return nil, fmt.Errorf("%w: %w", ErrGetStewardsFailed, err)
+	}
+
+	stewards := make([]*domain.CompetencySteward, len(rows))
+	for i, row := range rows {
+		stewards[i] = &domain.CompetencySteward{
+			CompetencyID: row.CompetencyID,
+			UserID:       row.UserID,
+			AssignedBy:   fromPgInt4(row.AssignedBy),
+			AssignedAt:   row.AssignedAt.Time,
+		}
+	}
+	return stewards, nil
+}
+
+// IsSteward reports whether a user is a steward of a competency
+func (r *stewardRepository) IsSteward(ctx context.Context, competencyID, userID int32) (bool, error) {
+	steward, err := r.q(ctx).IsSteward(ctx, sqlc.IsStewardParams{CompetencyID: competencyID, UserID: userID})
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to check steward", "error", err, "competency_id", competencyID, "user_id", userID)
+		return false, fmt.Errorf("%w: %w", ErrGetStewardsFailed, err)
+	}
+	return steward, nil
+}
+
+// GetStewarded retrieves the competencies a user is a steward of
+func (r *stewardRepository) GetStewarded(ctx context.Context, userID int32) ([]*domain.Competency, error) {
+	rows, err := r.q(ctx).ListStewardedCompetencies(ctx, userID)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to list stewarded competencies", "error", err, "user_id", userID)
+		return nil, fmt.Errorf("%w: %w", ErrGetStewardsFailed, err)
+	}
+
+	competencies := make([]*domain.Competency, len(rows))
+	for i, row := range rows {
+		competencies[i] = toDomainCompetency(competencyRow(row))
+	}
+	return competencies, nil
+}
+
+// CreateReport records that a competency is outdated
+func (r *stewardRepository) CreateReport(ctx context.Context, competencyID int32, reporterID *int32, reason string) (*domain.CompetencyReport, error) {
+	row, err := r.q(ctx).CreateCompetencyReport(ctx, sqlc.CreateCompetencyReportParams{
+		CompetencyID: competencyID,
+		ReporterID:   toPgInt4(reporterID),
+		Reason:       reason,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			r.logger.InfoContext(ctx, "competency not found for report", "competency_id", competencyID)
+			return nil, domain.ErrCompetencyNotFound
+		}
+		r.logger.ErrorContext(ctx, "failed to create competency report", "error", err, "competency_id", competencyID)
+		return nil, fmt.Errorf("%w: %w", ErrCreateReportFailed, err)
+	}
+
+	r.logger.InfoContext(ctx, "competency report created", "report_id", row.ID, "competency_id", competencyID)
+	return toDomainCompetencyReport(row), nil
+}
+
+// GetReports retrieves the latest reports of a competency
+func (r *stewardRepository) GetReports(ctx context.Context, competencyID, limit int32) ([]*domain.CompetencyReport, error) {
+	rows, err := r.q(ctx).ListCompetencyReports(ctx, sqlc.ListCompetencyReportsParams{CompetencyID: competencyID, RowLimit: limit})
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to list competency reports", "error", err, "competency_id", competencyID)
+		return nil, fmt.Errorf("%w: %w", ErrGetReportsFailed, err)
+	}
+
+	reports := make([]*domain.CompetencyReport, len(rows))
+	for i, row := range rows {
+		reports[i] = toDomainCompetencyReport(row)
+	}
+	return reports, nil
+}
+
+// toDomainCompetencyReport converts SQLC CompetencyReport model to domain CompetencyReport model
+func toDomainCompetencyReport(row sqlc.CompetencyReport) *domain.CompetencyReport {
+	return &domain.CompetencyReport{
+		ID:           row.ID,
+		CompetencyID: row.CompetencyID,
+		ReporterID:   fromPgInt4(row.ReporterID),
+		Reason:       row.Reason,
+		CreatedAt:    row.CreatedAt.Time,
+	}
+}
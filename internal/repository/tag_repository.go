@@ -0,0 +1,212 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/mehrnoosh-hk/devnorth-back/db/sqlc"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+)
+
+// tagRepository implements domain.TagRepository using SQLC
+type tagRepository struct {
+	queries *sqlc.Queries
+	logger  *slog.Logger
+}
+
+// NewTagRepository creates a new instance of TagRepository
+func NewTagRepository(pool *pgxpool.Pool, logger *slog.Logger) (domain.TagRepository, error) {
+	if pool == nil {
+		return nil, ErrPoolNil
+	}
+	if logger == nil {
+		return nil, ErrLoggerNil
+	}
+	return &tagRepository{
+		queries: sqlc.New(pool),
+		logger:  logger,
+	}, nil
+}
+
+// q returns the queries to run, in the transaction of ctx if there is one (see transactor)
+func (r *tagRepository) q(ctx context.Context) *sqlc.Queries {
+	return queriesFromContext(ctx, r.queries)
+}
+
+// Create creates a tag
+func (r *tagRepository) Create(ctx context.Context, name string, curated bool) (*domain.Tag, error) {
+	r.logger.InfoContext(ctx, "creating tag", "name", name, "curated", curated)
+
+	sqlcTag, err := r.q(ctx).CreateTag(ctx, sqlc.CreateTagParams{Name: name, Curated: curated})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			r.logger.WarnContext(ctx, "duplicate tag name", "name", name)
+			return nil, domain.ErrTagAlreadyExists
+		}
+		r.logger.ErrorContext(ctx, "failed to create tag", "error", err, "name", name)
+		return nil, fmt.Errorf("%w: %w", ErrCreateTagFailed, err)
+	}
+
+	r.logger.InfoContext(ctx, "tag created successfully", "tag_id", sqlcTag.ID)
+	return toDomainTag(sqlcTag), nil
+}
+
+// GetByID retrieves a tag with its usage count
+func (r *tagRepository) GetByID(ctx context.Context, id int32) (*domain.Tag, error) {
+	row, err := r.q(ctx).GetTagByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			r.logger.InfoContext(ctx, "tag not found", "id", id)
+			return nil, domain.ErrTagNotFound
+		}
+		r.logger.ErrorContext(ctx, "failed to get tag by ID", "error", err, "id", id)
+		return nil, fmt.Errorf("%w: %w", ErrGetTagFailed, err)
+	}
+	return toDomainTagUsage(sqlc.ListTagsRow(row)), nil
+}
+
+// GetAll retrieves the tags matching filter, most used first
+func (r *tagRepository) GetAll(ctx context.Context, filter domain.TagFilter) ([]*domain.Tag, error) {
+	params := sqlc.ListTagsParams{RowLimit: filter.Limit}
+	if filter.NamePrefix != "" {
+		params.NamePattern = pgtype.Text{String: escapeLike(filter.NamePrefix) + "%", Valid: true}
+	}
+	if filter.Curated != nil {
+		params.Curated = pgtype.Bool{Bool: *filter.Curated, Valid: true}
+	}
+
+	rows, err := r.q(ctx).ListTags(ctx, params)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to list tags", "error", err)
+		return nil, fmt.Errorf("%w: %w", ErrListTagsFailed, err)
+	}
+
+	tags := make([]*domain.Tag, len(rows))
+	for i, row := range rows {
+		tags[i] = toDomainTagUsage(row)
+	}
+	return tags, nil
+}
+
+// Update renames a tag and sets whether it is curated
+func (r *tagRepository) Update(ctx context.Context, id int32, name string, curated bool) (*domain.Tag, error) {
+	sqlcTag, err := r.q(ctx).UpdateTag(ctx, sqlc.UpdateTagParams{Name: name, Curated: curated, ID: id})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			r.logger.InfoContext(ctx, "tag not found", "id", id)
+			return nil, domain.ErrTagNotFound
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			r.logger.WarnContext(ctx, "duplicate tag name", "name", name)
+			return nil, domain.ErrTagAlreadyExists
+		}
+		r.logger.ErrorContext(ctx, "failed to update tag", "error", err, "id", id)
+		return nil, fmt.Errorf("%w: %w", ErrUpdateTagFailed, err)
+	}
+	return toDomainTag(sqlcTag), nil
+}
+
+// Delete deletes a tag; its competency links are removed by the database
+func (r *tagRepository) Delete(ctx context.Context, id int32) error {
+	rows, err := r.q(ctx).DeleteTag(ctx, id)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to delete tag", "error", err, "id", id)
+		return fmt.Errorf("%w: %w", ErrDeleteTagFailed, err)
+	}
+	if rows == 0 {
+		r.logger.InfoContext(ctx, "tag not found", "id", id)
+		return domain.ErrTagNotFound
+	}
+	return nil
+}
+
+// Ensure retrieves the tags with the given names, creating the missing ones
+func (r *tagRepository) Ensure(ctx context.Context, names []string) ([]*domain.Tag, error) {
+	sqlcTags, err := r.q(ctx).EnsureTags(ctx, names)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to ensure tags", "error", err, "names", names)
+		return nil, fmt.Errorf("%w: %w", ErrCreateTagFailed, err)
+	}
+
+	tags := make([]*domain.Tag, len(sqlcTags))
+	for i, sqlcTag := range sqlcTags {
+		tags[i] = toDomainTag(sqlcTag)
+	}
+	return tags, nil
+}
+
+// GetByCompetency retrieves the tags of a competency
+func (r *tagRepository) GetByCompetency(ctx context.Context, competencyID int32) ([]*domain.Tag, error) {
+	rows, err := r.q(ctx).ListCompetencyTags(ctx, competencyID)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to list competency tags", "error", err, "competency_id", competencyID)
+		return nil, fmt.Errorf("%w: %w", ErrListTagsFailed, err)
+	}
+
+	tags := make([]*domain.Tag, len(rows))
+	for i, row := range rows {
+		tags[i] = toDomainTagUsage(sqlc.ListTagsRow(row))
+	}
+	return tags, nil
+}
+
+// AddToCompetency adds tags to a competency
+func (r *tagRepository) AddToCompetency(ctx context.Context, competencyID int32, tagIDs []int32) error {
+	err := r.q(ctx).AddCompetencyTags(ctx, sqlc.AddCompetencyTagsParams{CompetencyID: competencyID, TagIds: tagIDs})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			r.logger.InfoContext(ctx, "competency not found", "competency_id", competencyID)
+			return domain.ErrCompetencyNotFound
+		}
+		r.logger.ErrorContext(ctx, "failed to tag competency", "error", err, "competency_id", competencyID)
+		return fmt.Errorf("%w: %w", ErrUpdateCompetencyTagsFailed, err)
+	}
+	return nil
+}
+
+// RemoveFromCompetency removes a tag from a competency
+func (r *tagRepository) RemoveFromCompetency(ctx context.Context, competencyID, tagID int32) error {
+	rows, err := r.q(ctx).RemoveCompetencyTag(ctx, sqlc.RemoveCompetencyTagParams{CompetencyID: competencyID, TagID: tagID})
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to untag competency", "error", err, "competency_id", competencyID, "tag_id", tagID)
+		return fmt.Errorf("%w: %w", ErrUpdateCompetencyTagsFailed, err)
+	}
+	if rows == 0 {
+		r.logger.InfoContext(ctx, "competency doesn't carry the tag", "competency_id", competencyID, "tag_id", tagID)
+		return domain.ErrTagNotFound
+	}
+	return nil
+}
+
+// toDomainTag converts SQLC Tag model to domain Tag model, without usage count
+func toDomainTag(sqlcTag sqlc.Tag) *domain.Tag {
+	return &domain.Tag{
+		ID:        sqlcTag.ID,
+		Name:      sqlcTag.Name,
+		Curated:   sqlcTag.Curated,
+		CreatedAt: sqlcTag.CreatedAt.Time,
+		UpdatedAt: sqlcTag.UpdatedAt.Time,
+	}
+}
+
+// toDomainTagUsage converts a tag row with its usage count to domain Tag model
+// The GetTagByID and ListCompetencyTags rows have the same columns and convert to ListTagsRow
+func toDomainTagUsage(row sqlc.ListTagsRow) *domain.Tag {
+	return &domain.Tag{
+		ID:         row.ID,
+		Name:       row.Name,
+		Curated:    row.Curated,
+		UsageCount: row.UsageCount,
+		CreatedAt:  row.CreatedAt.Time,
+		UpdatedAt:  row.UpdatedAt.Time,
+	}
+}
@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/mehrnoosh-hk/devnorth-back/db/sqlc"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+)
+
+// txContextKey is the context key of the transaction repositories run their queries in
+type txContextKey struct{}
+
+// transactor implements domain.Transactor with pgx transactions
+type transactor struct {
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewTransactor creates a new instance of Transactor
+func NewTransactor(pool *pgxpool.Pool, logger *slog.Logger) (domain.Transactor, error) {
+	if pool == nil {
+		return nil, ErrPoolNil
+	}
+	if logger == nil {
+		return nil, ErrLoggerNil
+	}
+	return &transactor{
+		pool:   pool,
+		logger: logger,
+	}, nil
+}
+
+// WithinTx runs fn in a transaction carried by its context, or in the transaction of ctx if there is one
+func (t *transactor) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txContextKey{}).(pgx.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := t.pool.Begin(ctx)
+	if err != nil {
+		t.logger.ErrorContext(ctx, "failed to begin transaction", "error", err)
+		return fmt.Errorf("%w: %w", ErrBeginTxFailed, err)
+	}
+	// Rolling back a committed transaction is a no-op
+	defer func() {
+		if err := tx.Rollback(context.WithoutCancel(ctx)); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			t.logger.ErrorContext(ctx, "failed to roll back transaction", "error", err)
+		}
+	}()
+
+	if err := fn(context.WithValue(ctx, txContextKey{}, tx)); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		t.logger.ErrorContext(ctx, "failed to commit transaction", "error", err)
+		return fmt.Errorf("%w: %w", ErrCommitTxFailed, err)
+	}
+	return nil
+}
+
+// queriesFromContext returns queries running in the transaction of ctx, or queries when there is none
+func queriesFromContext(ctx context.Context, queries *sqlc.Queries) *sqlc.Queries {
+	if tx, ok := ctx.Value(txContextKey{}).(pgx.Tx); ok {
+		return queries.WithTx(tx)
+	}
+	return queries
+}
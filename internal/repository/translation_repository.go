@@ -0,0 +1,259 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/mehrnoosh-hk/devnorth-back/db/sqlc"
+	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+)
+
+// translationRepository implements domain.TranslationRepository using SQLC
+type translationRepository struct {
+	queries *sqlc.Queries
+	logger  *slog.Logger
+}
+
+// NewTranslationRepository creates a new instance of TranslationRepository
+func NewTranslationRepository(pool *pgxpool.Pool, logger *slog.Logger) (domain.TranslationRepository, error) {
+	if pool == nil {
+		return nil, ErrPoolNil
+	}
+	if logger == nil {
+		return nil, ErrLoggerNil
+	}
+	return &translationRepository{
+		queries: sqlc.New(pool),
+		logger:  logger,
+	}, nil
+}
+
+// q returns the queries to run, in the transaction of ctx if there is one (see transactor)
+func (r *translationRepository) q(ctx context.Context) *sqlc.Queries {
+	return queriesFromContext(ctx, r.queries)
+}
+
+// GetAll retrieves the translations of a competency in every locale
+func (r *translationRepository) GetAll(ctx context.Context, competencyID int32) ([]*domain.CompetencyTranslation, error) {
+	translations, err := r.list(ctx, []int32{competencyID}, pgtype.Text{})
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to list competency translations", "error", err, "competency_id", competencyID)
+		return nil, err
+	}
+	return translations, nil
+}
+
+// GetByLocale retrieves the translations into locale of the competencies of ids
+func (r *translationRepository) GetByLocale(ctx context.Context, locale domain.Locale, ids []int32) (map[int32]*domain.CompetencyTranslation, error) {
+	translations, err := r.list(ctx, ids, pgtype.Text{String: string(locale), Valid: true})
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to list competency translations", "error", err, "locale", locale)
+		return nil, err
+	}
+
+	byCompetency := make(map[int32]*domain.CompetencyTranslation, len(translations))
+	for _, translation := range translations {
+		byCompetency[translation.CompetencyID] = translation
+	}
+	return byCompetency, nil
+}
+
+// list retrieves the translations of the competencies of ids with their levels, in locale when it is set
+func (r *translationRepository) list(ctx context.Context, ids []int32, locale pgtype.Text) ([]*domain.CompetencyTranslation, error) {
+	rows, err := r.q(ctx).ListCompetencyTranslations(ctx, sqlc.ListCompetencyTranslationsParams{CompetencyIds: ids, Locale: locale})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrGetTranslationsFailed, err)
+	}
+	levelRows, err := r.q(ctx).ListCompetencyLevelTranslations(ctx, sqlc.ListCompetencyLevelTranslationsParams{CompetencyIds: ids, Locale: locale})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrGetTranslationsFailed, err)
+	}
+
+	type key struct {
+		competencyID int32
+		locale       string
+	}
+	translations := make([]*domain.CompetencyTranslation, len(rows))
+	byKey := make(map[key]*domain.CompetencyTranslation, len(rows))
+	for i, row := range rows {
+		translations[i] = toDomainTranslation(row)
+		byKey[key{row.CompetencyID, row.Locale}] = translations[i]
+	}
+	for _, levelRow := range levelRows {
+		// Levels of a translation deleted between both reads are dropped
+		if translation, ok := byKey[key{levelRow.CompetencyID, levelRow.Locale}]; ok {
+			translation.Levels = append(translation.Levels, toDomainLevelTranslation(levelRow))
+		}
+	}
+	return translations, nil
+}
+
+// Set creates or replaces the translation of a competency into a locale, with its levels
+// Run it in a transaction: the levels are replaced one by one
+func (r *translationRepository) Set(ctx context.Context, translation domain.CompetencyTranslation) (*domain.CompetencyTranslation, error) {
+	r.logger.InfoContext(ctx, "setting competency translation", "competency_id", translation.CompetencyID, "locale", translation.Locale)
+
+	row, err := r.q(ctx).UpsertCompetencyTranslation(ctx, sqlc.UpsertCompetencyTranslationParams{
+		CompetencyID: translation.CompetencyID,
+		Locale:       string(translation.Locale),
+		Name:         translation.Name,
+		Description:  translation.Description,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case "23505":
+				r.logger.InfoContext(ctx, "duplicate translated competency name", "locale", translation.Locale, "name", translation.Name)
+				return nil, domain.ErrCompetencyAlreadyExists
+			case "23503":
+				return nil, domain.ErrCompetencyNotFound
+			}
+		}
+		r.logger.ErrorContext(ctx, "failed to set competency translation", "error", err, "competency_id", translation.CompetencyID)
+		return nil, fmt.Errorf("%w: %w", ErrUpdateTranslationFailed, err)
+	}
+
+	err = r.q(ctx).DeleteCompetencyLevelTranslations(ctx, sqlc.DeleteCompetencyLevelTranslationsParams{
+		CompetencyID: translation.CompetencyID,
+		Locale:       string(translation.Locale),
+	})
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to delete competency level translations", "error", err, "competency_id", translation.CompetencyID)
+		return nil, fmt.Errorf("%w: %w", ErrUpdateTranslationFailed, err)
+	}
+	result := toDomainTranslation(row)
+	for _, level := range translation.Levels {
+		indicators := level.Indicators
+		if indicators == nil {
+			indicators = []string{}
+		}
+		err := r.q(ctx).InsertCompetencyLevelTranslation(ctx, sqlc.InsertCompetencyLevelTranslationParams{
+			CompetencyID: translation.CompetencyID,
+			Level:        level.Value,
+			Locale:       string(translation.Locale),
+			Description:  level.Description,
+			Indicators:   indicators,
+		})
+		if err != nil {
+			// Check for foreign key violation (the rubric was deleted meanwhile)
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+				return nil, domain.ErrRubricNotFound
+			}
+			r.logger.ErrorContext(ctx, "failed to insert competency level translation", "error", err, "competency_id", translation.CompetencyID)
+			return nil, fmt.Errorf("%w: %w", ErrUpdateTranslationFailed, err)
+		}
+		result.Levels = append(result.Levels, domain.CompetencyLevel{Value: level.Value, Description: level.Description, Indicators: indicators})
+	}
+	return result, nil
+}
+
+// Delete deletes the translation of a competency into a locale, with its levels
+func (r *translationRepository) Delete(ctx context.Context, competencyID int32, locale domain.Locale) error {
+	r.logger.InfoContext(ctx, "deleting competency translation", "competency_id", competencyID, "locale", locale)
+
+	deleted, err := r.q(ctx).DeleteCompetencyTranslation(ctx, sqlc.DeleteCompetencyTranslationParams{CompetencyID: competencyID, Locale: string(locale)})
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to delete competency translation", "error", err, "competency_id", competencyID)
+		return fmt.Errorf("%w: %w", ErrUpdateTranslationFailed, err)
+	}
+	if deleted == 0 {
+		r.logger.InfoContext(ctx, "competency translation not found", "competency_id", competencyID, "locale", locale)
+		return domain.ErrTranslationNotFound
+	}
+
+	err = r.q(ctx).DeleteCompetencyLevelTranslations(ctx, sqlc.DeleteCompetencyLevelTranslationsParams{CompetencyID: competencyID, Locale: string(locale)})
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to delete competency level translations", "error", err, "competency_id", competencyID)
+		return fmt.Errorf("%w: %w", ErrUpdateTranslationFailed, err)
+	}
+	return nil
+}
+
+// GetNameConflicts retrieves the locales where another competency shows name
+func (r *translationRepository) GetNameConflicts(ctx context.Context, name string, locales []domain.Locale, excludeID int32) ([]domain.Locale, error) {
+	rows, err := r.q(ctx).ListLocalizedNameConflicts(ctx, sqlc.ListLocalizedNameConflictsParams{
+		Name:      name,
+		Locales:   localeStrings(locales),
+		ExcludeID: excludeID,
+	})
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to check localized competency names", "error", err, "name", name)
+		return nil, fmt.Errorf("%w: %w", ErrGetTranslationsFailed, err)
+	}
+
+	conflicts := make([]domain.Locale, 0, len(rows))
+	for _, row := range rows {
+		if len(conflicts) == 0 || conflicts[len(conflicts)-1] != domain.Locale(row.Locale) {
+			conflicts = append(conflicts, domain.Locale(row.Locale))
+		}
+	}
+	return conflicts, nil
+}
+
+// GetMissing retrieves the competencies lacking translations into locales
+func (r *translationRepository) GetMissing(ctx context.Context, locales []domain.Locale) ([]*domain.MissingTranslation, error) {
+	rows, err := r.q(ctx).ListMissingTranslations(ctx, localeStrings(locales))
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to list missing translations", "error", err)
+		return nil, fmt.Errorf("%w: %w", ErrGetTranslationsFailed, err)
+	}
+
+	missing := make([]*domain.MissingTranslation, len(rows))
+	for i, row := range rows {
+		missing[i] = &domain.MissingTranslation{
+			CompetencyID:       row.CompetencyID,
+			Name:               row.Name,
+			Locale:             domain.Locale(row.Locale),
+			MissingName:        row.MissingName,
+			MissingDescription: row.MissingDescription,
+			MissingLevels:      row.MissingLevels,
+		}
+	}
+	return missing, nil
+}
+
+// TouchCompetency bumps the version of a competency whose translations changed
+func (r *translationRepository) TouchCompetency(ctx context.Context, competencyID int32) error {
+	if err := r.q(ctx).TouchCompetency(ctx, competencyID); err != nil {
+		r.logger.ErrorContext(ctx, "failed to touch competency", "error", err, "competency_id", competencyID)
+		return fmt.Errorf("%w: %w", ErrUpdateTranslationFailed, err)
+	}
+	return nil
+}
+
+// localeStrings converts locales to the strings stored in the database
+func localeStrings(locales []domain.Locale) []string {
+	values := make([]string, len(locales))
+	for i, locale := range locales {
+		values[i] = string(locale)
+	}
+	return values
+}
+
+// toDomainTranslation converts SQLC CompetencyTranslation model to domain CompetencyTranslation model, without levels
+func toDomainTranslation(row sqlc.CompetencyTranslation) *domain.CompetencyTranslation {
+	return &domain.CompetencyTranslation{
+		CompetencyID: row.CompetencyID,
+		Locale:       domain.Locale(row.Locale),
+		Name:         row.Name,
+		Description:  row.Description,
+		Levels:       []domain.CompetencyLevel{},
+		UpdatedAt:    row.UpdatedAt.Time,
+	}
+}
+
+// toDomainLevelTranslation converts SQLC CompetencyLevelTranslation model to a domain CompetencyLevel
+func toDomainLevelTranslation(row sqlc.CompetencyLevelTranslation) domain.CompetencyLevel {
+	return domain.CompetencyLevel{
+		Value:       row.Level,
+		Description: row.Description,
+		Indicators:  row.Indicators,
+	}
+}
@@ -30,7 +30,7 @@ func NewUserRepository(pool *pgxpool.Pool, logger *slog.Logger) domain.UserRepos
 
 // Create creates a new user in the database
 func (r *userRepository) Create(ctx context.Context, email, hashedPassword string, role domain.UserRole) (*domain.User, error) {
-	r.logger.Info("creating user", "role", role)
+	r.logger.InfoContext(ctx, "creating user", "role", role)
 
 	params := sqlc.CreateUserParams{
 		Email:          email,
@@ -43,14 +43,14 @@ func (r *userRepository) Create(ctx context.Context, email, hashedPassword strin
 		// Check for unique constraint violation (duplicate email)
 		var pgErr *pgconn.PgError
 			if errors.As(err, &pgErr) && pgErr.Code == "23505" {
-				r.logger.Warn("duplicate email", "email", email)
+				r.logger.WarnContext(ctx, "duplicate email", "email", email)
 				return nil, domain.ErrEmailAlreadyExists
 		}
-		r.logger.Error("failed to create user", "error", err, "Role", role)
+		r.logger.ErrorContext(ctx, "failed to create user", "error", err, "Role", role)
 		return nil, fmt.Errorf("failed to create user: %w", err)
 	}
 
-	r.logger.Info("user created successfully", "user_id", sqlcUser.ID)
+	r.logger.InfoContext(ctx, "user created successfully", "user_id", sqlcUser.ID)
 
 	// Convert SQLC model to domain model
 	return toDomainUser(sqlcUser), nil
@@ -58,19 +58,19 @@ func (r *userRepository) Create(ctx context.Context, email, hashedPassword strin
 
 // GetByEmail retrieves a user by email address
 func (r *userRepository) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
-	r.logger.Info("getting user by email")
+	r.logger.InfoContext(ctx, "getting user by email")
 
 	sqlcUser, err := r.queries.GetUserByEmail(ctx, email)
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			r.logger.Info("user not found")
+			r.logger.InfoContext(ctx, "user not found")
 			return nil, domain.ErrUserNotFound
 		}
-		r.logger.Error("failed to get user by email", "error", err)
+		r.logger.ErrorContext(ctx, "failed to get user by email", "error", err)
 		return nil, fmt.Errorf("failed to get user by email: %w", err)
 	}
 
-	r.logger.Info("user retrieved successfully", "user_id", sqlcUser.ID)
+	r.logger.InfoContext(ctx, "user retrieved successfully", "user_id", sqlcUser.ID)
 
 	// Convert SQLC model to domain model
 	return toDomainUser(sqlcUser), nil
@@ -61,7 +61,7 @@ func NewJWTGenerator(kid string, Keys map[string]string, durationMinutes int, l
 // Generate creates a JWT token for the given user
 func (g *jwtGenerator) Generate(ctx context.Context, user *domain.User) (string, error) {
 	if user == nil {
-		g.logger.Error("user can not be nil")
+		g.logger.ErrorContext(ctx, "user can not be nil")
 		return "", ErrUserCanNotBeNil
 	}
 	now := time.Now()
@@ -86,7 +86,7 @@ func (g *jwtGenerator) Generate(ctx context.Context, user *domain.User) (string,
 	// Sign token with secret key
 	signedToken, err := token.SignedString([]byte(g.keys[g.currentKey]))
 	if err != nil {
-		g.logger.Error("failed to sign token", "error", err)
+		g.logger.ErrorContext(ctx, "failed to sign token", "error", err)
 		return "", fmt.Errorf("%w: %w", ErrFailedToSignToken, err)
 	}
 
@@ -99,26 +99,26 @@ func (g *jwtGenerator) Validate(ctx context.Context, tokenString string) (*domai
 	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (any, error) {
 		// Verify signing method
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			g.logger.Error("unexpected signing method", "method", token.Header["alg"])
+			g.logger.ErrorContext(ctx, "unexpected signing method", "method", token.Header["alg"])
 			return nil, ErrUnexpectedSigningMethod
 		}
 		// Verify kid
 		if token.Header["kid"] != g.currentKey {
-			g.logger.Error("unexpected key ID", "kid", token.Header["kid"])
+			g.logger.ErrorContext(ctx, "unexpected key ID", "kid", token.Header["kid"])
 			return nil, ErrUnexpectedKeyID
 		}
 		return []byte(g.keys[g.currentKey]), nil
 	})
 
 	if err != nil {
-		g.logger.Error("invalid token", "error", err)
+		g.logger.ErrorContext(ctx, "invalid token", "error", err)
 		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidToken, err)
 	}
 
 	// Extract claims
 	claims, ok := token.Claims.(*Claims)
 	if !ok || !token.Valid {
-		g.logger.Error("invalid token claims")
+		g.logger.ErrorContext(ctx, "invalid token claims")
 		return nil, domain.ErrInvalidToken
 	}
 
@@ -0,0 +1,393 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+)
+
+// categoryUseCase implements domain.CategoryUseCase
+// Changes to the shape of the tree run in transactions holding the tree lock, so concurrent moves
+// can't combine into a cycle and sibling positions stay contiguous
+type categoryUseCase struct {
+	categoryRepo domain.CategoryRepository
+	transactor   domain.Transactor
+	logger       *slog.Logger
+}
+
+// NewCategoryUseCase creates a new category use case instance
+func NewCategoryUseCase(
+	categoryRepo domain.CategoryRepository,
+	transactor domain.Transactor,
+	logger *slog.Logger,
+) (domain.CategoryUseCase, error) {
+	// Nil-check the injected dependencies
+	if categoryRepo == nil {
+		return nil, ErrCategoryRepositoryNil
+	}
+	if transactor == nil {
+		return nil, ErrTransactorNil
+	}
+	if logger == nil {
+		return nil, ErrLoggerNil
+	}
+	return &categoryUseCase{
+		categoryRepo: categoryRepo,
+		transactor:   transactor,
+		logger:       logger,
+	}, nil
+}
+
+// Create creates a category after its siblings; admins only
+func (uc *categoryUseCase) Create(ctx context.Context, parentID *int32, name string) (*domain.Category, error) {
+	if err := domain.RequireAdmin(ctx); err != nil {
+		uc.logger.InfoContext(ctx, "category creation not allowed", "reason", err)
+		return nil, err
+	}
+
+	name = strings.TrimSpace(name)
+	if err := validateCategoryName(name); err != nil {
+		uc.logger.InfoContext(ctx, "invalid category name", "name", name)
+		return nil, err
+	}
+
+	var category *domain.Category
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		// The position is the number of siblings, which must not change before the insert
+		if err := uc.categoryRepo.LockTree(ctx); err != nil {
+			return err
+		}
+		var err error
+		category, err = uc.categoryRepo.Create(ctx, parentID, name)
+		return err
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrCategoryParentNotFound):
+			uc.logger.InfoContext(ctx, "parent category not found", "parent_id", parentID)
+			return nil, domain.ErrCategoryParentNotFound
+		case errors.Is(err, domain.ErrCategoryAlreadyExists):
+			uc.logger.InfoContext(ctx, "category already exists", "parent_id", parentID, "name", name)
+			return nil, domain.ErrCategoryAlreadyExists
+		}
+		uc.logger.ErrorContext(ctx, "failed to create category", "error", err)
+		return nil, fmt.Errorf("%w: %w", ErrCreateCategory, err)
+	}
+
+	uc.logger.InfoContext(ctx, "category created successfully", "category_id", category.ID, "name", category.Name)
+	return category, nil
+}
+
+// GetByID retrieves a category by its ID
+func (uc *categoryUseCase) GetByID(ctx context.Context, id int32) (*domain.Category, error) {
+	category, err := uc.categoryRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrCategoryNotFound) {
+			return nil, domain.ErrCategoryNotFound
+		}
+		uc.logger.ErrorContext(ctx, "failed to get category", "error", err, "id", id)
+		return nil, fmt.Errorf("%w: %w", ErrGetCategory, err)
+	}
+	return category, nil
+}
+
+// GetTree retrieves the whole taxonomy
+func (uc *categoryUseCase) GetTree(ctx context.Context) ([]*domain.CategoryNode, error) {
+	categories, counts, err := uc.readTree(ctx, func(ctx context.Context) ([]*domain.Category, error) {
+		return uc.categoryRepo.GetAll(ctx)
+	})
+	if err != nil {
+		uc.logger.ErrorContext(ctx, "failed to get category tree", "error", err)
+		return nil, fmt.Errorf("%w: %w", ErrGetCategory, err)
+	}
+	return domain.BuildCategoryTree(categories, counts), nil
+}
+
+// GetSubtree retrieves a category with its descendants
+func (uc *categoryUseCase) GetSubtree(ctx context.Context, id int32) (*domain.CategoryNode, error) {
+	categories, counts, err := uc.readTree(ctx, func(ctx context.Context) ([]*domain.Category, error) {
+		return uc.categoryRepo.GetSubtree(ctx, id)
+	})
+	if err != nil {
+		uc.logger.ErrorContext(ctx, "failed to get category subtree", "error", err, "id", id)
+		return nil, fmt.Errorf("%w: %w", ErrGetCategory, err)
+	}
+
+	// The subtree lists the category itself first, so it is the only root
+	roots := domain.BuildCategoryTree(categories, counts)
+	if len(roots) == 0 {
+		uc.logger.InfoContext(ctx, "category not found", "id", id)
+		return nil, domain.ErrCategoryNotFound
+	}
+	return roots[0], nil
+}
+
+// readTree reads categories and the competency counts in one transaction, so they are consistent
+func (uc *categoryUseCase) readTree(ctx context.Context, read func(ctx context.Context) ([]*domain.Category, error)) ([]*domain.Category, map[int32]int64, error) {
+	var categories []*domain.Category
+	var counts map[int32]int64
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		if categories, err = read(ctx); err != nil {
+			return err
+		}
+		counts, err = uc.categoryRepo.CountCompetencies(ctx)
+		return err
+	})
+	return categories, counts, err
+}
+
+// Rename renames a category; admins only
+func (uc *categoryUseCase) Rename(ctx context.Context, id int32, name string) (*domain.Category, error) {
+	if err := domain.RequireAdmin(ctx); err != nil {
+		uc.logger.InfoContext(ctx, "category rename not allowed", "reason", err, "id", id)
+		return nil, err
+	}
+
+	name = strings.TrimSpace(name)
+	if err := validateCategoryName(name); err != nil {
+		uc.logger.InfoContext(ctx, "invalid category name for rename", "id", id, "name", name)
+		return nil, err
+	}
+
+	category, err := uc.categoryRepo.Rename(ctx, id, name)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrCategoryNotFound):
+			uc.logger.InfoContext(ctx, "category not found for rename", "id", id)
+			return nil, domain.ErrCategoryNotFound
+		case errors.Is(err, domain.ErrCategoryAlreadyExists):
+			uc.logger.InfoContext(ctx, "category name already taken among siblings", "id", id, "name", name)
+			return nil, domain.ErrCategoryAlreadyExists
+		}
+		uc.logger.ErrorContext(ctx, "failed to rename category", "error", err, "id", id)
+		return nil, fmt.Errorf("%w: %w", ErrUpdateCategory, err)
+	}
+
+	uc.logger.InfoContext(ctx, "category renamed successfully", "category_id", category.ID, "name", category.Name)
+	return category, nil
+}
+
+// Move places a category under a new parent at a position among its siblings
+// Business logic flow:
+// 1. Check that the user is an admin
+// 2. Lock the tree, so no concurrent move can create a cycle with this one
+// 3. Check the category and the new parent exist, and that the parent isn't in the subtree of the category
+// 4. Insert the category among its new siblings and renumber them
+// 5. Renumber the siblings it left, closing the gap
+func (uc *categoryUseCase) Move(ctx context.Context, id int32, parentID *int32, position int32) (*domain.Category, error) {
+	// Step 1: Authorize
+	if err := domain.RequireAdmin(ctx); err != nil {
+		uc.logger.InfoContext(ctx, "category move not allowed", "reason", err, "id", id)
+		return nil, err
+	}
+
+	var moved *domain.Category
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		// Step 2: Lock the tree
+		if err := uc.categoryRepo.LockTree(ctx); err != nil {
+			return err
+		}
+
+		// Step 3: Check the move
+		category, err := uc.categoryRepo.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if parentID != nil {
+			if _, err := uc.categoryRepo.GetByID(ctx, *parentID); err != nil {
+				if errors.Is(err, domain.ErrCategoryNotFound) {
+					return domain.ErrCategoryParentNotFound
+				}
+				return err
+			}
+			cycle, err := uc.categoryRepo.IsInSubtree(ctx, id, *parentID)
+			if err != nil {
+				return err
+			}
+			if cycle {
+				return domain.ErrCategoryCycle
+			}
+		}
+
+		// Step 4: Insert among the new siblings
+		siblings, err := uc.categoryRepo.GetChildren(ctx, parentID)
+		if err != nil {
+			return err
+		}
+		order := make([]int32, 0, len(siblings)+1)
+		for _, sibling := range siblings {
+			if sibling.ID != id {
+				order = append(order, sibling.ID)
+			}
+		}
+		if position < 0 || int(position) > len(order) {
+			position = int32(len(order))
+		}
+		order = slices.Insert(order, int(position), id)
+
+		parentChanged := !sameCategoryID(category.ParentID, parentID)
+		if parentChanged {
+			if _, err := uc.categoryRepo.SetParent(ctx, id, parentID); err != nil {
+				return err
+			}
+		}
+		if err := uc.categoryRepo.SetPositions(ctx, order); err != nil {
+			return err
+		}
+
+		// Step 5: Close the gap among the former siblings
+		if parentChanged {
+			if err := uc.renumberChildren(ctx, category.ParentID); err != nil {
+				return err
+			}
+		}
+
+		moved, err = uc.categoryRepo.GetByID(ctx, id)
+		return err
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrCategoryNotFound),
+			errors.Is(err, domain.ErrCategoryParentNotFound),
+			errors.Is(err, domain.ErrCategoryCycle),
+			errors.Is(err, domain.ErrCategoryAlreadyExists):
+			uc.logger.InfoContext(ctx, "category move refused", "reason", err, "id", id, "parent_id", parentID)
+			return nil, err
+		}
+		uc.logger.ErrorContext(ctx, "failed to move category", "error", err, "id", id)
+		return nil, fmt.Errorf("%w: %w", ErrUpdateCategory, err)
+	}
+
+	uc.logger.InfoContext(ctx, "category moved successfully", "category_id", moved.ID, "parent_id", moved.ParentID, "position", moved.Position)
+	return moved, nil
+}
+
+// Reorder sets the order of the children of a category, or of the root categories; admins only
+func (uc *categoryUseCase) Reorder(ctx context.Context, parentID *int32, ids []int32) ([]*domain.Category, error) {
+	if err := domain.RequireAdmin(ctx); err != nil {
+		uc.logger.InfoContext(ctx, "category reorder not allowed", "reason", err, "parent_id", parentID)
+		return nil, err
+	}
+
+	var children []*domain.Category
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.categoryRepo.LockTree(ctx); err != nil {
+			return err
+		}
+		if parentID != nil {
+			if _, err := uc.categoryRepo.GetByID(ctx, *parentID); err != nil {
+				if errors.Is(err, domain.ErrCategoryNotFound) {
+					return domain.ErrCategoryParentNotFound
+				}
+				return err
+			}
+		}
+
+		// ids must be a permutation of the current children
+		current, err := uc.categoryRepo.GetChildren(ctx, parentID)
+		if err != nil {
+			return err
+		}
+		currentIDs := make([]int32, len(current))
+		for i, child := range current {
+			currentIDs[i] = child.ID
+		}
+		requested := slices.Clone(ids)
+		slices.Sort(currentIDs)
+		slices.Sort(requested)
+		if !slices.Equal(currentIDs, requested) {
+			return domain.ErrInvalidCategoryOrder
+		}
+
+		if err := uc.categoryRepo.SetPositions(ctx, ids); err != nil {
+			return err
+		}
+		children, err = uc.categoryRepo.GetChildren(ctx, parentID)
+		return err
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrCategoryParentNotFound), errors.Is(err, domain.ErrInvalidCategoryOrder):
+			uc.logger.InfoContext(ctx, "category reorder refused", "reason", err, "parent_id", parentID)
+			return nil, err
+		}
+		uc.logger.ErrorContext(ctx, "failed to reorder categories", "error", err, "parent_id", parentID)
+		return nil, fmt.Errorf("%w: %w", ErrUpdateCategory, err)
+	}
+
+	uc.logger.InfoContext(ctx, "categories reordered successfully", "parent_id", parentID, "count", len(children))
+	return children, nil
+}
+
+// Delete deletes a category and closes the gap among its siblings
+// Children and competencies are enforced by foreign keys, so a category in use can't be deleted; admins only
+func (uc *categoryUseCase) Delete(ctx context.Context, id int32) error {
+	if err := domain.RequireAdmin(ctx); err != nil {
+		uc.logger.InfoContext(ctx, "category delete not allowed", "reason", err, "id", id)
+		return err
+	}
+
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.categoryRepo.LockTree(ctx); err != nil {
+			return err
+		}
+		category, err := uc.categoryRepo.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := uc.categoryRepo.Delete(ctx, id); err != nil {
+			return err
+		}
+		return uc.renumberChildren(ctx, category.ParentID)
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrCategoryNotFound):
+			uc.logger.InfoContext(ctx, "category not found for delete", "id", id)
+			return domain.ErrCategoryNotFound
+		case errors.Is(err, domain.ErrCategoryInUse):
+			uc.logger.InfoContext(ctx, "category in use, not deleted", "id", id)
+			return domain.ErrCategoryInUse
+		}
+		uc.logger.ErrorContext(ctx, "failed to delete category", "error", err, "id", id)
+		return fmt.Errorf("%w: %w", ErrDeleteCategory, err)
+	}
+
+	uc.logger.InfoContext(ctx, "category deleted successfully", "category_id", id)
+	return nil
+}
+
+// renumberChildren sets the positions of the children of parentID to 0..n-1, keeping their order
+func (uc *categoryUseCase) renumberChildren(ctx context.Context, parentID *int32) error {
+	children, err := uc.categoryRepo.GetChildren(ctx, parentID)
+	if err != nil {
+		return err
+	}
+	ids := make([]int32, len(children))
+	for i, child := range children {
+		ids[i] = child.ID
+	}
+	return uc.categoryRepo.SetPositions(ctx, ids)
+}
+
+// sameCategoryID reports whether two optional category IDs are equal
+func sameCategoryID(a, b *int32) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// validateCategoryName checks the name has a reasonable length, like competency names
+func validateCategoryName(name string) error {
+	if len(name) < 2 || len(name) > 100 {
+		return domain.ErrInvalidCategoryName
+	}
+	return nil
+}
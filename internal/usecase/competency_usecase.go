@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
 	"strings"
 
 	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
@@ -13,77 +14,438 @@ import (
 // competencyUseCase implements domain.CompetencyUseCase
 // It orchestrates competency-related business operations using repository
 type competencyUseCase struct {
-	competencyRepo domain.CompetencyRepository
-	logger         *slog.Logger
+	competencyRepo  domain.CompetencyRepository
+	rubricRepo      domain.RubricRepository
+	revisionRepo    domain.RevisionRepository
+	translationRepo domain.TranslationRepository
+	mergeRepo       domain.MergeRepository
+	stewardRepo     domain.StewardRepository
+	transactor      domain.Transactor
+	logger          *slog.Logger
 }
 
 // NewCompetencyUseCase creates a new competency use case instance
 // Dependencies are injected following the Dependency Inversion Principle
 func NewCompetencyUseCase(
 	competencyRepo domain.CompetencyRepository,
+	rubricRepo domain.RubricRepository,
+	revisionRepo domain.RevisionRepository,
+	translationRepo domain.TranslationRepository,
+	mergeRepo domain.MergeRepository,
+	stewardRepo domain.StewardRepository,
+	transactor domain.Transactor,
 	logger *slog.Logger,
 ) (domain.CompetencyUseCase, error) {
 	// Nil-check the injected dependencies
 	if competencyRepo == nil {
 		return nil, ErrCompetencyRepositoryNil
 	}
+	if rubricRepo == nil {
+		return nil, ErrRubricRepositoryNil
+	}
+	if revisionRepo == nil {
+		return nil, ErrRevisionRepositoryNil
+	}
+	if translationRepo == nil {
+		return nil, ErrTranslationRepositoryNil
+	}
+	if mergeRepo == nil {
+		return nil, ErrMergeRepositoryNil
+	}
+	if stewardRepo == nil {
+		return nil, ErrStewardRepositoryNil
+	}
+	if transactor == nil {
+		return nil, ErrTransactorNil
+	}
 	if logger == nil {
 		return nil, ErrLoggerNil
 	}
 	return &competencyUseCase{
-		competencyRepo: competencyRepo,
-		logger:         logger,
+		competencyRepo:  competencyRepo,
+		rubricRepo:      rubricRepo,
+		revisionRepo:    revisionRepo,
+		translationRepo: translationRepo,
+		mergeRepo:       mergeRepo,
+		stewardRepo:     stewardRepo,
+		transactor:      transactor,
+		logger:          logger,
 	}, nil
 }
 
 // Create creates a new competency
 // Business logic flow:
-// 1. Validate name (basic validation for POC)
-// 2. Normalize name (trim spaces)
-// 3. Check if competency with same name already exists
-// 4. Create competency in repository
+// 1. Check that the user is an admin
+// 2. Normalize name (trim spaces) and validate it (basic validation for POC)
+// 3. Check the name isn't the name of another competency in any locale
+// 4. Create competency in repository, recording its first revision
 func (uc *competencyUseCase) Create(ctx context.Context, name, description string) (*domain.Competency, error) {
+	// Step 1: Authorize
+	if err := domain.RequireAdmin(ctx); err != nil {
+		uc.logger.InfoContext(ctx, "competency creation not allowed", "reason", err)
+		return nil, err
+	}
+
 	// Normalize name by trimming spaces
 	name = strings.TrimSpace(name)
 	description = strings.TrimSpace(description)
 
-	// Step 1: Validate name (basic validation for POC)
+	// Step 2: Validate name (basic validation for POC)
 	if err := uc.validateName(name); err != nil {
-		uc.logger.Error("failed to validate competency name", "error", err)
+		uc.logger.ErrorContext(ctx, "failed to validate competency name", "error", err)
 		return nil, err
 	}
 
-	// Step 2: Check if competency already exists
-	existingCompetency, err := uc.competencyRepo.GetByName(ctx, name)
-	if err != nil && !errors.Is(err, domain.ErrCompetencyNotFound) {
-		uc.logger.Error("failed to check existing competency", "error", err)
-		return nil, fmt.Errorf("%w: %w", ErrCheckExistingCompetency, err)
-	}
-	if existingCompetency != nil {
-		uc.logger.Error("competency already exists", "name", name)
-		return nil, domain.ErrCompetencyAlreadyExists
+	// Step 3: Check if competency already exists
+	if err := uc.checkNameConflicts(ctx, 0, name); err != nil {
+		if errors.Is(err, domain.ErrCompetencyAlreadyExists) {
+			uc.logger.ErrorContext(ctx, "competency already exists", "name", name)
+			return nil, err
+		}
+		uc.logger.ErrorContext(ctx, "failed to check existing competency", "error", err)
+		return nil, err
 	}
 
-	// Step 3: Create competency
-	competency, err := uc.competencyRepo.Create(ctx, name, description)
+	// Step 4: Create competency
+	competency, err := uc.change(ctx, domain.RevisionCreate, func(ctx context.Context) (*domain.Competency, error) {
+		return uc.competencyRepo.Create(ctx, name, description)
+	})
 	if err != nil {
-		uc.logger.Error("failed to create competency", "error", err)
+		uc.logger.ErrorContext(ctx, "failed to create competency", "error", err)
 		return nil, fmt.Errorf("%w: %w", ErrCreateCompetency, err)
 	}
 
-	uc.logger.Info("competency created successfully", "competency_id", competency.ID, "name", competency.Name)
+	uc.logger.InfoContext(ctx, "competency created successfully", "competency_id", competency.ID, "name", competency.Name)
 	return competency, nil
 }
 
-// GetByID retrieves a competency by its ID
-func (uc *competencyUseCase) GetByID(ctx context.Context, id int32) (*domain.Competency, error) {
-	competency, err := uc.competencyRepo.GetByID(ctx, id)
+// CreateBatch creates competencies in one transaction
+// Business logic flow:
+// 1. Check that the user is an admin, the batch size and mode
+// 2. Normalize and validate every item like Create does
+// 3. Insert the valid items in one transaction; names taken in any locale are reported as duplicates
+// 4. All-or-nothing: roll back when any item is invalid or a duplicate
+func (uc *competencyUseCase) CreateBatch(ctx context.Context, items []domain.NewCompetency, mode domain.CompetencyBatchMode) ([]*domain.CompetencyBatchResult, error) {
+	// Step 1: Authorize and check the batch
+	if err := domain.RequireAdmin(ctx); err != nil {
+		uc.logger.InfoContext(ctx, "competency batch creation not allowed", "reason", err)
+		return nil, err
+	}
+	if len(items) == 0 || len(items) > domain.MaxCompetencyBatchSize || !mode.Valid() {
+		uc.logger.InfoContext(ctx, "invalid competency batch", "items", len(items), "mode", mode)
+		return nil, domain.ErrInvalidCompetencyBatch
+	}
+
+	// Step 2: Validate items
+	results := make([]*domain.CompetencyBatchResult, len(items))
+	valid := make([]domain.NewCompetency, 0, len(items))
+	validIndexes := make([]int, 0, len(items))
+	for i, item := range items {
+		item.Name = strings.TrimSpace(item.Name)
+		item.Description = strings.TrimSpace(item.Description)
+		if err := uc.validateName(item.Name); err != nil {
+			results[i] = &domain.CompetencyBatchResult{Status: domain.CompetencyBatchInvalid, Err: err}
+			continue
+		}
+		valid = append(valid, item)
+		validIndexes = append(validIndexes, i)
+	}
+	rejected := mode == domain.CompetencyBatchAllOrNothing && len(valid) < len(items)
+
+	// Step 3: Insert valid items, all in one transaction
+	// A rejected batch is inserted too, then rolled back, to report its duplicates as well
+	if len(valid) > 0 {
+		err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+			// Names shown by other competencies in any locale are duplicates too
+			creates := make([]domain.NewCompetency, 0, len(valid))
+			createIndexes := make([]int, 0, len(valid))
+			for j, item := range valid {
+				err := uc.checkNameConflicts(ctx, 0, item.Name)
+				if errors.Is(err, domain.ErrCompetencyAlreadyExists) {
+					results[validIndexes[j]] = &domain.CompetencyBatchResult{Status: domain.CompetencyBatchDuplicate, Err: err}
+					rejected = mode == domain.CompetencyBatchAllOrNothing
+					continue
+				}
+				if err != nil {
+					return err
+				}
+				creates = append(creates, item)
+				createIndexes = append(createIndexes, validIndexes[j])
+			}
+
+			var created []*domain.Competency
+			if len(creates) > 0 {
+				var err error
+				if created, err = uc.competencyRepo.CreateMany(ctx, creates); err != nil {
+					return err
+				}
+			}
+			createdIDs := make([]int32, 0, len(created))
+			for j, competency := range created {
+				if competency == nil {
+					results[createIndexes[j]] = &domain.CompetencyBatchResult{Status: domain.CompetencyBatchDuplicate, Err: domain.ErrCompetencyAlreadyExists}
+					rejected = mode == domain.CompetencyBatchAllOrNothing
+					continue
+				}
+				results[createIndexes[j]] = &domain.CompetencyBatchResult{Status: domain.CompetencyBatchCreated, Competency: competency}
+				createdIDs = append(createdIDs, competency.ID)
+			}
+			if err := uc.revisionRepo.Record(ctx, revisionChange(ctx, domain.RevisionCreate), createdIDs...); err != nil {
+				return err
+			}
+
+			// Step 4: Roll back a rejected all-or-nothing batch
+			if rejected {
+				return domain.ErrCompetencyBatchRejected
+			}
+			return nil
+		})
+		if err != nil && !errors.Is(err, domain.ErrCompetencyBatchRejected) {
+			uc.logger.ErrorContext(ctx, "failed to create competency batch", "error", err)
+			return nil, fmt.Errorf("%w: %w", ErrCreateCompetency, err)
+		}
+	}
+
+	if rejected {
+		// Nothing was created: items that passed are reported as skipped
+		for i, result := range results {
+			if result == nil || result.Status == domain.CompetencyBatchCreated {
+				results[i] = &domain.CompetencyBatchResult{Status: domain.CompetencyBatchSkipped}
+			}
+		}
+		uc.logger.InfoContext(ctx, "competency batch rejected", "items", len(items))
+		return results, domain.ErrCompetencyBatchRejected
+	}
+
+	uc.logger.InfoContext(ctx, "competency batch created successfully", "items", len(items), "mode", mode)
+	return results, nil
+}
+
+// Import creates and updates competencies from an imported catalogue in one transaction
+// Business logic flow:
+// 1. Check that the user is an admin, the import size and policy
+// 2. Normalize and validate every record like Create does; repeated names are invalid
+// 3. Plan each record against the existing competencies of the same name; new names taken in any locale conflict
+// 4. Apply the plan, unless it is a dry run or the import is rejected
+func (uc *competencyUseCase) Import(ctx context.Context, records []domain.NewCompetency, opts domain.CompetencyImportOptions) (*domain.CompetencyImportReport, error) {
+	// Step 1: Authorize and check the import
+	if err := domain.RequireAdmin(ctx); err != nil {
+		uc.logger.InfoContext(ctx, "competency import not allowed", "reason", err)
+		return nil, err
+	}
+	if opts.OnExisting == "" {
+		opts.OnExisting = domain.CompetencyImportSkip
+	}
+	if len(records) == 0 || len(records) > domain.MaxCompetencyImportSize || !opts.OnExisting.Valid() {
+		uc.logger.InfoContext(ctx, "invalid competency import", "records", len(records), "on_existing", opts.OnExisting)
+		return nil, domain.ErrInvalidCompetencyImport
+	}
+
+	// Step 2: Validate records
+	report := &domain.CompetencyImportReport{DryRun: opts.DryRun, Results: make([]*domain.CompetencyImportResult, len(records))}
+	valid := make([]int, 0, len(records))
+	names := make([]string, 0, len(records))
+	seen := make(map[string]bool, len(records))
+	for i := range records {
+		record := &records[i]
+		record.Name = strings.TrimSpace(record.Name)
+		record.Description = strings.TrimSpace(record.Description)
+		report.Results[i] = &domain.CompetencyImportResult{Name: record.Name}
+
+		if err := uc.validateName(record.Name); err != nil {
+			report.Results[i].Action, report.Results[i].Err = domain.CompetencyImportInvalid, err
+			continue
+		}
+		// Names are unique case-insensitively
+		key := strings.ToLower(record.Name)
+		if seen[key] {
+			report.Results[i].Action, report.Results[i].Err = domain.CompetencyImportInvalid, domain.ErrDuplicateImportRecord
+			continue
+		}
+		seen[key] = true
+		valid = append(valid, i)
+		names = append(names, record.Name)
+	}
+	report.Rejected = len(valid) < len(records)
+
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		// Step 3: Plan the records
+		existing, err := uc.competencyRepo.GetByNames(ctx, names)
+		if err != nil {
+			return err
+		}
+		existingByName := make(map[string]*domain.Competency, len(existing))
+		for _, competency := range existing {
+			existingByName[strings.ToLower(competency.Name)] = competency
+		}
+
+		var creates []domain.NewCompetency
+		var createIndexes, updateIndexes []int
+		for _, i := range valid {
+			result := report.Results[i]
+			current := existingByName[strings.ToLower(records[i].Name)]
+			if current == nil {
+				err := uc.checkNameConflicts(ctx, 0, records[i].Name)
+				if errors.Is(err, domain.ErrCompetencyAlreadyExists) {
+					result.Action, result.Err = domain.CompetencyImportConflict, err
+					report.Rejected = true
+					continue
+				}
+				if err != nil {
+					return err
+				}
+			}
+			switch {
+			case current == nil:
+				result.Action = domain.CompetencyImportCreated
+				creates = append(creates, records[i])
+				createIndexes = append(createIndexes, i)
+			case opts.OnExisting == domain.CompetencyImportFail:
+				result.Action, result.Competency, result.Err = domain.CompetencyImportConflict, current, domain.ErrCompetencyAlreadyExists
+				report.Rejected = true
+			case opts.OnExisting == domain.CompetencyImportSkip:
+				result.Action, result.Competency = domain.CompetencyImportSkipped, current
+			case current.Description == records[i].Description:
+				result.Action, result.Competency = domain.CompetencyImportUnchanged, current
+			default:
+				result.Action, result.Competency = domain.CompetencyImportUpdated, current
+				updateIndexes = append(updateIndexes, i)
+			}
+		}
+		if opts.DryRun || report.Rejected {
+			return nil
+		}
+
+		// Step 4: Apply the plan
+		var created []*domain.Competency
+		if len(creates) > 0 {
+			if created, err = uc.competencyRepo.CreateMany(ctx, creates); err != nil {
+				return err
+			}
+		}
+		createdIDs := make([]int32, 0, len(created))
+		for j, competency := range created {
+			result := report.Results[createIndexes[j]]
+			if competency == nil {
+				// Created concurrently since it was planned
+				result.Action, result.Err = domain.CompetencyImportConflict, domain.ErrCompetencyAlreadyExists
+				report.Rejected = true
+				continue
+			}
+			result.Competency = competency
+			createdIDs = append(createdIDs, competency.ID)
+		}
+		updatedIDs := make([]int32, 0, len(updateIndexes))
+		for _, i := range updateIndexes {
+			result := report.Results[i]
+			updated, err := uc.competencyRepo.UpdateDescription(ctx, result.Competency.ID, records[i].Description, result.Competency.Version)
+			if errors.Is(err, domain.ErrCompetencyNotFound) || errors.Is(err, domain.ErrCompetencyVersionConflict) {
+				// Changed concurrently since it was planned
+				result.Action, result.Err = domain.CompetencyImportConflict, domain.ErrCompetencyVersionConflict
+				report.Rejected = true
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			result.Competency = updated
+			updatedIDs = append(updatedIDs, updated.ID)
+		}
+
+		// Record the changes in the history of the competencies
+		if err := uc.revisionRepo.Record(ctx, revisionChange(ctx, domain.RevisionCreate), createdIDs...); err != nil {
+			return err
+		}
+		if err := uc.revisionRepo.Record(ctx, revisionChange(ctx, domain.RevisionUpdate), updatedIDs...); err != nil {
+			return err
+		}
+
+		// Roll back an import that turned out to be rejected
+		if report.Rejected {
+			return domain.ErrCompetencyImportRejected
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, domain.ErrCompetencyImportRejected) {
+		uc.logger.ErrorContext(ctx, "failed to import competencies", "error", err)
+		return nil, fmt.Errorf("%w: %w", ErrImportCompetencies, err)
+	}
+
+	if report.Rejected && !opts.DryRun {
+		uc.logger.InfoContext(ctx, "competency import rejected", "records", len(records))
+		return report, domain.ErrCompetencyImportRejected
+	}
+	report.Applied = !opts.DryRun
+
+	uc.logger.InfoContext(ctx, "competency import completed", "records", len(records), "dry_run", opts.DryRun, "on_existing", opts.OnExisting)
+	return report, nil
+}
+
+// Export calls yield with every competency that isn't archived, ordered by name
+// Competencies are fetched page by page, so the catalogue is never held in memory
+func (uc *competencyUseCase) Export(ctx context.Context, yield func(*domain.Competency) error) error {
+	params := domain.CompetencyListParams{
+		Sort:      domain.CompetencySortName,
+		Direction: domain.SortAscending,
+		Limit:     domain.MaxCompetencyPageSize,
+	}
+	count := 0
+	for {
+		page, err := uc.competencyRepo.GetAll(ctx, params)
+		if err != nil {
+			uc.logger.ErrorContext(ctx, "failed to export competencies", "error", err, "exported", count)
+			return fmt.Errorf("%w: %w", ErrGetCompetencies, err)
+		}
+		for _, competency := range page.Competencies {
+			if err := yield(competency); err != nil {
+				return err
+			}
+			count++
+		}
+		if page.NextCursor == nil {
+			break
+		}
+		params.After = page.NextCursor
+	}
+
+	uc.logger.InfoContext(ctx, "competencies exported successfully", "count", count)
+	return nil
+}
+
+// GetByID retrieves a competency by its ID with the related data selected by include, in the locale of ctx
+// The competency, its related data and translation are read in one transaction, so they match its version
+// The ID of a merged competency gets the competency it was merged into
+func (uc *competencyUseCase) GetByID(ctx context.Context, id int32, include domain.CompetencyInclude) (*domain.Competency, error) {
+	var competency *domain.Competency
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		competency, err = uc.competencyRepo.GetByID(ctx, id)
+		if errors.Is(err, domain.ErrCompetencyNotFound) {
+			var survivorID int32
+			if survivorID, err = uc.mergeRepo.GetRedirect(ctx, id); err != nil {
+				return err
+			}
+			uc.logger.InfoContext(ctx, "merged competency redirected", "id", id, "competency_id", survivorID)
+			competency, err = uc.competencyRepo.GetByID(ctx, survivorID)
+		}
+		if err != nil {
+			return err
+		}
+		if include.Levels {
+			rubric, err := uc.rubricRepo.GetRubric(ctx, competency.ID)
+			if err != nil && !errors.Is(err, domain.ErrRubricNotFound) {
+				return err
+			}
+			competency.Rubric = rubric
+		}
+		return localize(ctx, uc.translationRepo, competency)
+	})
 	if err != nil {
 		if errors.Is(err, domain.ErrCompetencyNotFound) {
-			uc.logger.Info("competency not found", "id", id)
+			uc.logger.InfoContext(ctx, "competency not found", "id", id)
 			return nil, domain.ErrCompetencyNotFound
 		}
-		uc.logger.Error("failed to get competency by ID", "error", err, "id", id)
+		uc.logger.ErrorContext(ctx, "failed to get competency by ID", "error", err, "id", id)
 		return nil, fmt.Errorf("%w: %w", ErrGetCompetency, err)
 	}
 
@@ -98,50 +460,483 @@ func (uc *competencyUseCase) GetByName(ctx context.Context, name string) (*domai
 	competency, err := uc.competencyRepo.GetByName(ctx, name)
 	if err != nil {
 		if errors.Is(err, domain.ErrCompetencyNotFound) {
-			uc.logger.Info("competency not found", "name", name)
+			uc.logger.InfoContext(ctx, "competency not found", "name", name)
 			return nil, domain.ErrCompetencyNotFound
 		}
-		uc.logger.Error("failed to get competency by name", "error", err, "name", name)
+		uc.logger.ErrorContext(ctx, "failed to get competency by name", "error", err, "name", name)
 		return nil, fmt.Errorf("%w: %w", ErrGetCompetency, err)
 	}
 
 	return competency, nil
 }
 
-// GetAll retrieves all competencies
-func (uc *competencyUseCase) GetAll(ctx context.Context) ([]*domain.Competency, error) {
-	competencies, err := uc.competencyRepo.GetAll(ctx)
+// GetAll retrieves a page of competencies
+// Business logic flow:
+// 1. Apply the defaults: newest first, DefaultCompetencyPageSize items (capped at MaxCompetencyPageSize), any of the tags
+// 2. Check that the cursor belongs to the requested ordering
+// 3. Get the page from repository, in the locale of ctx
+func (uc *competencyUseCase) GetAll(ctx context.Context, params domain.CompetencyListParams) (*domain.CompetencyPage, error) {
+	// Step 1: Apply defaults
+	params.Filter.NamePrefix = strings.TrimSpace(params.Filter.NamePrefix)
+	if params.Sort == "" {
+		params.Sort = domain.CompetencySortCreatedAt
+	}
+	if params.Direction == "" {
+		params.Direction = domain.SortDescending
+		if params.Sort == domain.CompetencySortName {
+			params.Direction = domain.SortAscending
+		}
+	}
+	if !params.Sort.Valid() || !params.Direction.Valid() {
+		uc.logger.InfoContext(ctx, "invalid competency sort", "sort", params.Sort, "direction", params.Direction)
+		return nil, domain.ErrInvalidCompetencySort
+	}
+	params.Limit = clampLimit(params.Limit, domain.DefaultCompetencyPageSize, domain.MaxCompetencyPageSize)
+	if params.Filter.TagMatch == "" {
+		params.Filter.TagMatch = domain.TagMatchAny
+	}
+	if !params.Filter.TagMatch.Valid() {
+		uc.logger.InfoContext(ctx, "invalid tag match", "tag_match", params.Filter.TagMatch)
+		return nil, domain.ErrInvalidTagMatch
+	}
+	tags, err := normalizeTagNames(params.Filter.Tags)
 	if err != nil {
-		uc.logger.Error("failed to get all competencies", "error", err)
+		uc.logger.InfoContext(ctx, "invalid tag filter", "error", err)
+		return nil, err
+	}
+	params.Filter.Tags = tags
+
+	// Step 2: A cursor is a position within one ordering only
+	if after := params.After; after != nil && (after.Sort != params.Sort || after.Direction != params.Direction) {
+		uc.logger.InfoContext(ctx, "competency cursor issued for another ordering", "cursor_sort", after.Sort, "sort", params.Sort)
+		return nil, domain.ErrInvalidCompetencyCursor
+	}
+
+	// Step 3: Get the page
+	// Filters and sorting by name apply to the fallback names
+	var page *domain.CompetencyPage
+	err = uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		if page, err = uc.competencyRepo.GetAll(ctx, params); err != nil {
+			return err
+		}
+		return localize(ctx, uc.translationRepo, page.Competencies...)
+	})
+	if err != nil {
+		uc.logger.ErrorContext(ctx, "failed to list competencies", "error", err)
 		return nil, fmt.Errorf("%w: %w", ErrGetCompetencies, err)
 	}
 
-	uc.logger.Info("competencies retrieved successfully", "count", len(competencies))
-	return competencies, nil
+	uc.logger.InfoContext(ctx, "competencies retrieved successfully", "count", len(page.Competencies), "total", page.Total)
+	return page, nil
+}
+
+// Search retrieves the competencies matching a search query
+// Business logic flow:
+// 1. Normalize the query and reject blank ones
+// 2. Apply the default and maximum limit
+// 3. Search in repository
+func (uc *competencyUseCase) Search(ctx context.Context, query string, limit int32) ([]*domain.CompetencySearchResult, error) {
+	// Step 1: Normalize query
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, domain.ErrInvalidSearchQuery
+	}
+
+	// Step 2: Apply limits
+	limit = clampLimit(limit, domain.DefaultCompetencySearchLimit, domain.MaxCompetencySearchLimit)
+
+	// Step 3: Search
+	results, err := uc.competencyRepo.Search(ctx, query, limit)
+	if err != nil {
+		uc.logger.ErrorContext(ctx, "failed to search competencies", "error", err, "query", query)
+		return nil, fmt.Errorf("%w: %w", ErrSearchCompetencies, err)
+	}
+
+	uc.logger.InfoContext(ctx, "competencies searched successfully", "query", query, "count", len(results))
+	return results, nil
+}
+
+// Suggest retrieves competency names for typeahead
+func (uc *competencyUseCase) Suggest(ctx context.Context, prefix string, limit int32) ([]*domain.CompetencySuggestion, error) {
+	// Normalize prefix
+	prefix = strings.TrimSpace(prefix)
+	if prefix == "" {
+		return nil, domain.ErrInvalidSearchQuery
+	}
+
+	limit = clampLimit(limit, domain.DefaultCompetencySuggestLimit, domain.MaxCompetencySuggestLimit)
+
+	suggestions, err := uc.competencyRepo.Suggest(ctx, prefix, limit)
+	if err != nil {
+		uc.logger.ErrorContext(ctx, "failed to suggest competencies", "error", err, "prefix", prefix)
+		return nil, fmt.Errorf("%w: %w", ErrSearchCompetencies, err)
+	}
+
+	return suggestions, nil
 }
 
 // UpdateDescription updates the description of a competency
 // Business logic flow:
-// 1. Validate that competency exists (implicitly done by repository)
-// 2. Update description in repository
-func (uc *competencyUseCase) UpdateDescription(ctx context.Context, id int32, description string) (*domain.Competency, error) {
+// 1. Check that the user is an admin or a steward of the competency
+// 2. Validate that competency exists and has the expected version (implicitly done by repository)
+// 3. Update description in repository
+func (uc *competencyUseCase) UpdateDescription(ctx context.Context, id int32, description string, expectedVersion int32) (*domain.Competency, error) {
+	// Step 1: Authorize
+	if err := requireEditor(ctx, uc.stewardRepo, id); err != nil {
+		uc.logger.InfoContext(ctx, "competency update not allowed", "reason", err, "competency_id", id)
+		return nil, err
+	}
+
 	// Normalize description
 	description = strings.TrimSpace(description)
 
-	competency, err := uc.competencyRepo.UpdateDescription(ctx, id, description)
+	competency, err := uc.change(ctx, domain.RevisionUpdate, func(ctx context.Context) (*domain.Competency, error) {
+		return uc.competencyRepo.UpdateDescription(ctx, id, description, expectedVersion)
+	})
 	if err != nil {
 		if errors.Is(err, domain.ErrCompetencyNotFound) {
-			uc.logger.Info("competency not found for update", "id", id)
+			uc.logger.InfoContext(ctx, "competency not found for update", "id", id)
 			return nil, domain.ErrCompetencyNotFound
 		}
-		uc.logger.Error("failed to update competency description", "error", err, "id", id)
+		if errors.Is(err, domain.ErrCompetencyVersionConflict) {
+			uc.logger.InfoContext(ctx, "competency version conflict on update", "id", id, "expected_version", expectedVersion)
+			return nil, domain.ErrCompetencyVersionConflict
+		}
+		uc.logger.ErrorContext(ctx, "failed to update competency description", "error", err, "id", id)
 		return nil, fmt.Errorf("%w: %w", ErrUpdateCompetency, err)
 	}
 
-	uc.logger.Info("competency description updated successfully", "competency_id", competency.ID)
+	uc.logger.InfoContext(ctx, "competency description updated successfully", "competency_id", competency.ID)
 	return competency, nil
 }
 
+// Rename renames a competency
+// Business logic flow:
+// 1. Check that the user is an admin or a steward of the competency
+// 2. Normalize and validate the name like Create does
+// 3. Check the name isn't shown by another competency in a locale the competency shows it in
+// 4. Rename in repository, which checks the version and the uniqueness of the name
+func (uc *competencyUseCase) Rename(ctx context.Context, id int32, name string, expectedVersion int32) (*domain.Competency, error) {
+	// Step 1: Authorize
+	if err := requireEditor(ctx, uc.stewardRepo, id); err != nil {
+		uc.logger.InfoContext(ctx, "competency rename not allowed", "reason", err, "competency_id", id)
+		return nil, err
+	}
+
+	// Step 2: Validate name
+	name = strings.TrimSpace(name)
+	if err := uc.validateName(name); err != nil {
+		uc.logger.InfoContext(ctx, "invalid competency name for rename", "error", err, "id", id)
+		return nil, err
+	}
+
+	competency, err := uc.change(ctx, domain.RevisionRename, func(ctx context.Context) (*domain.Competency, error) {
+		// Step 3: Check name conflicts
+		if err := uc.checkNameConflicts(ctx, id, name); err != nil {
+			return nil, err
+		}
+
+		// Step 4: Rename
+		return uc.competencyRepo.Rename(ctx, id, name, expectedVersion)
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrCompetencyNotFound):
+			uc.logger.InfoContext(ctx, "competency not found for rename", "id", id)
+			return nil, domain.ErrCompetencyNotFound
+		case errors.Is(err, domain.ErrCompetencyVersionConflict):
+			uc.logger.InfoContext(ctx, "competency version conflict on rename", "id", id, "expected_version", expectedVersion)
+			return nil, domain.ErrCompetencyVersionConflict
+		case errors.Is(err, domain.ErrCompetencyAlreadyExists):
+			uc.logger.InfoContext(ctx, "competency name already taken", "id", id, "name", name)
+			return nil, domain.ErrCompetencyAlreadyExists
+		}
+		uc.logger.ErrorContext(ctx, "failed to rename competency", "error", err, "id", id)
+		return nil, fmt.Errorf("%w: %w", ErrUpdateCompetency, err)
+	}
+
+	uc.logger.InfoContext(ctx, "competency renamed successfully", "competency_id", competency.ID, "name", competency.Name)
+	return competency, nil
+}
+
+// Archive hides a competency from listings and search
+func (uc *competencyUseCase) Archive(ctx context.Context, id int32) (*domain.Competency, error) {
+	if err := requireEditor(ctx, uc.stewardRepo, id); err != nil {
+		uc.logger.InfoContext(ctx, "competency archive not allowed", "reason", err, "competency_id", id)
+		return nil, err
+	}
+
+	competency, err := uc.change(ctx, domain.RevisionArchive, func(ctx context.Context) (*domain.Competency, error) {
+		return uc.competencyRepo.Archive(ctx, id)
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrCompetencyNotFound) {
+			uc.logger.InfoContext(ctx, "competency not found for archive", "id", id)
+			return nil, domain.ErrCompetencyNotFound
+		}
+		uc.logger.ErrorContext(ctx, "failed to archive competency", "error", err, "id", id)
+		return nil, fmt.Errorf("%w: %w", ErrUpdateCompetency, err)
+	}
+
+	uc.logger.InfoContext(ctx, "competency archived successfully", "competency_id", competency.ID)
+	return competency, nil
+}
+
+// Restore brings an archived competency back
+func (uc *competencyUseCase) Restore(ctx context.Context, id int32) (*domain.Competency, error) {
+	if err := requireEditor(ctx, uc.stewardRepo, id); err != nil {
+		uc.logger.InfoContext(ctx, "competency restore not allowed", "reason", err, "competency_id", id)
+		return nil, err
+	}
+
+	competency, err := uc.change(ctx, domain.RevisionRestore, func(ctx context.Context) (*domain.Competency, error) {
+		return uc.competencyRepo.Restore(ctx, id)
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrCompetencyNotFound) {
+			uc.logger.InfoContext(ctx, "competency not found for restore", "id", id)
+			return nil, domain.ErrCompetencyNotFound
+		}
+		uc.logger.ErrorContext(ctx, "failed to restore competency", "error", err, "id", id)
+		return nil, fmt.Errorf("%w: %w", ErrUpdateCompetency, err)
+	}
+
+	uc.logger.InfoContext(ctx, "competency restored successfully", "competency_id", competency.ID)
+	return competency, nil
+}
+
+// SetCategory places a competency under a category
+// The foreign key guarantees the category exists, so no separate check is needed
+func (uc *competencyUseCase) SetCategory(ctx context.Context, id int32, categoryID *int32) (*domain.Competency, error) {
+	if err := requireEditor(ctx, uc.stewardRepo, id); err != nil {
+		uc.logger.InfoContext(ctx, "competency category change not allowed", "reason", err, "competency_id", id)
+		return nil, err
+	}
+
+	competency, err := uc.change(ctx, domain.RevisionCategorize, func(ctx context.Context) (*domain.Competency, error) {
+		return uc.competencyRepo.SetCategory(ctx, id, categoryID)
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrCompetencyNotFound):
+			uc.logger.InfoContext(ctx, "competency not found for category change", "id", id)
+			return nil, domain.ErrCompetencyNotFound
+		case errors.Is(err, domain.ErrCategoryNotFound):
+			uc.logger.InfoContext(ctx, "category not found for competency", "id", id, "category_id", categoryID)
+			return nil, domain.ErrCategoryNotFound
+		}
+		uc.logger.ErrorContext(ctx, "failed to set competency category", "error", err, "id", id)
+		return nil, fmt.Errorf("%w: %w", ErrUpdateCompetency, err)
+	}
+
+	uc.logger.InfoContext(ctx, "competency category set successfully", "competency_id", competency.ID, "category_id", categoryID)
+	return competency, nil
+}
+
+// Delete deletes a competency permanently
+// Its references cascade in the database, so the repository refuses to delete a competency that prerequisite or
+// related edges, merge redirects or a rubric still reference; the check and the deletion share a transaction
+// Admins only: the deletion takes the history of the competency with it, which stewards can't undo
+func (uc *competencyUseCase) Delete(ctx context.Context, id int32) error {
+	if err := domain.RequireAdmin(ctx); err != nil {
+		uc.logger.InfoContext(ctx, "competency deletion not allowed", "reason", err, "competency_id", id)
+		return err
+	}
+
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		return uc.competencyRepo.Delete(ctx, id)
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrCompetencyNotFound):
+			uc.logger.InfoContext(ctx, "competency not found for delete", "id", id)
+			return domain.ErrCompetencyNotFound
+		case errors.Is(err, domain.ErrCompetencyInUse):
+			uc.logger.InfoContext(ctx, "competency in use, not deleted", "id", id)
+			return domain.ErrCompetencyInUse
+		}
+		uc.logger.ErrorContext(ctx, "failed to delete competency", "error", err, "id", id)
+		return fmt.Errorf("%w: %w", ErrDeleteCompetency, err)
+	}
+
+	uc.logger.InfoContext(ctx, "competency deleted successfully", "competency_id", id)
+	return nil
+}
+
+// History retrieves the revisions of a competency, newest first
+// A non-positive limit means DefaultRevisionPageSize, and it is capped at MaxRevisionPageSize
+func (uc *competencyUseCase) History(ctx context.Context, id, before, limit int32) ([]*domain.CompetencyRevision, error) {
+	limit = clampLimit(limit, domain.DefaultRevisionPageSize, domain.MaxRevisionPageSize)
+
+	var revisions []*domain.CompetencyRevision
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if _, err := uc.competencyRepo.GetByID(ctx, id); err != nil {
+			return err
+		}
+		var err error
+		revisions, err = uc.revisionRepo.GetAll(ctx, id, before, limit)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrCompetencyNotFound) {
+			uc.logger.InfoContext(ctx, "competency not found for history", "id", id)
+			return nil, domain.ErrCompetencyNotFound
+		}
+		uc.logger.ErrorContext(ctx, "failed to get competency history", "error", err, "id", id)
+		return nil, fmt.Errorf("%w: %w", ErrGetCompetencyHistory, err)
+	}
+
+	return revisions, nil
+}
+
+// Diff compares the states recorded by two revisions of a competency
+func (uc *competencyUseCase) Diff(ctx context.Context, id, from, to int32) (*domain.RevisionDiff, error) {
+	diff := &domain.RevisionDiff{}
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if _, err := uc.competencyRepo.GetByID(ctx, id); err != nil {
+			return err
+		}
+		var err error
+		if diff.From, err = uc.revisionRepo.GetByRevision(ctx, id, from); err != nil {
+			return err
+		}
+		diff.To, err = uc.revisionRepo.GetByRevision(ctx, id, to)
+		return err
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrCompetencyNotFound):
+			uc.logger.InfoContext(ctx, "competency not found for diff", "id", id)
+			return nil, domain.ErrCompetencyNotFound
+		case errors.Is(err, domain.ErrRevisionNotFound):
+			uc.logger.InfoContext(ctx, "competency revision not found for diff", "id", id, "from", from, "to", to)
+			return nil, domain.ErrRevisionNotFound
+		}
+		uc.logger.ErrorContext(ctx, "failed to diff competency revisions", "error", err, "id", id)
+		return nil, fmt.Errorf("%w: %w", ErrGetCompetencyHistory, err)
+	}
+
+	diff.Changes = diff.From.After.Diff(diff.To.After)
+	return diff, nil
+}
+
+// Revert sets a competency back to the state recorded by one of its revisions
+// Business logic flow:
+// 1. Check that the user is an admin or a steward of the competency
+// 2. Get the revision to revert to
+// 3. Set its state if the competency still has the expected version; the name must still be free and the category exist
+// 4. Record the revert as a new revision, so it can be reverted too
+func (uc *competencyUseCase) Revert(ctx context.Context, id, revision, expectedVersion int32) (*domain.Competency, error) {
+	// Step 1: Authorize
+	if err := requireEditor(ctx, uc.stewardRepo, id); err != nil {
+		uc.logger.InfoContext(ctx, "competency revert not allowed", "reason", err, "competency_id", id)
+		return nil, err
+	}
+
+	var competency *domain.Competency
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		// Step 2: Get the revision
+		if _, err := uc.competencyRepo.GetByID(ctx, id); err != nil {
+			return err
+		}
+		target, err := uc.revisionRepo.GetByRevision(ctx, id, revision)
+		if err != nil {
+			return err
+		}
+
+		// Step 3: Set its state
+		if err := uc.checkNameConflicts(ctx, id, target.After.Name); err != nil {
+			return err
+		}
+		if competency, err = uc.competencyRepo.Revert(ctx, id, target.After, expectedVersion); err != nil {
+			return err
+		}
+
+		// Step 4: Record the revert
+		change := revisionChange(ctx, domain.RevisionRevert)
+		change.RevertedFrom = &revision
+		return uc.revisionRepo.Record(ctx, change, id)
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrCompetencyNotFound):
+			uc.logger.InfoContext(ctx, "competency not found for revert", "id", id)
+			return nil, domain.ErrCompetencyNotFound
+		case errors.Is(err, domain.ErrRevisionNotFound):
+			uc.logger.InfoContext(ctx, "competency revision not found for revert", "id", id, "revision", revision)
+			return nil, domain.ErrRevisionNotFound
+		case errors.Is(err, domain.ErrCompetencyVersionConflict):
+			uc.logger.InfoContext(ctx, "competency version conflict on revert", "id", id, "expected_version", expectedVersion)
+			return nil, domain.ErrCompetencyVersionConflict
+		case errors.Is(err, domain.ErrCompetencyAlreadyExists):
+			uc.logger.InfoContext(ctx, "reverted competency name already taken", "id", id, "revision", revision)
+			return nil, domain.ErrCompetencyAlreadyExists
+		case errors.Is(err, domain.ErrCategoryNotFound):
+			uc.logger.InfoContext(ctx, "reverted competency category no longer exists", "id", id, "revision", revision)
+			return nil, domain.ErrCategoryNotFound
+		}
+		uc.logger.ErrorContext(ctx, "failed to revert competency", "error", err, "id", id, "revision", revision)
+		return nil, fmt.Errorf("%w: %w", ErrUpdateCompetency, err)
+	}
+
+	uc.logger.InfoContext(ctx, "competency reverted successfully", "competency_id", competency.ID, "revision", revision)
+	return competency, nil
+}
+
+// change runs a change of a competency and records the competency it returns as a revision, in one transaction
+func (uc *competencyUseCase) change(ctx context.Context, action domain.RevisionAction, fn func(ctx context.Context) (*domain.Competency, error)) (*domain.Competency, error) {
+	var competency *domain.Competency
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		if competency, err = fn(ctx); err != nil {
+			return err
+		}
+		return uc.revisionRepo.Record(ctx, revisionChange(ctx, action), competency.ID)
+	})
+	return competency, err
+}
+
+// checkNameConflicts checks that name, as the own name of the competency of id (0 for a new competency), isn't
+// shown by another competency in the locales it would be shown in: the fallback locale and the locales the
+// competency isn't translated into, every locale for a new competency
+// Returns domain.ErrCompetencyAlreadyExists if it is
+func (uc *competencyUseCase) checkNameConflicts(ctx context.Context, id int32, name string) error {
+	locales := domain.SupportedLocales
+	if id != 0 {
+		translations, err := uc.translationRepo.GetAll(ctx, id)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrCheckExistingCompetency, err)
+		}
+		locales = slices.DeleteFunc(slices.Clone(locales), func(l domain.Locale) bool {
+			return l != domain.FallbackLocale && slices.ContainsFunc(translations, func(t *domain.CompetencyTranslation) bool {
+				return t.Locale == l
+			})
+		})
+	}
+	return checkNameAvailable(ctx, uc.translationRepo, name, locales, id)
+}
+
+// revisionChange describes a change credited to the author of ctx (none for anonymous requests)
+func revisionChange(ctx context.Context, action domain.RevisionAction) domain.RevisionChange {
+	change := domain.RevisionChange{Action: action}
+	if authorID, ok := domain.AuthorFromContext(ctx); ok {
+		change.EditorID = &authorID
+	}
+	return change
+}
+
+// clampLimit returns defaultLimit for non-positive limits and caps the others at maxLimit
+func clampLimit(limit, defaultLimit, maxLimit int32) int32 {
+	switch {
+	case limit <= 0:
+		return defaultLimit
+	case limit > maxLimit:
+		return maxLimit
+	}
+	return limit
+}
+
 // validateName performs basic name validation
 // For POC: minimal validation - just check it's not empty and has reasonable length
 // Production: add more sophisticated validation rules
@@ -4,11 +4,24 @@ import "errors"
 
 var (
 	// Dependency errors
-	ErrUserRepositoryNil       = errors.New("user repository cannot be nil")
-	ErrCompetencyRepositoryNil = errors.New("competency repository cannot be nil")
-	ErrPasswordHasherNil       = errors.New("password hasher cannot be nil")
-	ErrTokenGeneratorNil       = errors.New("token generator cannot be nil")
-	ErrLoggerNil               = errors.New("logger cannot be nil")
+	ErrUserRepositoryNil         = errors.New("user repository cannot be nil")
+	ErrCompetencyRepositoryNil   = errors.New("competency repository cannot be nil")
+	ErrCategoryRepositoryNil     = errors.New("category repository cannot be nil")
+	ErrRubricRepositoryNil       = errors.New("rubric repository cannot be nil")
+	ErrTagRepositoryNil          = errors.New("tag repository cannot be nil")
+	ErrRevisionRepositoryNil     = errors.New("revision repository cannot be nil")
+	ErrRelationRepositoryNil     = errors.New("relation repository cannot be nil")
+	ErrTranslationRepositoryNil  = errors.New("translation repository cannot be nil")
+	ErrMergeRepositoryNil        = errors.New("merge repository cannot be nil")
+	ErrProposalRepositoryNil     = errors.New("proposal repository cannot be nil")
+	ErrCompetencyUseCaseNil      = errors.New("competency use case cannot be nil")
+	ErrStewardRepositoryNil      = errors.New("steward repository cannot be nil")
+	ErrNotificationRepositoryNil = errors.New("notification repository cannot be nil")
+	ErrFrameworkRepositoryNil    = errors.New("framework repository cannot be nil")
+	ErrTransactorNil             = errors.New("transactor cannot be nil")
+	ErrPasswordHasherNil         = errors.New("password hasher cannot be nil")
+	ErrTokenGeneratorNil         = errors.New("token generator cannot be nil")
+	ErrLoggerNil                 = errors.New("logger cannot be nil")
 
 	// User operation errors
 	ErrCheckExistingUser = errors.New("failed to check existing user")
@@ -23,4 +36,63 @@ var (
 	ErrGetCompetency           = errors.New("failed to get competency")
 	ErrGetCompetencies         = errors.New("failed to get competencies")
 	ErrUpdateCompetency        = errors.New("failed to update competency")
+	ErrSearchCompetencies      = errors.New("failed to search competencies")
+	ErrImportCompetencies      = errors.New("failed to import competencies")
+	ErrDeleteCompetency        = errors.New("failed to delete competency")
+	ErrGetCompetencyHistory    = errors.New("failed to get competency history")
+
+	// Category operation errors
+	ErrCreateCategory = errors.New("failed to create category")
+	ErrGetCategory    = errors.New("failed to get category")
+	ErrUpdateCategory = errors.New("failed to update category")
+	ErrDeleteCategory = errors.New("failed to delete category")
+
+	// Rubric operation errors
+	ErrCreateRatingScale = errors.New("failed to create rating scale")
+	ErrGetRatingScale    = errors.New("failed to get rating scale")
+	ErrUpdateRatingScale = errors.New("failed to update rating scale")
+	ErrDeleteRatingScale = errors.New("failed to delete rating scale")
+	ErrGetRubric         = errors.New("failed to get rubric")
+	ErrUpdateRubric      = errors.New("failed to update rubric")
+
+	// Tag operation errors
+	ErrCreateTag            = errors.New("failed to create tag")
+	ErrGetTag               = errors.New("failed to get tag")
+	ErrUpdateTag            = errors.New("failed to update tag")
+	ErrDeleteTag            = errors.New("failed to delete tag")
+	ErrUpdateCompetencyTags = errors.New("failed to update competency tags")
+
+	// Relation operation errors
+	ErrGetRelations    = errors.New("failed to get competency relations")
+	ErrUpdateRelations = errors.New("failed to update competency relations")
+
+	// Translation operation errors
+	ErrGetTranslations   = errors.New("failed to get competency translations")
+	ErrUpdateTranslation = errors.New("failed to update competency translation")
+	ErrLocalize          = errors.New("failed to localize competencies")
+
+	// Merge operation errors
+	ErrFindDuplicates    = errors.New("failed to find duplicate competencies")
+	ErrMergeCompetencies = errors.New("failed to merge competencies")
+
+	// Proposal operation errors
+	ErrCreateProposal = errors.New("failed to create proposal")
+	ErrGetProposal    = errors.New("failed to get proposal")
+	ErrUpdateProposal = errors.New("failed to update proposal")
+	ErrReviewProposal = errors.New("failed to review proposal")
+
+	// Steward operation errors
+	ErrCheckSteward     = errors.New("failed to check competency steward")
+	ErrGetStewards      = errors.New("failed to get competency stewards")
+	ErrUpdateStewards   = errors.New("failed to update competency stewards")
+	ErrReportCompetency = errors.New("failed to report competency")
+	ErrGetReports       = errors.New("failed to get competency reports")
+
+	// Notification operation errors
+	ErrGetNotifications   = errors.New("failed to get notifications")
+	ErrUpdateNotification = errors.New("failed to update notification")
+
+	// Framework operation errors
+	ErrPublishFramework = errors.New("failed to publish framework version")
+	ErrGetFramework     = errors.New("failed to get framework version")
 )
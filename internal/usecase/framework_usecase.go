@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+)
+
+// frameworkUseCase implements domain.FrameworkUseCase
+type frameworkUseCase struct {
+	frameworkRepo domain.FrameworkRepository
+	logger        *slog.Logger
+}
+
+// NewFrameworkUseCase creates a new framework use case instance
+func NewFrameworkUseCase(frameworkRepo domain.FrameworkRepository, logger *slog.Logger) (domain.FrameworkUseCase, error) {
+	// Nil-check the injected dependencies
+	if frameworkRepo == nil {
+		return nil, ErrFrameworkRepositoryNil
+	}
+	if logger == nil {
+		return nil, ErrLoggerNil
+	}
+	return &frameworkUseCase{
+		frameworkRepo: frameworkRepo,
+		logger:        logger,
+	}, nil
+}
+
+// Publish snapshots the current framework as an immutable version
+// Business logic flow:
+// 1. Check that the user is an admin
+// 2. Normalize and validate the name and notes
+// 3. Publish in repository, which refuses a name that is taken
+func (uc *frameworkUseCase) Publish(ctx context.Context, name, notes string) (*domain.FrameworkVersion, error) {
+	// Step 1: Authorize
+	if err := domain.RequireAdmin(ctx); err != nil {
+		uc.logger.InfoContext(ctx, "framework publishing not allowed", "reason", err)
+		return nil, err
+	}
+	admin, _ := domain.ActorFromContext(ctx)
+
+	// Step 2: Validate
+	name = strings.TrimSpace(name)
+	notes = strings.TrimSpace(notes)
+	if !domain.ValidFrameworkVersionName(name) {
+		uc.logger.InfoContext(ctx, "invalid framework version name", "name", name)
+		return nil, fmt.Errorf("%w: invalid name '%s'", domain.ErrInvalidFrameworkVersion, name)
+	}
+	if utf8.RuneCountInString(notes) > domain.MaxFrameworkVersionNotesLength {
+		uc.logger.InfoContext(ctx, "framework version notes too long", "name", name)
+		return nil, fmt.Errorf("%w: notes have more than %d characters", domain.ErrInvalidFrameworkVersion, domain.MaxFrameworkVersionNotesLength)
+	}
+
+	// Step 3: Publish
+	version, err := uc.frameworkRepo.Publish(ctx, name, notes, &admin.ID)
+	if err != nil {
+		if errors.Is(err, domain.ErrFrameworkVersionAlreadyExists) {
+			uc.logger.InfoContext(ctx, "framework version name already taken", "name", name)
+			return nil, domain.ErrFrameworkVersionAlreadyExists
+		}
+		uc.logger.ErrorContext(ctx, "failed to publish framework version", "error", err, "name", name)
+		return nil, fmt.Errorf("%w: %w", ErrPublishFramework, err)
+	}
+
+	uc.logger.InfoContext(ctx, "framework version published successfully", "version_id", version.ID, "name", name)
+	return version, nil
+}
+
+// GetVersions retrieves the published versions, latest first
+func (uc *frameworkUseCase) GetVersions(ctx context.Context) ([]*domain.FrameworkVersion, error) {
+	versions, err := uc.frameworkRepo.GetAll(ctx)
+	if err != nil {
+		uc.logger.ErrorContext(ctx, "failed to get framework versions", "error", err)
+		return nil, fmt.Errorf("%w: %w", ErrGetFramework, err)
+	}
+	return versions, nil
+}
+
+// GetVersion retrieves a published version with its snapshot, or the draft
+func (uc *frameworkUseCase) GetVersion(ctx context.Context, name string) (*domain.FrameworkVersion, error) {
+	version, err := uc.version(ctx, name)
+	if err != nil {
+		if errors.Is(err, domain.ErrFrameworkVersionNotFound) {
+			return nil, domain.ErrFrameworkVersionNotFound
+		}
+		uc.logger.ErrorContext(ctx, "failed to get framework version", "error", err, "name", name)
+		return nil, fmt.Errorf("%w: %w", ErrGetFramework, err)
+	}
+	return version, nil
+}
+
+// Diff compares two versions, matching their categories and competencies by ID
+func (uc *frameworkUseCase) Diff(ctx context.Context, from, to string) (*domain.FrameworkDiff, error) {
+	fromVersion, err := uc.GetVersion(ctx, from)
+	if err != nil {
+		return nil, err
+	}
+	toVersion, err := uc.GetVersion(ctx, to)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &domain.FrameworkDiff{From: fromVersion.Name, To: toVersion.Name}
+	diff.Categories, diff.Competencies = fromVersion.Snapshot.Diff(*toVersion.Snapshot)
+	return diff, nil
+}
+
+// version retrieves a published version, or takes a snapshot of the draft for DraftFrameworkVersion
+func (uc *frameworkUseCase) version(ctx context.Context, name string) (*domain.FrameworkVersion, error) {
+	if name != domain.DraftFrameworkVersion {
+		return uc.frameworkRepo.GetByName(ctx, name)
+	}
+
+	snapshot, err := uc.frameworkRepo.GetDraft(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.FrameworkVersion{
+		Name:            domain.DraftFrameworkVersion,
+		CompetencyCount: int32(len(snapshot.Competencies)),
+		Snapshot:        snapshot,
+	}, nil
+}
@@ -0,0 +1,161 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+)
+
+// mergeUseCase implements domain.MergeUseCase
+type mergeUseCase struct {
+	mergeRepo      domain.MergeRepository
+	competencyRepo domain.CompetencyRepository
+	relationRepo   domain.RelationRepository
+	revisionRepo   domain.RevisionRepository
+	transactor     domain.Transactor
+	logger         *slog.Logger
+}
+
+// NewMergeUseCase creates a new merge use case instance
+func NewMergeUseCase(
+	mergeRepo domain.MergeRepository,
+	competencyRepo domain.CompetencyRepository,
+	relationRepo domain.RelationRepository,
+	revisionRepo domain.RevisionRepository,
+	transactor domain.Transactor,
+	logger *slog.Logger,
+) (domain.MergeUseCase, error) {
+	// Nil-check the injected dependencies
+	if mergeRepo == nil {
+		return nil, ErrMergeRepositoryNil
+	}
+	if competencyRepo == nil {
+		return nil, ErrCompetencyRepositoryNil
+	}
+	if relationRepo == nil {
+		return nil, ErrRelationRepositoryNil
+	}
+	if revisionRepo == nil {
+		return nil, ErrRevisionRepositoryNil
+	}
+	if transactor == nil {
+		return nil, ErrTransactorNil
+	}
+	if logger == nil {
+		return nil, ErrLoggerNil
+	}
+	return &mergeUseCase{
+		mergeRepo:      mergeRepo,
+		competencyRepo: competencyRepo,
+		relationRepo:   relationRepo,
+		revisionRepo:   revisionRepo,
+		transactor:     transactor,
+		logger:         logger,
+	}, nil
+}
+
+// FindDuplicates reports pairs of similar competencies
+// Business logic flow:
+// 1. Check that the user is an admin
+// 2. Apply the default threshold and limit, and check the threshold
+// 3. Get the pairs from repository
+func (uc *mergeUseCase) FindDuplicates(ctx context.Context, threshold float32, limit int32) ([]*domain.DuplicateCandidate, error) {
+	// Step 1: Authorize
+	if err := domain.RequireAdmin(ctx); err != nil {
+		uc.logger.InfoContext(ctx, "duplicate report not allowed", "reason", err)
+		return nil, err
+	}
+
+	// Step 2: Apply the defaults
+	if threshold == 0 {
+		threshold = domain.DefaultDuplicateThreshold
+	}
+	if threshold < domain.MinDuplicateThreshold || threshold > 1 {
+		uc.logger.InfoContext(ctx, "invalid duplicate threshold", "threshold", threshold)
+		return nil, domain.ErrInvalidDuplicateThreshold
+	}
+	limit = clampLimit(limit, domain.DefaultDuplicateLimit, domain.MaxDuplicateLimit)
+
+	// Step 3: Find the pairs
+	candidates, err := uc.mergeRepo.GetDuplicates(ctx, threshold, limit)
+	if err != nil {
+		uc.logger.ErrorContext(ctx, "failed to find duplicate competencies", "error", err)
+		return nil, fmt.Errorf("%w: %w", ErrFindDuplicates, err)
+	}
+	return candidates, nil
+}
+
+// Merge merges a competency into another one
+// Business logic flow:
+// 1. Check that the user is an admin and the competencies differ
+// 2. Lock the relations, so concurrent prerequisites can't close a cycle with the moved ones
+// 3. Move the references of the merged competency and delete it
+// 4. Refuse the merge when a prerequisite of the surviving competency now requires it
+// 5. Record the merge as a revision of the surviving competency
+func (uc *mergeUseCase) Merge(ctx context.Context, id, mergedID int32) (*domain.Competency, error) {
+	// Step 1: Authorize and check
+	if err := domain.RequireAdmin(ctx); err != nil {
+		uc.logger.InfoContext(ctx, "competency merge not allowed", "reason", err)
+		return nil, err
+	}
+	if id == mergedID {
+		uc.logger.InfoContext(ctx, "competency merged into itself", "id", id)
+		return nil, domain.ErrInvalidMerge
+	}
+
+	change := revisionChange(ctx, domain.RevisionMerge)
+	change.MergedID = &mergedID
+
+	var competency *domain.Competency
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		// Step 2: Lock the relations
+		if err := uc.relationRepo.Lock(ctx); err != nil {
+			return err
+		}
+
+		// Step 3: Merge
+		if err := uc.mergeRepo.Merge(ctx, domain.CompetencyMerge{CompetencyID: id, MergedID: mergedID, MergedBy: change.EditorID}); err != nil {
+			return err
+		}
+
+		// Step 4: Check for a cycle (the graph was acyclic, so a new one goes through the surviving competency)
+		edges, err := uc.relationRepo.GetRequiresEdges(ctx, []int32{id})
+		if err != nil {
+			return err
+		}
+		for _, edge := range edges {
+			cycle, err := uc.relationRepo.IsPrerequisite(ctx, edge.To, id)
+			if err != nil {
+				return err
+			}
+			if cycle {
+				return domain.ErrRelationCycle
+			}
+		}
+
+		// Step 5: Record the merge
+		if err := uc.revisionRepo.Record(ctx, change, id); err != nil {
+			return err
+		}
+		competency, err = uc.competencyRepo.GetByID(ctx, id)
+		return err
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrCompetencyNotFound):
+			uc.logger.InfoContext(ctx, "competency not found for merge", "id", id, "merged_id", mergedID)
+			return nil, domain.ErrCompetencyNotFound
+		case errors.Is(err, domain.ErrRelationCycle):
+			uc.logger.InfoContext(ctx, "competency merge would create a prerequisite cycle", "id", id, "merged_id", mergedID)
+			return nil, domain.ErrRelationCycle
+		}
+		uc.logger.ErrorContext(ctx, "failed to merge competencies", "error", err, "id", id, "merged_id", mergedID)
+		return nil, fmt.Errorf("%w: %w", ErrMergeCompetencies, err)
+	}
+
+	uc.logger.InfoContext(ctx, "competencies merged successfully", "competency_id", id, "merged_id", mergedID)
+	return competency, nil
+}
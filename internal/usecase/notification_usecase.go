@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+)
+
+// notificationUseCase implements domain.NotificationUseCase
+type notificationUseCase struct {
+	notificationRepo domain.NotificationRepository
+	logger           *slog.Logger
+}
+
+// NewNotificationUseCase creates a new notification use case instance
+func NewNotificationUseCase(notificationRepo domain.NotificationRepository, logger *slog.Logger) (domain.NotificationUseCase, error) {
+	// Nil-check the injected dependencies
+	if notificationRepo == nil {
+		return nil, ErrNotificationRepositoryNil
+	}
+	if logger == nil {
+		return nil, ErrLoggerNil
+	}
+	return &notificationUseCase{
+		notificationRepo: notificationRepo,
+		logger:           logger,
+	}, nil
+}
+
+// GetMine retrieves the latest notifications of the user
+func (uc *notificationUseCase) GetMine(ctx context.Context, unreadOnly bool, limit int32) ([]*domain.Notification, error) {
+	user, ok := domain.ActorFromContext(ctx)
+	if !ok {
+		return nil, domain.ErrAuthenticationRequired
+	}
+
+	limit = clampLimit(limit, domain.DefaultNotificationPageSize, domain.MaxNotificationPageSize)
+	notifications, err := uc.notificationRepo.GetByUser(ctx, user.ID, unreadOnly, limit)
+	if err != nil {
+		uc.logger.ErrorContext(ctx, "failed to get notifications", "error", err, "user_id", user.ID)
+		return nil, fmt.Errorf("%w: %w", ErrGetNotifications, err)
+	}
+	return notifications, nil
+}
+
+// MarkRead marks a notification of the user read
+func (uc *notificationUseCase) MarkRead(ctx context.Context, id int32) (*domain.Notification, error) {
+	user, ok := domain.ActorFromContext(ctx)
+	if !ok {
+		return nil, domain.ErrAuthenticationRequired
+	}
+
+	notification, err := uc.notificationRepo.MarkRead(ctx, id, user.ID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotificationNotFound) {
+			return nil, err
+		}
+		uc.logger.ErrorContext(ctx, "failed to mark notification read", "error", err, "id", id)
+		return nil, fmt.Errorf("%w: %w", ErrUpdateNotification, err)
+	}
+	return notification, nil
+}
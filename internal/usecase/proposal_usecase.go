@@ -0,0 +1,481 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+)
+
+// approvalErrors are the errors of an approval that are returned as they are, the others are unexpected
+var approvalErrors = []error{
+	domain.ErrProposalNotFound,
+	domain.ErrProposalStatusConflict,
+	domain.ErrInvalidCompetencyName,
+	domain.ErrCompetencyAlreadyExists,
+	domain.ErrCompetencyNotFound,
+	domain.ErrCategoryNotFound,
+}
+
+// proposalUseCase implements domain.ProposalUseCase
+type proposalUseCase struct {
+	proposalRepo      domain.ProposalRepository
+	competencyUseCase domain.CompetencyUseCase
+	notificationRepo  domain.NotificationRepository
+	transactor        domain.Transactor
+	logger            *slog.Logger
+}
+
+// NewProposalUseCase creates a new proposal use case instance
+// Approved changes go through competencyUseCase, so they are validated and recorded like direct edits
+func NewProposalUseCase(
+	proposalRepo domain.ProposalRepository,
+	competencyUseCase domain.CompetencyUseCase,
+	notificationRepo domain.NotificationRepository,
+	transactor domain.Transactor,
+	logger *slog.Logger,
+) (domain.ProposalUseCase, error) {
+	// Nil-check the injected dependencies
+	if proposalRepo == nil {
+		return nil, ErrProposalRepositoryNil
+	}
+	if competencyUseCase == nil {
+		return nil, ErrCompetencyUseCaseNil
+	}
+	if notificationRepo == nil {
+		return nil, ErrNotificationRepositoryNil
+	}
+	if transactor == nil {
+		return nil, ErrTransactorNil
+	}
+	if logger == nil {
+		return nil, ErrLoggerNil
+	}
+	return &proposalUseCase{
+		proposalRepo:      proposalRepo,
+		competencyUseCase: competencyUseCase,
+		notificationRepo:  notificationRepo,
+		transactor:        transactor,
+		logger:            logger,
+	}, nil
+}
+
+// Create creates a draft proposal of the user
+// Business logic flow:
+// 1. Check that the user is authenticated
+// 2. Normalize and validate the change
+// 3. Create the draft in repository
+func (uc *proposalUseCase) Create(ctx context.Context, change domain.ProposalChange) (*domain.CompetencyProposal, error) {
+	// Step 1: Authenticate
+	user, ok := domain.ActorFromContext(ctx)
+	if !ok {
+		return nil, domain.ErrAuthenticationRequired
+	}
+
+	// Step 2: Validate
+	change, err := normalizeProposalChange(change)
+	if err != nil {
+		uc.logger.InfoContext(ctx, "invalid proposal", "error", err)
+		return nil, err
+	}
+	kind := domain.ProposalCreate
+	if change.CompetencyID != nil {
+		kind = domain.ProposalEdit
+	}
+
+	// Step 3: Create
+	proposal, err := uc.proposalRepo.Create(ctx, kind, user.ID, change)
+	if err != nil {
+		if errors.Is(err, domain.ErrCompetencyNotFound) || errors.Is(err, domain.ErrCategoryNotFound) {
+			uc.logger.InfoContext(ctx, "proposal references a missing row", "error", err)
+			return nil, err
+		}
+		uc.logger.ErrorContext(ctx, "failed to create proposal", "error", err)
+		return nil, fmt.Errorf("%w: %w", ErrCreateProposal, err)
+	}
+
+	uc.logger.InfoContext(ctx, "proposal created successfully", "proposal_id", proposal.ID, "kind", kind)
+	return proposal, nil
+}
+
+// GetByID retrieves a proposal the user can see
+func (uc *proposalUseCase) GetByID(ctx context.Context, id int32) (*domain.CompetencyProposal, error) {
+	user, ok := domain.ActorFromContext(ctx)
+	if !ok {
+		return nil, domain.ErrAuthenticationRequired
+	}
+	return uc.visibleProposal(ctx, user, id, false)
+}
+
+// GetMine retrieves the proposals of the user
+func (uc *proposalUseCase) GetMine(ctx context.Context, status domain.ProposalStatus) ([]*domain.CompetencyProposal, error) {
+	user, ok := domain.ActorFromContext(ctx)
+	if !ok {
+		return nil, domain.ErrAuthenticationRequired
+	}
+	if status != "" && !status.Valid() {
+		uc.logger.InfoContext(ctx, "invalid proposal status", "status", status)
+		return nil, domain.ErrInvalidProposal
+	}
+
+	proposals, err := uc.proposalRepo.GetByProposer(ctx, user.ID, status)
+	if err != nil {
+		uc.logger.ErrorContext(ctx, "failed to get proposals", "error", err, "proposer_id", user.ID)
+		return nil, fmt.Errorf("%w: %w", ErrGetProposal, err)
+	}
+	return proposals, nil
+}
+
+// GetQueue retrieves the submitted proposals for review
+func (uc *proposalUseCase) GetQueue(ctx context.Context, limit int32) ([]*domain.CompetencyProposal, error) {
+	if err := domain.RequireAdmin(ctx); err != nil {
+		uc.logger.InfoContext(ctx, "proposal queue not allowed", "reason", err)
+		return nil, err
+	}
+
+	limit = clampLimit(limit, domain.DefaultProposalQueueSize, domain.MaxProposalQueueSize)
+	proposals, err := uc.proposalRepo.GetQueue(ctx, limit)
+	if err != nil {
+		uc.logger.ErrorContext(ctx, "failed to get proposal queue", "error", err)
+		return nil, fmt.Errorf("%w: %w", ErrGetProposal, err)
+	}
+	return proposals, nil
+}
+
+// Update replaces the change of a draft of the user
+// Business logic flow:
+// 1. Check that the proposal is one of the user
+// 2. Normalize and validate the change, keeping the competency of the proposal
+// 3. Update the draft in repository (a proposal that isn't a draft anymore is a conflict)
+func (uc *proposalUseCase) Update(ctx context.Context, id int32, change domain.ProposalChange) (*domain.CompetencyProposal, error) {
+	// Step 1: Check the proposer
+	proposal, err := uc.ownProposal(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Step 2: Validate
+	change.CompetencyID = proposal.Change.CompetencyID
+	change, err = normalizeProposalChange(change)
+	if err != nil {
+		uc.logger.InfoContext(ctx, "invalid proposal", "error", err, "proposal_id", id)
+		return nil, err
+	}
+
+	// Step 3: Update
+	proposal, err = uc.proposalRepo.UpdateDraft(ctx, id, change)
+	if err != nil {
+		return nil, uc.transitionError(ctx, err, id, "update")
+	}
+
+	uc.logger.InfoContext(ctx, "proposal updated successfully", "proposal_id", id)
+	return proposal, nil
+}
+
+// Submit sends a draft of the user to the review queue
+func (uc *proposalUseCase) Submit(ctx context.Context, id int32) (*domain.CompetencyProposal, error) {
+	if _, err := uc.ownProposal(ctx, id); err != nil {
+		return nil, err
+	}
+
+	var proposal *domain.CompetencyProposal
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		if proposal, err = uc.proposalRepo.Submit(ctx, id); err != nil {
+			return err
+		}
+		if proposal.Kind != domain.ProposalEdit {
+			return nil
+		}
+		// The stewards of the edited competency are told there is something to review
+		_, err = uc.notificationRepo.NotifyStewards(ctx, domain.StewardNotice{
+			Kind:         domain.NotificationProposalSubmitted,
+			CompetencyID: *proposal.Change.CompetencyID,
+			ProposalID:   &proposal.ID,
+			SenderID:     &proposal.ProposerID,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, uc.transitionError(ctx, err, id, "submit")
+	}
+
+	uc.logger.InfoContext(ctx, "proposal submitted successfully", "proposal_id", id)
+	return proposal, nil
+}
+
+// Withdraw deletes a proposal of the user that isn't reviewed yet
+func (uc *proposalUseCase) Withdraw(ctx context.Context, id int32) error {
+	if _, err := uc.ownProposal(ctx, id); err != nil {
+		return err
+	}
+
+	if err := uc.proposalRepo.Delete(ctx, id); err != nil {
+		return uc.transitionError(ctx, err, id, "withdraw")
+	}
+
+	uc.logger.InfoContext(ctx, "proposal withdrawn successfully", "proposal_id", id)
+	return nil
+}
+
+// Comment adds a comment of the user to a proposal they can see
+func (uc *proposalUseCase) Comment(ctx context.Context, id int32, body string) (*domain.ProposalComment, error) {
+	user, ok := domain.ActorFromContext(ctx)
+	if !ok {
+		return nil, domain.ErrAuthenticationRequired
+	}
+	body, err := normalizeProposalComment(body, true)
+	if err != nil {
+		uc.logger.InfoContext(ctx, "invalid proposal comment", "proposal_id", id)
+		return nil, err
+	}
+	if _, err := uc.visibleProposal(ctx, user, id, false); err != nil {
+		return nil, err
+	}
+
+	comment, err := uc.proposalRepo.AddComment(ctx, id, &user.ID, body)
+	if err != nil {
+		if errors.Is(err, domain.ErrProposalNotFound) {
+			return nil, err
+		}
+		uc.logger.ErrorContext(ctx, "failed to comment proposal", "error", err, "proposal_id", id)
+		return nil, fmt.Errorf("%w: %w", ErrUpdateProposal, err)
+	}
+
+	uc.logger.InfoContext(ctx, "proposal commented successfully", "proposal_id", id, "comment_id", comment.ID)
+	return comment, nil
+}
+
+// Approve applies a submitted proposal and marks it approved
+// Business logic flow:
+// 1. Check that the user is an admin and the comment is valid
+// 2. Lock the proposal and check that it is submitted
+// 3. Apply the change through CompetencyUseCase, credited to the proposer
+// 4. Mark the proposal approved, with the competency it created, and add the comment
+func (uc *proposalUseCase) Approve(ctx context.Context, id int32, comment string) (*domain.CompetencyProposal, error) {
+	// Step 1: Authorize and check
+	if err := domain.RequireAdmin(ctx); err != nil {
+		uc.logger.InfoContext(ctx, "proposal approval not allowed", "reason", err)
+		return nil, err
+	}
+	comment, err := normalizeProposalComment(comment, false)
+	if err != nil {
+		uc.logger.InfoContext(ctx, "invalid proposal comment", "proposal_id", id)
+		return nil, err
+	}
+
+	var proposal *domain.CompetencyProposal
+	err = uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		// Step 2: Lock
+		submitted, err := uc.submittedProposal(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		// Step 3: Apply
+		competencyID, err := uc.apply(domain.ContextWithAuthor(ctx, submitted.ProposerID), submitted.Change)
+		if err != nil {
+			return err
+		}
+
+		// Step 4: Review
+		proposal, err = uc.review(ctx, id, domain.ProposalApproved, &competencyID, comment)
+		return err
+	})
+	if err != nil {
+		for _, target := range approvalErrors {
+			if errors.Is(err, target) {
+				uc.logger.InfoContext(ctx, "proposal not approved", "reason", err, "proposal_id", id)
+				return nil, target
+			}
+		}
+		uc.logger.ErrorContext(ctx, "failed to approve proposal", "error", err, "proposal_id", id)
+		return nil, fmt.Errorf("%w: %w", ErrReviewProposal, err)
+	}
+
+	uc.logger.InfoContext(ctx, "proposal approved successfully", "proposal_id", id, "competency_id", *proposal.Change.CompetencyID)
+	return proposal, nil
+}
+
+// Reject marks a submitted proposal rejected, with the reason in a comment
+func (uc *proposalUseCase) Reject(ctx context.Context, id int32, comment string) (*domain.CompetencyProposal, error) {
+	if err := domain.RequireAdmin(ctx); err != nil {
+		uc.logger.InfoContext(ctx, "proposal rejection not allowed", "reason", err)
+		return nil, err
+	}
+	comment, err := normalizeProposalComment(comment, true)
+	if err != nil {
+		uc.logger.InfoContext(ctx, "proposal rejected without a reason", "proposal_id", id)
+		return nil, err
+	}
+
+	var proposal *domain.CompetencyProposal
+	err = uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if _, err := uc.submittedProposal(ctx, id); err != nil {
+			return err
+		}
+		proposal, err = uc.review(ctx, id, domain.ProposalRejected, nil, comment)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrProposalNotFound) || errors.Is(err, domain.ErrProposalStatusConflict) {
+			uc.logger.InfoContext(ctx, "proposal not rejected", "reason", err, "proposal_id", id)
+			return nil, err
+		}
+		uc.logger.ErrorContext(ctx, "failed to reject proposal", "error", err, "proposal_id", id)
+		return nil, fmt.Errorf("%w: %w", ErrReviewProposal, err)
+	}
+
+	uc.logger.InfoContext(ctx, "proposal rejected successfully", "proposal_id", id)
+	return proposal, nil
+}
+
+// apply makes the change of a proposal through CompetencyUseCase and returns the competency it changed
+// Edits skip the version check: the reviewer approves the change against the current state
+func (uc *proposalUseCase) apply(ctx context.Context, change domain.ProposalChange) (int32, error) {
+	var competency *domain.Competency
+	var err error
+	if change.CompetencyID == nil {
+		description := ""
+		if change.Description != nil {
+			description = *change.Description
+		}
+		if competency, err = uc.competencyUseCase.Create(ctx, *change.Name, description); err != nil {
+			return 0, err
+		}
+	} else {
+		competency = &domain.Competency{ID: *change.CompetencyID}
+		if change.Name != nil {
+			if competency, err = uc.competencyUseCase.Rename(ctx, competency.ID, *change.Name, domain.AnyVersion); err != nil {
+				return 0, err
+			}
+		}
+		if change.Description != nil {
+			if competency, err = uc.competencyUseCase.UpdateDescription(ctx, competency.ID, *change.Description, domain.AnyVersion); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if change.CategoryID != nil {
+		if competency, err = uc.competencyUseCase.SetCategory(ctx, competency.ID, change.CategoryID); err != nil {
+			return 0, err
+		}
+	}
+	return competency.ID, nil
+}
+
+// review marks a locked, submitted proposal reviewed by the user and adds the non-empty comment
+func (uc *proposalUseCase) review(ctx context.Context, id int32, status domain.ProposalStatus, competencyID *int32, comment string) (*domain.CompetencyProposal, error) {
+	reviewer, _ := domain.ActorFromContext(ctx)
+	proposal, err := uc.proposalRepo.Review(ctx, id, status, &reviewer.ID, competencyID)
+	if err != nil {
+		return nil, err
+	}
+	if comment != "" {
+		added, err := uc.proposalRepo.AddComment(ctx, id, &reviewer.ID, comment)
+		if err != nil {
+			return nil, err
+		}
+		proposal.Comments = append(proposal.Comments, added)
+	}
+	return proposal, nil
+}
+
+// submittedProposal locks a proposal for its review, returning ErrProposalStatusConflict unless it is submitted
+func (uc *proposalUseCase) submittedProposal(ctx context.Context, id int32) (*domain.CompetencyProposal, error) {
+	proposal, err := uc.proposalRepo.GetByID(ctx, id, true)
+	if err != nil {
+		return nil, err
+	}
+	if proposal.Status != domain.ProposalSubmitted {
+		return nil, domain.ErrProposalStatusConflict
+	}
+	return proposal, nil
+}
+
+// ownProposal retrieves a proposal of the user, returning ErrProposalNotFound for the others
+func (uc *proposalUseCase) ownProposal(ctx context.Context, id int32) (*domain.CompetencyProposal, error) {
+	user, ok := domain.ActorFromContext(ctx)
+	if !ok {
+		return nil, domain.ErrAuthenticationRequired
+	}
+	return uc.visibleProposal(ctx, user, id, true)
+}
+
+// visibleProposal retrieves a proposal user can see: their own, or one submitted to the admins
+// With own set, only their own proposals are returned. The others are ErrProposalNotFound, so their IDs don't leak
+func (uc *proposalUseCase) visibleProposal(ctx context.Context, user *domain.User, id int32, own bool) (*domain.CompetencyProposal, error) {
+	proposal, err := uc.proposalRepo.GetByID(ctx, id, false)
+	if err != nil {
+		if errors.Is(err, domain.ErrProposalNotFound) {
+			return nil, err
+		}
+		uc.logger.ErrorContext(ctx, "failed to get proposal", "error", err, "id", id)
+		return nil, fmt.Errorf("%w: %w", ErrGetProposal, err)
+	}
+
+	switch {
+	case proposal.ProposerID == user.ID:
+		return proposal, nil
+	case !own && user.IsAdmin() && proposal.Status != domain.ProposalDraft:
+		return proposal, nil
+	}
+	uc.logger.InfoContext(ctx, "proposal not visible to the user", "proposal_id", id, "user_id", user.ID)
+	return nil, domain.ErrProposalNotFound
+}
+
+// transitionError returns the expected errors of a proposal transition as they are and wraps the others
+func (uc *proposalUseCase) transitionError(ctx context.Context, err error, id int32, operation string) error {
+	for _, target := range []error{domain.ErrProposalNotFound, domain.ErrProposalStatusConflict, domain.ErrCategoryNotFound} {
+		if errors.Is(err, target) {
+			uc.logger.InfoContext(ctx, "proposal not changed", "reason", err, "proposal_id", id, "operation", operation)
+			return target
+		}
+	}
+	uc.logger.ErrorContext(ctx, "failed to change proposal", "error", err, "proposal_id", id, "operation", operation)
+	return fmt.Errorf("%w: %w", ErrUpdateProposal, err)
+}
+
+// normalizeProposalChange trims and validates a change
+// A creation needs a name, an edit at least one changed field; names are validated like competency names
+func normalizeProposalChange(change domain.ProposalChange) (domain.ProposalChange, error) {
+	trim := func(s *string) *string {
+		if s == nil {
+			return nil
+		}
+		trimmed := strings.TrimSpace(*s)
+		return &trimmed
+	}
+	change.Name = trim(change.Name)
+	change.Description = trim(change.Description)
+	change.Rationale = strings.TrimSpace(change.Rationale)
+
+	if change.Name != nil {
+		if n := utf8.RuneCountInString(*change.Name); n < 2 || n > 100 {
+			return change, domain.ErrInvalidProposal
+		}
+	}
+	if utf8.RuneCountInString(change.Rationale) > domain.MaxProposalRationaleLength {
+		return change, domain.ErrInvalidProposal
+	}
+	if change.CompetencyID == nil && change.Name == nil {
+		return change, domain.ErrInvalidProposal
+	}
+	if change.Name == nil && change.Description == nil && change.CategoryID == nil {
+		return change, domain.ErrInvalidProposal
+	}
+	return change, nil
+}
+
+// normalizeProposalComment trims and validates a comment, which may be empty unless required is set
+func normalizeProposalComment(body string, required bool) (string, error) {
+	body = strings.TrimSpace(body)
+	if (required && body == "") || utf8.RuneCountInString(body) > domain.MaxProposalCommentLength {
+		return "", domain.ErrInvalidProposalComment
+	}
+	return body, nil
+}
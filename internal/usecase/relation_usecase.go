@@ -0,0 +1,256 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+)
+
+// relationUseCase implements domain.RelationUseCase
+type relationUseCase struct {
+	relationRepo   domain.RelationRepository
+	competencyRepo domain.CompetencyRepository
+	stewardRepo    domain.StewardRepository
+	transactor     domain.Transactor
+	logger         *slog.Logger
+}
+
+// NewRelationUseCase creates a new relation use case instance
+func NewRelationUseCase(
+	relationRepo domain.RelationRepository,
+	competencyRepo domain.CompetencyRepository,
+	stewardRepo domain.StewardRepository,
+	transactor domain.Transactor,
+	logger *slog.Logger,
+) (domain.RelationUseCase, error) {
+	// Nil-check the injected dependencies
+	if relationRepo == nil {
+		return nil, ErrRelationRepositoryNil
+	}
+	if competencyRepo == nil {
+		return nil, ErrCompetencyRepositoryNil
+	}
+	if stewardRepo == nil {
+		return nil, ErrStewardRepositoryNil
+	}
+	if transactor == nil {
+		return nil, ErrTransactorNil
+	}
+	if logger == nil {
+		return nil, ErrLoggerNil
+	}
+	return &relationUseCase{
+		relationRepo:   relationRepo,
+		competencyRepo: competencyRepo,
+		stewardRepo:    stewardRepo,
+		transactor:     transactor,
+		logger:         logger,
+	}, nil
+}
+
+// GetRelations retrieves the competencies directly linked to a competency
+func (uc *relationUseCase) GetRelations(ctx context.Context, competencyID int32) (*domain.CompetencyRelations, error) {
+	var relations *domain.CompetencyRelations
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if _, err := uc.competencyRepo.GetByID(ctx, competencyID); err != nil {
+			return err
+		}
+		var err error
+		relations, err = uc.relationRepo.GetRelations(ctx, competencyID)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrCompetencyNotFound) {
+			return nil, domain.ErrCompetencyNotFound
+		}
+		uc.logger.ErrorContext(ctx, "failed to get competency relations", "error", err, "competency_id", competencyID)
+		return nil, fmt.Errorf("%w: %w", ErrGetRelations, err)
+	}
+	return relations, nil
+}
+
+// Relate adds an edge between two competencies
+// Business logic flow:
+// 1. Check that the user is an admin or a steward of the competency
+// 2. Check the edge
+// 3. Lock the relations, so concurrent prerequisites can't close a cycle together
+// 4. Refuse a prerequisite that already requires the competency, directly or not
+// 5. Add the edge
+func (uc *relationUseCase) Relate(ctx context.Context, competencyID, otherID int32, kind domain.RelationKind) (*domain.CompetencyRelations, error) {
+	// Step 1: Authorize
+	if err := requireEditor(ctx, uc.stewardRepo, competencyID); err != nil {
+		uc.logger.InfoContext(ctx, "competency relation not allowed", "reason", err, "competency_id", competencyID)
+		return nil, err
+	}
+
+	// Step 2: Check the edge
+	if competencyID == otherID || !kind.Valid() {
+		uc.logger.InfoContext(ctx, "invalid competency relation", "competency_id", competencyID, "other_id", otherID, "kind", kind)
+		return nil, domain.ErrInvalidRelation
+	}
+
+	var relations *domain.CompetencyRelations
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		// Step 3: Lock the relations
+		if err := uc.relationRepo.Lock(ctx); err != nil {
+			return err
+		}
+
+		// Step 4: Check for a cycle
+		if kind == domain.RelationRequires {
+			cycle, err := uc.relationRepo.IsPrerequisite(ctx, otherID, competencyID)
+			if err != nil {
+				return err
+			}
+			if cycle {
+				return domain.ErrRelationCycle
+			}
+		}
+
+		// Step 5: Add the edge
+		if err := uc.relationRepo.Add(ctx, domain.NewCompetencyEdge(competencyID, otherID, kind)); err != nil {
+			return err
+		}
+		var err error
+		relations, err = uc.relationRepo.GetRelations(ctx, competencyID)
+		return err
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrCompetencyNotFound):
+			return nil, domain.ErrCompetencyNotFound
+		case errors.Is(err, domain.ErrRelationCycle):
+			uc.logger.InfoContext(ctx, "prerequisite would create a cycle", "competency_id", competencyID, "other_id", otherID)
+			return nil, domain.ErrRelationCycle
+		}
+		uc.logger.ErrorContext(ctx, "failed to relate competencies", "error", err, "competency_id", competencyID, "other_id", otherID)
+		return nil, fmt.Errorf("%w: %w", ErrUpdateRelations, err)
+	}
+
+	uc.logger.InfoContext(ctx, "competencies related successfully", "competency_id", competencyID, "other_id", otherID, "kind", kind)
+	return relations, nil
+}
+
+// Unrelate removes an edge between two competencies; admins and stewards of the competency only
+func (uc *relationUseCase) Unrelate(ctx context.Context, competencyID, otherID int32, kind domain.RelationKind) error {
+	if err := requireEditor(ctx, uc.stewardRepo, competencyID); err != nil {
+		uc.logger.InfoContext(ctx, "competency relation removal not allowed", "reason", err, "competency_id", competencyID)
+		return err
+	}
+
+	if competencyID == otherID || !kind.Valid() {
+		uc.logger.InfoContext(ctx, "invalid competency relation", "competency_id", competencyID, "other_id", otherID, "kind", kind)
+		return domain.ErrInvalidRelation
+	}
+
+	if err := uc.relationRepo.Remove(ctx, domain.NewCompetencyEdge(competencyID, otherID, kind)); err != nil {
+		if errors.Is(err, domain.ErrRelationNotFound) {
+			return domain.ErrRelationNotFound
+		}
+		uc.logger.ErrorContext(ctx, "failed to unrelate competencies", "error", err, "competency_id", competencyID, "other_id", otherID)
+		return fmt.Errorf("%w: %w", ErrUpdateRelations, err)
+	}
+
+	uc.logger.InfoContext(ctx, "competencies unrelated successfully", "competency_id", competencyID, "other_id", otherID, "kind", kind)
+	return nil
+}
+
+// GetPrerequisites retrieves the competencies a competency requires, nearest first
+func (uc *relationUseCase) GetPrerequisites(ctx context.Context, competencyID int32) ([]*domain.CompetencyNode, error) {
+	var prerequisites []*domain.CompetencyNode
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if _, err := uc.competencyRepo.GetByID(ctx, competencyID); err != nil {
+			return err
+		}
+		var err error
+		prerequisites, err = uc.relationRepo.GetPrerequisites(ctx, []int32{competencyID})
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrCompetencyNotFound) {
+			return nil, domain.ErrCompetencyNotFound
+		}
+		uc.logger.ErrorContext(ctx, "failed to get prerequisites", "error", err, "competency_id", competencyID)
+		return nil, fmt.Errorf("%w: %w", ErrGetRelations, err)
+	}
+	return prerequisites, nil
+}
+
+// GetLearningOrder orders the targets and their prerequisites so prerequisites come first
+// Business logic flow:
+// 1. Check the targets, dropping duplicates
+// 2. Collect the targets and everything they require, with the edges between them
+// 3. Sort them topologically (see domain.SortLearningOrder)
+func (uc *relationUseCase) GetLearningOrder(ctx context.Context, targetIDs []int32) ([]*domain.LearningStep, error) {
+	// Step 1: Check the targets
+	targetIDs = slices.Compact(slices.Sorted(slices.Values(targetIDs)))
+	if len(targetIDs) == 0 || len(targetIDs) > domain.MaxLearningTargets {
+		uc.logger.InfoContext(ctx, "invalid learning targets", "targets", len(targetIDs))
+		return nil, domain.ErrInvalidLearningTargets
+	}
+
+	var nodes []*domain.CompetencyNode
+	var edges []domain.CompetencyEdge
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		// Step 2: Collect the competencies
+		targets, err := uc.relationRepo.GetNodes(ctx, targetIDs)
+		if err != nil {
+			return err
+		}
+		if len(targets) < len(targetIDs) {
+			return domain.ErrCompetencyNotFound
+		}
+		prerequisites, err := uc.relationRepo.GetPrerequisites(ctx, targetIDs)
+		if err != nil {
+			return err
+		}
+		nodes = targets
+		ids := slices.Clone(targetIDs)
+		for _, prerequisite := range prerequisites {
+			if !slices.Contains(targetIDs, prerequisite.ID) {
+				nodes = append(nodes, prerequisite)
+				ids = append(ids, prerequisite.ID)
+			}
+		}
+		edges, err = uc.relationRepo.GetRequiresEdges(ctx, ids)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrCompetencyNotFound) {
+			uc.logger.InfoContext(ctx, "learning target not found", "targets", targetIDs)
+			return nil, domain.ErrCompetencyNotFound
+		}
+		uc.logger.ErrorContext(ctx, "failed to get learning order", "error", err)
+		return nil, fmt.Errorf("%w: %w", ErrGetRelations, err)
+	}
+
+	// Step 3: Sort
+	order := domain.SortLearningOrder(nodes, edges)
+	steps := make([]*domain.LearningStep, len(order))
+	for i, node := range order {
+		_, target := slices.BinarySearch(targetIDs, node.ID)
+		steps[i] = &domain.LearningStep{Competency: node, Target: target}
+	}
+	return steps, nil
+}
+
+// GetGraph retrieves the prerequisite graph of the competencies that aren't archived
+func (uc *relationUseCase) GetGraph(ctx context.Context) (*domain.CompetencyGraph, error) {
+	var graph *domain.CompetencyGraph
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		graph, err = uc.relationRepo.GetGraph(ctx)
+		return err
+	})
+	if err != nil {
+		uc.logger.ErrorContext(ctx, "failed to get competency graph", "error", err)
+		return nil, fmt.Errorf("%w: %w", ErrGetRelations, err)
+	}
+
+	uc.logger.InfoContext(ctx, "competency graph retrieved successfully", "nodes", len(graph.Nodes), "edges", len(graph.Edges))
+	return graph, nil
+}
@@ -0,0 +1,134 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"slices"
+	"testing"
+
+	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+)
+
+// memoryRelationRepository keeps the edges of the prerequisite graph in memory
+type memoryRelationRepository struct {
+	domain.RelationRepository
+	edges []domain.CompetencyEdge
+}
+
+func (r *memoryRelationRepository) Lock(ctx context.Context) error {
+	return nil
+}
+
+func (r *memoryRelationRepository) Add(ctx context.Context, edge domain.CompetencyEdge) error {
+	if !slices.Contains(r.edges, edge) {
+		r.edges = append(r.edges, edge)
+	}
+	return nil
+}
+
+// IsPrerequisite walks the "requires" edges from competencyID
+func (r *memoryRelationRepository) IsPrerequisite(ctx context.Context, competencyID, candidateID int32) (bool, error) {
+	seen := map[int32]bool{competencyID: true}
+	queue := []int32{competencyID}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		if id == candidateID {
+			return true, nil
+		}
+		for _, edge := range r.edges {
+			if edge.Kind == domain.RelationRequires && edge.From == id && !seen[edge.To] {
+				seen[edge.To] = true
+				queue = append(queue, edge.To)
+			}
+		}
+	}
+	return false, nil
+}
+
+func (r *memoryRelationRepository) GetRelations(ctx context.Context, competencyID int32) (*domain.CompetencyRelations, error) {
+	return &domain.CompetencyRelations{}, nil
+}
+
+// immediateTransactor runs transactions as plain calls
+type immediateTransactor struct{}
+
+func (immediateTransactor) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// Repositories the tested paths don't call
+type (
+	stubCompetencyRepository struct{ domain.CompetencyRepository }
+	stubStewardRepository    struct{ domain.StewardRepository }
+)
+
+// TestRelateRefusesCycles checks that prerequisites never make a competency require itself, directly or not
+func TestRelateRefusesCycles(t *testing.T) {
+	requires := func(from, to int32) domain.CompetencyEdge {
+		return domain.CompetencyEdge{From: from, To: to, Kind: domain.RelationRequires}
+	}
+	related := func(from, to int32) domain.CompetencyEdge {
+		return domain.CompetencyEdge{From: from, To: to, Kind: domain.RelationRelated}
+	}
+
+	tests := []struct {
+		name    string
+		edges   []domain.CompetencyEdge
+		add     domain.CompetencyEdge
+		wantErr error
+	}{
+		{name: "first prerequisite", add: requires(1, 2)},
+		{name: "existing prerequisite", edges: []domain.CompetencyEdge{requires(1, 2)}, add: requires(1, 2)},
+		{name: "self", add: requires(1, 1), wantErr: domain.ErrInvalidRelation},
+		{name: "direct cycle", edges: []domain.CompetencyEdge{requires(1, 2)}, add: requires(2, 1), wantErr: domain.ErrRelationCycle},
+		{
+			name:    "transitive cycle",
+			edges:   []domain.CompetencyEdge{requires(1, 2), requires(2, 3), requires(3, 4)},
+			add:     requires(4, 1),
+			wantErr: domain.ErrRelationCycle,
+		},
+		{
+			name:  "shortcut over a chain",
+			edges: []domain.CompetencyEdge{requires(1, 2), requires(2, 3)},
+			add:   requires(1, 3),
+		},
+		{
+			name:  "diamond",
+			edges: []domain.CompetencyEdge{requires(1, 2), requires(1, 3), requires(2, 4)},
+			add:   requires(3, 4),
+		},
+		{
+			name:  "related edges don't count",
+			edges: []domain.CompetencyEdge{related(1, 2)},
+			add:   requires(2, 1),
+		},
+		{
+			name:  "related pair of a prerequisite",
+			edges: []domain.CompetencyEdge{requires(1, 2)},
+			add:   related(2, 1),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &memoryRelationRepository{edges: slices.Clone(tt.edges)}
+			uc, err := NewRelationUseCase(repo, stubCompetencyRepository{}, stubStewardRepository{}, immediateTransactor{},
+				slog.New(slog.NewTextHandler(io.Discard, nil)))
+			if err != nil {
+				t.Fatalf("NewRelationUseCase: %v", err)
+			}
+			ctx := domain.ContextWithActor(context.Background(), &domain.User{ID: 1, Role: domain.UserRoleADMIN})
+
+			_, err = uc.Relate(ctx, tt.add.From, tt.add.To, tt.add.Kind)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Relate() error = %v, want %v", err, tt.wantErr)
+			}
+			added := slices.Contains(repo.edges, domain.NewCompetencyEdge(tt.add.From, tt.add.To, tt.add.Kind))
+			if added != (tt.wantErr == nil) {
+				t.Errorf("edge added = %v, want %v", added, tt.wantErr == nil)
+			}
+		})
+	}
+}
@@ -0,0 +1,472 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+)
+
+// rubricUseCase implements domain.RubricUseCase
+type rubricUseCase struct {
+	rubricRepo      domain.RubricRepository
+	competencyRepo  domain.CompetencyRepository
+	translationRepo domain.TranslationRepository
+	stewardRepo     domain.StewardRepository
+	transactor      domain.Transactor
+	logger          *slog.Logger
+}
+
+// NewRubricUseCase creates a new rubric use case instance
+func NewRubricUseCase(
+	rubricRepo domain.RubricRepository,
+	competencyRepo domain.CompetencyRepository,
+	translationRepo domain.TranslationRepository,
+	stewardRepo domain.StewardRepository,
+	transactor domain.Transactor,
+	logger *slog.Logger,
+) (domain.RubricUseCase, error) {
+	// Nil-check the injected dependencies
+	if rubricRepo == nil {
+		return nil, ErrRubricRepositoryNil
+	}
+	if competencyRepo == nil {
+		return nil, ErrCompetencyRepositoryNil
+	}
+	if translationRepo == nil {
+		return nil, ErrTranslationRepositoryNil
+	}
+	if stewardRepo == nil {
+		return nil, ErrStewardRepositoryNil
+	}
+	if transactor == nil {
+		return nil, ErrTransactorNil
+	}
+	if logger == nil {
+		return nil, ErrLoggerNil
+	}
+	return &rubricUseCase{
+		rubricRepo:      rubricRepo,
+		competencyRepo:  competencyRepo,
+		translationRepo: translationRepo,
+		stewardRepo:     stewardRepo,
+		transactor:      transactor,
+		logger:          logger,
+	}, nil
+}
+
+// CreateScale creates a rating scale with its levels in one transaction; admins only
+func (uc *rubricUseCase) CreateScale(ctx context.Context, spec domain.RatingScaleSpec) (*domain.RatingScale, error) {
+	if err := domain.RequireAdmin(ctx); err != nil {
+		uc.logger.InfoContext(ctx, "rating scale creation not allowed", "reason", err)
+		return nil, err
+	}
+
+	spec, err := normalizeScaleSpec(spec)
+	if err != nil {
+		uc.logger.InfoContext(ctx, "invalid rating scale", "error", err)
+		return nil, err
+	}
+
+	var scale *domain.RatingScale
+	err = uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		created, err := uc.rubricRepo.CreateScale(ctx, spec.Name, spec.Description)
+		if err != nil {
+			return err
+		}
+		if err := uc.rubricRepo.SetScaleLevels(ctx, created.ID, spec.Levels); err != nil {
+			return err
+		}
+		scale, err = uc.rubricRepo.GetScale(ctx, created.ID)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrRatingScaleAlreadyExists) {
+			uc.logger.InfoContext(ctx, "rating scale already exists", "name", spec.Name)
+			return nil, domain.ErrRatingScaleAlreadyExists
+		}
+		uc.logger.ErrorContext(ctx, "failed to create rating scale", "error", err)
+		return nil, fmt.Errorf("%w: %w", ErrCreateRatingScale, err)
+	}
+
+	uc.logger.InfoContext(ctx, "rating scale created successfully", "scale_id", scale.ID, "levels", len(scale.Levels))
+	return scale, nil
+}
+
+// GetScale retrieves a rating scale with its levels
+func (uc *rubricUseCase) GetScale(ctx context.Context, id int32) (*domain.RatingScale, error) {
+	scale, err := uc.rubricRepo.GetScale(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrRatingScaleNotFound) {
+			return nil, domain.ErrRatingScaleNotFound
+		}
+		uc.logger.ErrorContext(ctx, "failed to get rating scale", "error", err, "id", id)
+		return nil, fmt.Errorf("%w: %w", ErrGetRatingScale, err)
+	}
+	return scale, nil
+}
+
+// GetScales retrieves every rating scale
+func (uc *rubricUseCase) GetScales(ctx context.Context) ([]*domain.RatingScale, error) {
+	scales, err := uc.rubricRepo.GetScales(ctx)
+	if err != nil {
+		uc.logger.ErrorContext(ctx, "failed to get rating scales", "error", err)
+		return nil, fmt.Errorf("%w: %w", ErrGetRatingScale, err)
+	}
+	return scales, nil
+}
+
+// UpdateScale replaces a rating scale
+// Business logic flow:
+// 1. Check that the user is an admin
+// 2. Normalize and validate the scale like CreateScale does
+// 3. Update it in one transaction; removing a level a competency describes fails
+// 4. Bump the version of the competencies using the scale, since their embedded rubric changed
+func (uc *rubricUseCase) UpdateScale(ctx context.Context, id int32, spec domain.RatingScaleSpec) (*domain.RatingScale, error) {
+	// Step 1: Authorize
+	if err := domain.RequireAdmin(ctx); err != nil {
+		uc.logger.InfoContext(ctx, "rating scale update not allowed", "reason", err, "id", id)
+		return nil, err
+	}
+
+	// Step 2: Validate
+	spec, err := normalizeScaleSpec(spec)
+	if err != nil {
+		uc.logger.InfoContext(ctx, "invalid rating scale", "error", err, "id", id)
+		return nil, err
+	}
+
+	// Step 3 and 4: Update
+	var scale *domain.RatingScale
+	err = uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if _, err := uc.rubricRepo.UpdateScale(ctx, id, spec.Name, spec.Description); err != nil {
+			return err
+		}
+		if err := uc.rubricRepo.SetScaleLevels(ctx, id, spec.Levels); err != nil {
+			return err
+		}
+		if err := uc.rubricRepo.TouchScaleCompetencies(ctx, id); err != nil {
+			return err
+		}
+		var err error
+		scale, err = uc.rubricRepo.GetScale(ctx, id)
+		return err
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrRatingScaleNotFound),
+			errors.Is(err, domain.ErrRatingScaleAlreadyExists),
+			errors.Is(err, domain.ErrRatingScaleInUse):
+			uc.logger.InfoContext(ctx, "rating scale update refused", "reason", err, "id", id)
+			return nil, err
+		}
+		uc.logger.ErrorContext(ctx, "failed to update rating scale", "error", err, "id", id)
+		return nil, fmt.Errorf("%w: %w", ErrUpdateRatingScale, err)
+	}
+
+	uc.logger.InfoContext(ctx, "rating scale updated successfully", "scale_id", scale.ID)
+	return scale, nil
+}
+
+// DeleteScale deletes a rating scale
+// Rubrics reference their scale with a foreign key, so a scale in use can't be deleted; admins only
+func (uc *rubricUseCase) DeleteScale(ctx context.Context, id int32) error {
+	if err := domain.RequireAdmin(ctx); err != nil {
+		uc.logger.InfoContext(ctx, "rating scale delete not allowed", "reason", err, "id", id)
+		return err
+	}
+	if err := uc.rubricRepo.DeleteScale(ctx, id); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrRatingScaleNotFound), errors.Is(err, domain.ErrRatingScaleInUse):
+			uc.logger.InfoContext(ctx, "rating scale delete refused", "reason", err, "id", id)
+			return err
+		}
+		uc.logger.ErrorContext(ctx, "failed to delete rating scale", "error", err, "id", id)
+		return fmt.Errorf("%w: %w", ErrDeleteRatingScale, err)
+	}
+
+	uc.logger.InfoContext(ctx, "rating scale deleted successfully", "scale_id", id)
+	return nil
+}
+
+// GetRubric retrieves the rubric of a competency, in the locale of ctx
+func (uc *rubricUseCase) GetRubric(ctx context.Context, competencyID int32) (*domain.CompetencyRubric, error) {
+	var rubric *domain.CompetencyRubric
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if _, err := uc.competencyRepo.GetByID(ctx, competencyID); err != nil {
+			return err
+		}
+		var err error
+		if rubric, err = uc.rubricRepo.GetRubric(ctx, competencyID); err != nil {
+			return err
+		}
+
+		// Level texts are read in the locale of ctx
+		if locale := domain.LocaleFromContext(ctx); locale != domain.FallbackLocale {
+			translations, err := uc.translationRepo.GetByLocale(ctx, locale, []int32{competencyID})
+			if err != nil {
+				return err
+			}
+			rubric.Localize(translations[competencyID])
+		}
+		return nil
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrCompetencyNotFound), errors.Is(err, domain.ErrRubricNotFound):
+			uc.logger.InfoContext(ctx, "rubric not found", "reason", err, "competency_id", competencyID)
+			return nil, err
+		}
+		uc.logger.ErrorContext(ctx, "failed to get rubric", "error", err, "competency_id", competencyID)
+		return nil, fmt.Errorf("%w: %w", ErrGetRubric, err)
+	}
+	return rubric, nil
+}
+
+// SetRubric replaces the rubric of a competency
+// Business logic flow:
+// 1. Check that the user is an admin or a steward of the competency
+// 2. Normalize and validate the level descriptions
+// 3. Check the competency and the scale exist, and that every level belongs to the scale
+// 4. Replace the scale and the descriptions, and bump the version of the competency
+func (uc *rubricUseCase) SetRubric(ctx context.Context, competencyID, scaleID int32, levels []domain.CompetencyLevel) (*domain.CompetencyRubric, error) {
+	// Step 1: Authorize
+	if err := requireEditor(ctx, uc.stewardRepo, competencyID); err != nil {
+		uc.logger.InfoContext(ctx, "rubric update not allowed", "reason", err, "competency_id", competencyID)
+		return nil, err
+	}
+
+	// Step 2: Validate levels
+	normalized := make([]domain.CompetencyLevel, len(levels))
+	seen := make(map[int32]bool, len(levels))
+	for i, level := range levels {
+		level, err := normalizeCompetencyLevel(level)
+		if err != nil {
+			uc.logger.InfoContext(ctx, "invalid competency level", "error", err, "competency_id", competencyID)
+			return nil, err
+		}
+		if seen[level.Value] {
+			uc.logger.InfoContext(ctx, "competency level given twice", "competency_id", competencyID, "level", level.Value)
+			return nil, fmt.Errorf("%w: level %d is given more than once", domain.ErrInvalidCompetencyLevel, level.Value)
+		}
+		seen[level.Value] = true
+		normalized[i] = level
+	}
+
+	var rubric *domain.CompetencyRubric
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		// Step 3: Check references
+		if _, err := uc.competencyRepo.GetByID(ctx, competencyID); err != nil {
+			return err
+		}
+		scale, err := uc.rubricRepo.GetScale(ctx, scaleID)
+		if err != nil {
+			return err
+		}
+		for _, level := range normalized {
+			if _, ok := scale.Level(level.Value); !ok {
+				return fmt.Errorf("%w: %d", domain.ErrUnknownRatingLevel, level.Value)
+			}
+		}
+
+		// Step 4: Replace
+		if err := uc.rubricRepo.DeleteRubricLevels(ctx, competencyID); err != nil {
+			return err
+		}
+		if err := uc.rubricRepo.SetRubricScale(ctx, competencyID, scaleID); err != nil {
+			return err
+		}
+		for _, level := range normalized {
+			if !level.IsDescribed() {
+				continue
+			}
+			if err := uc.rubricRepo.SetRubricLevel(ctx, competencyID, scaleID, level); err != nil {
+				return err
+			}
+		}
+		if err := uc.rubricRepo.TouchCompetency(ctx, competencyID); err != nil {
+			return err
+		}
+		rubric, err = uc.rubricRepo.GetRubric(ctx, competencyID)
+		return err
+	})
+	if err != nil {
+		return nil, uc.rubricUpdateError(ctx, err, competencyID)
+	}
+
+	uc.logger.InfoContext(ctx, "rubric set successfully", "competency_id", competencyID, "scale_id", scaleID)
+	return rubric, nil
+}
+
+// SetLevel creates or replaces the description of one level of a rubric
+func (uc *rubricUseCase) SetLevel(ctx context.Context, competencyID int32, level domain.CompetencyLevel) (*domain.CompetencyRubric, error) {
+	if err := requireEditor(ctx, uc.stewardRepo, competencyID); err != nil {
+		uc.logger.InfoContext(ctx, "competency level update not allowed", "reason", err, "competency_id", competencyID)
+		return nil, err
+	}
+
+	level, err := normalizeCompetencyLevel(level)
+	if err != nil {
+		uc.logger.InfoContext(ctx, "invalid competency level", "error", err, "competency_id", competencyID)
+		return nil, err
+	}
+
+	var rubric *domain.CompetencyRubric
+	err = uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if _, err := uc.competencyRepo.GetByID(ctx, competencyID); err != nil {
+			return err
+		}
+		current, err := uc.rubricRepo.GetRubric(ctx, competencyID)
+		if err != nil {
+			return err
+		}
+		if _, ok := current.Scale.Level(level.Value); !ok {
+			return fmt.Errorf("%w: %d", domain.ErrUnknownRatingLevel, level.Value)
+		}
+		if err := uc.rubricRepo.SetRubricLevel(ctx, competencyID, current.Scale.ID, level); err != nil {
+			return err
+		}
+		if err := uc.rubricRepo.TouchCompetency(ctx, competencyID); err != nil {
+			return err
+		}
+		rubric, err = uc.rubricRepo.GetRubric(ctx, competencyID)
+		return err
+	})
+	if err != nil {
+		return nil, uc.rubricUpdateError(ctx, err, competencyID)
+	}
+
+	uc.logger.InfoContext(ctx, "competency level set successfully", "competency_id", competencyID, "level", level.Value)
+	return rubric, nil
+}
+
+// DeleteLevel deletes the description of one level of a rubric
+func (uc *rubricUseCase) DeleteLevel(ctx context.Context, competencyID, value int32) error {
+	if err := requireEditor(ctx, uc.stewardRepo, competencyID); err != nil {
+		uc.logger.InfoContext(ctx, "competency level deletion not allowed", "reason", err, "competency_id", competencyID)
+		return err
+	}
+
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if _, err := uc.competencyRepo.GetByID(ctx, competencyID); err != nil {
+			return err
+		}
+		if _, err := uc.rubricRepo.GetRubric(ctx, competencyID); err != nil {
+			return err
+		}
+		if err := uc.rubricRepo.DeleteRubricLevel(ctx, competencyID, value); err != nil {
+			return err
+		}
+		return uc.rubricRepo.TouchCompetency(ctx, competencyID)
+	})
+	if err != nil {
+		return uc.rubricUpdateError(ctx, err, competencyID)
+	}
+
+	uc.logger.InfoContext(ctx, "competency level deleted successfully", "competency_id", competencyID, "level", value)
+	return nil
+}
+
+// DeleteRubric deletes the rubric of a competency
+func (uc *rubricUseCase) DeleteRubric(ctx context.Context, competencyID int32) error {
+	if err := requireEditor(ctx, uc.stewardRepo, competencyID); err != nil {
+		uc.logger.InfoContext(ctx, "rubric deletion not allowed", "reason", err, "competency_id", competencyID)
+		return err
+	}
+
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if _, err := uc.competencyRepo.GetByID(ctx, competencyID); err != nil {
+			return err
+		}
+		if err := uc.rubricRepo.DeleteRubric(ctx, competencyID); err != nil {
+			return err
+		}
+		return uc.rubricRepo.TouchCompetency(ctx, competencyID)
+	})
+	if err != nil {
+		return uc.rubricUpdateError(ctx, err, competencyID)
+	}
+
+	uc.logger.InfoContext(ctx, "rubric deleted successfully", "competency_id", competencyID)
+	return nil
+}
+
+// rubricUpdateError logs a failed rubric change and returns its domain error, or wraps unexpected errors
+func (uc *rubricUseCase) rubricUpdateError(ctx context.Context, err error, competencyID int32) error {
+	switch {
+	case errors.Is(err, domain.ErrCompetencyNotFound),
+		errors.Is(err, domain.ErrRatingScaleNotFound),
+		errors.Is(err, domain.ErrRubricNotFound),
+		errors.Is(err, domain.ErrUnknownRatingLevel),
+		errors.Is(err, domain.ErrCompetencyLevelNotFound):
+		uc.logger.InfoContext(ctx, "rubric change refused", "reason", err, "competency_id", competencyID)
+		return err
+	}
+	uc.logger.ErrorContext(ctx, "failed to change rubric", "error", err, "competency_id", competencyID)
+	return fmt.Errorf("%w: %w", ErrUpdateRubric, err)
+}
+
+// normalizeScaleSpec trims the fields of a rating scale, checks them, and orders its levels by value
+func normalizeScaleSpec(spec domain.RatingScaleSpec) (domain.RatingScaleSpec, error) {
+	spec.Name = strings.TrimSpace(spec.Name)
+	spec.Description = strings.TrimSpace(spec.Description)
+	if len(spec.Name) < 2 || len(spec.Name) > 100 {
+		return spec, fmt.Errorf("%w: name must be 2-100 characters", domain.ErrInvalidRatingScale)
+	}
+	if len(spec.Description) > domain.MaxRatingScaleDescriptionLength {
+		return spec, fmt.Errorf("%w: description is too long", domain.ErrInvalidRatingScale)
+	}
+	if len(spec.Levels) == 0 || len(spec.Levels) > domain.MaxRatingScaleLevels {
+		return spec, fmt.Errorf("%w: a scale has 1 to %d levels", domain.ErrInvalidRatingScale, domain.MaxRatingScaleLevels)
+	}
+
+	levels := make([]domain.RatingLevel, len(spec.Levels))
+	for i, level := range spec.Levels {
+		level.Label = strings.TrimSpace(level.Label)
+		if level.Value <= 0 {
+			return spec, fmt.Errorf("%w: level values must be positive", domain.ErrInvalidRatingScale)
+		}
+		if level.Label == "" || len(level.Label) > domain.MaxRatingLevelLabelLength {
+			return spec, fmt.Errorf("%w: level labels must be 1-%d characters", domain.ErrInvalidRatingScale, domain.MaxRatingLevelLabelLength)
+		}
+		levels[i] = level
+	}
+	slices.SortFunc(levels, func(a, b domain.RatingLevel) int { return int(a.Value) - int(b.Value) })
+	for i := 1; i < len(levels); i++ {
+		if levels[i].Value == levels[i-1].Value {
+			return spec, fmt.Errorf("%w: level %d is given more than once", domain.ErrInvalidRatingScale, levels[i].Value)
+		}
+	}
+	spec.Levels = levels
+	return spec, nil
+}
+
+// normalizeCompetencyLevel trims the description and indicators of a level and checks them
+func normalizeCompetencyLevel(level domain.CompetencyLevel) (domain.CompetencyLevel, error) {
+	level.Label = ""
+	level.Description = strings.TrimSpace(level.Description)
+	if level.Value <= 0 {
+		return level, fmt.Errorf("%w: level values are positive", domain.ErrInvalidCompetencyLevel)
+	}
+	if len(level.Description) > domain.MaxLevelDescriptionLength {
+		return level, fmt.Errorf("%w: description is too long", domain.ErrInvalidCompetencyLevel)
+	}
+	if len(level.Indicators) > domain.MaxLevelIndicators {
+		return level, fmt.Errorf("%w: at most %d indicators", domain.ErrInvalidCompetencyLevel, domain.MaxLevelIndicators)
+	}
+
+	indicators := make([]string, len(level.Indicators))
+	for i, indicator := range level.Indicators {
+		indicator = strings.TrimSpace(indicator)
+		if indicator == "" || len(indicator) > domain.MaxLevelIndicatorLength {
+			return level, fmt.Errorf("%w: indicators must be 1-%d characters", domain.ErrInvalidCompetencyLevel, domain.MaxLevelIndicatorLength)
+		}
+		indicators[i] = indicator
+	}
+	level.Indicators = indicators
+	return level, nil
+}
@@ -0,0 +1,233 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+)
+
+// stewardUseCase implements domain.StewardUseCase
+type stewardUseCase struct {
+	stewardRepo      domain.StewardRepository
+	competencyRepo   domain.CompetencyRepository
+	notificationRepo domain.NotificationRepository
+	translationRepo  domain.TranslationRepository
+	transactor       domain.Transactor
+	logger           *slog.Logger
+}
+
+// NewStewardUseCase creates a new steward use case instance
+func NewStewardUseCase(
+	stewardRepo domain.StewardRepository,
+	competencyRepo domain.CompetencyRepository,
+	notificationRepo domain.NotificationRepository,
+	translationRepo domain.TranslationRepository,
+	transactor domain.Transactor,
+	logger *slog.Logger,
+) (domain.StewardUseCase, error) {
+	// Nil-check the injected dependencies
+	if stewardRepo == nil {
+		return nil, ErrStewardRepositoryNil
+	}
+	if competencyRepo == nil {
+		return nil, ErrCompetencyRepositoryNil
+	}
+	if notificationRepo == nil {
+		return nil, ErrNotificationRepositoryNil
+	}
+	if translationRepo == nil {
+		return nil, ErrTranslationRepositoryNil
+	}
+	if transactor == nil {
+		return nil, ErrTransactorNil
+	}
+	if logger == nil {
+		return nil, ErrLoggerNil
+	}
+	return &stewardUseCase{
+		stewardRepo:      stewardRepo,
+		competencyRepo:   competencyRepo,
+		notificationRepo: notificationRepo,
+		translationRepo:  translationRepo,
+		transactor:       transactor,
+		logger:           logger,
+	}, nil
+}
+
+// Assign makes a user a steward of a competency
+func (uc *stewardUseCase) Assign(ctx context.Context, competencyID, userID int32) ([]*domain.CompetencySteward, error) {
+	if err := domain.RequireAdmin(ctx); err != nil {
+		uc.logger.InfoContext(ctx, "steward assignment not allowed", "reason", err, "competency_id", competencyID)
+		return nil, err
+	}
+
+	var stewards []*domain.CompetencySteward
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		admin, _ := domain.ActorFromContext(ctx)
+		if err := uc.stewardRepo.Assign(ctx, competencyID, userID, &admin.ID); err != nil {
+			return err
+		}
+		var err error
+		stewards, err = uc.stewardRepo.GetByCompetency(ctx, competencyID)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrCompetencyNotFound) || errors.Is(err, domain.ErrUserNotFound) {
+			uc.logger.InfoContext(ctx, "steward not assigned", "reason", err, "competency_id", competencyID, "user_id", userID)
+			return nil, err
+		}
+		uc.logger.ErrorContext(ctx, "failed to assign steward", "error", err, "competency_id", competencyID, "user_id", userID)
+		return nil, fmt.Errorf("%w: %w", ErrUpdateStewards, err)
+	}
+
+	uc.logger.InfoContext(ctx, "steward assigned successfully", "competency_id", competencyID, "user_id", userID)
+	return stewards, nil
+}
+
+// Unassign removes a steward of a competency
+func (uc *stewardUseCase) Unassign(ctx context.Context, competencyID, userID int32) error {
+	if err := domain.RequireAdmin(ctx); err != nil {
+		uc.logger.InfoContext(ctx, "steward unassignment not allowed", "reason", err, "competency_id", competencyID)
+		return err
+	}
+
+	if err := uc.stewardRepo.Unassign(ctx, competencyID, userID); err != nil {
+		if errors.Is(err, domain.ErrStewardNotFound) {
+			return err
+		}
+		uc.logger.ErrorContext(ctx, "failed to unassign steward", "error", err, "competency_id", competencyID, "user_id", userID)
+		return fmt.Errorf("%w: %w", ErrUpdateStewards, err)
+	}
+
+	uc.logger.InfoContext(ctx, "steward unassigned successfully", "competency_id", competencyID, "user_id", userID)
+	return nil
+}
+
+// GetStewards retrieves the stewards of a competency
+func (uc *stewardUseCase) GetStewards(ctx context.Context, competencyID int32) ([]*domain.CompetencySteward, error) {
+	var stewards []*domain.CompetencySteward
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if _, err := uc.competencyRepo.GetByID(ctx, competencyID); err != nil {
+			return err
+		}
+		var err error
+		stewards, err = uc.stewardRepo.GetByCompetency(ctx, competencyID)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrCompetencyNotFound) {
+			uc.logger.InfoContext(ctx, "competency not found for stewards", "competency_id", competencyID)
+			return nil, domain.ErrCompetencyNotFound
+		}
+		uc.logger.ErrorContext(ctx, "failed to get stewards", "error", err, "competency_id", competencyID)
+		return nil, fmt.Errorf("%w: %w", ErrGetStewards, err)
+	}
+	return stewards, nil
+}
+
+// GetStewarded retrieves the competencies the user is a steward of
+func (uc *stewardUseCase) GetStewarded(ctx context.Context) ([]*domain.Competency, error) {
+	user, ok := domain.ActorFromContext(ctx)
+	if !ok {
+		return nil, domain.ErrAuthenticationRequired
+	}
+
+	competencies, err := uc.stewardRepo.GetStewarded(ctx, user.ID)
+	if err == nil {
+		err = localize(ctx, uc.translationRepo, competencies...)
+	}
+	if err != nil {
+		uc.logger.ErrorContext(ctx, "failed to get stewarded competencies", "error", err, "user_id", user.ID)
+		return nil, fmt.Errorf("%w: %w", ErrGetStewards, err)
+	}
+	return competencies, nil
+}
+
+// Report records that a competency is outdated and notifies its stewards
+// Business logic flow:
+// 1. Check that the user is authenticated and the reason is valid
+// 2. Record the report
+// 3. Notify the stewards of the competency, in the same transaction
+func (uc *stewardUseCase) Report(ctx context.Context, competencyID int32, reason string) (*domain.CompetencyReport, error) {
+	// Step 1: Authenticate and validate
+	user, ok := domain.ActorFromContext(ctx)
+	if !ok {
+		return nil, domain.ErrAuthenticationRequired
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" || utf8.RuneCountInString(reason) > domain.MaxReportReasonLength {
+		uc.logger.InfoContext(ctx, "invalid competency report", "competency_id", competencyID)
+		return nil, domain.ErrInvalidReport
+	}
+
+	var report *domain.CompetencyReport
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		// Step 2: Record
+		var err error
+		if report, err = uc.stewardRepo.CreateReport(ctx, competencyID, &user.ID, reason); err != nil {
+			return err
+		}
+
+		// Step 3: Notify
+		_, err = uc.notificationRepo.NotifyStewards(ctx, domain.StewardNotice{
+			Kind:         domain.NotificationStaleReport,
+			CompetencyID: competencyID,
+			ReportID:     &report.ID,
+			SenderID:     &user.ID,
+		})
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrCompetencyNotFound) {
+			uc.logger.InfoContext(ctx, "competency not found for report", "competency_id", competencyID)
+			return nil, domain.ErrCompetencyNotFound
+		}
+		uc.logger.ErrorContext(ctx, "failed to report competency", "error", err, "competency_id", competencyID)
+		return nil, fmt.Errorf("%w: %w", ErrReportCompetency, err)
+	}
+
+	uc.logger.InfoContext(ctx, "competency reported successfully", "report_id", report.ID, "competency_id", competencyID)
+	return report, nil
+}
+
+// GetReports retrieves the latest reports of a competency
+func (uc *stewardUseCase) GetReports(ctx context.Context, competencyID, limit int32) ([]*domain.CompetencyReport, error) {
+	if err := requireEditor(ctx, uc.stewardRepo, competencyID); err != nil {
+		uc.logger.InfoContext(ctx, "competency reports read not allowed", "reason", err, "competency_id", competencyID)
+		return nil, err
+	}
+
+	limit = clampLimit(limit, domain.DefaultReportPageSize, domain.MaxReportPageSize)
+	reports, err := uc.stewardRepo.GetReports(ctx, competencyID, limit)
+	if err != nil {
+		uc.logger.ErrorContext(ctx, "failed to get competency reports", "error", err, "competency_id", competencyID)
+		return nil, fmt.Errorf("%w: %w", ErrGetReports, err)
+	}
+	return reports, nil
+}
+
+// requireEditor checks that the user performing the operation of ctx can edit a competency:
+// an admin, or one of its stewards
+// Returns ErrAuthenticationRequired for anonymous requests and ErrForbidden for other users
+func requireEditor(ctx context.Context, stewardRepo domain.StewardRepository, competencyID int32) error {
+	user, ok := domain.ActorFromContext(ctx)
+	if !ok {
+		return domain.ErrAuthenticationRequired
+	}
+	if user.IsAdmin() {
+		return nil
+	}
+	steward, err := stewardRepo.IsSteward(ctx, competencyID, user.ID)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrCheckSteward, err)
+	}
+	if !steward {
+		return domain.ErrForbidden
+	}
+	return nil
+}
@@ -0,0 +1,345 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+)
+
+// tagUseCase implements domain.TagUseCase
+type tagUseCase struct {
+	tagRepo        domain.TagRepository
+	competencyRepo domain.CompetencyRepository
+	stewardRepo    domain.StewardRepository
+	transactor     domain.Transactor
+	logger         *slog.Logger
+}
+
+// NewTagUseCase creates a new tag use case instance
+func NewTagUseCase(
+	tagRepo domain.TagRepository,
+	competencyRepo domain.CompetencyRepository,
+	stewardRepo domain.StewardRepository,
+	transactor domain.Transactor,
+	logger *slog.Logger,
+) (domain.TagUseCase, error) {
+	// Nil-check the injected dependencies
+	if tagRepo == nil {
+		return nil, ErrTagRepositoryNil
+	}
+	if competencyRepo == nil {
+		return nil, ErrCompetencyRepositoryNil
+	}
+	if stewardRepo == nil {
+		return nil, ErrStewardRepositoryNil
+	}
+	if transactor == nil {
+		return nil, ErrTransactorNil
+	}
+	if logger == nil {
+		return nil, ErrLoggerNil
+	}
+	return &tagUseCase{
+		tagRepo:        tagRepo,
+		competencyRepo: competencyRepo,
+		stewardRepo:    stewardRepo,
+		transactor:     transactor,
+		logger:         logger,
+	}, nil
+}
+
+// Create creates a tag
+// Business logic flow:
+// 1. Check that the user is an admin
+// 2. Normalize and validate the name
+// 3. Create the tag
+func (uc *tagUseCase) Create(ctx context.Context, name string, curated bool) (*domain.Tag, error) {
+	// Step 1: Authorize
+	if err := domain.RequireAdmin(ctx); err != nil {
+		uc.logger.InfoContext(ctx, "tag creation not allowed", "reason", err)
+		return nil, err
+	}
+
+	// Step 2: Validate
+	name, err := normalizeTagName(name)
+	if err != nil {
+		uc.logger.InfoContext(ctx, "invalid tag name", "error", err)
+		return nil, err
+	}
+
+	// Step 3: Create
+	tag, err := uc.tagRepo.Create(ctx, name, curated)
+	if err != nil {
+		if errors.Is(err, domain.ErrTagAlreadyExists) {
+			uc.logger.InfoContext(ctx, "tag already exists", "name", name)
+			return nil, domain.ErrTagAlreadyExists
+		}
+		uc.logger.ErrorContext(ctx, "failed to create tag", "error", err)
+		return nil, fmt.Errorf("%w: %w", ErrCreateTag, err)
+	}
+
+	uc.logger.InfoContext(ctx, "tag created successfully", "tag_id", tag.ID, "curated", tag.Curated)
+	return tag, nil
+}
+
+// GetByID retrieves a tag with its usage count
+func (uc *tagUseCase) GetByID(ctx context.Context, id int32) (*domain.Tag, error) {
+	tag, err := uc.tagRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrTagNotFound) {
+			return nil, domain.ErrTagNotFound
+		}
+		uc.logger.ErrorContext(ctx, "failed to get tag", "error", err, "id", id)
+		return nil, fmt.Errorf("%w: %w", ErrGetTag, err)
+	}
+	return tag, nil
+}
+
+// GetAll retrieves the tags matching filter, most used first
+func (uc *tagUseCase) GetAll(ctx context.Context, filter domain.TagFilter) ([]*domain.Tag, error) {
+	filter.NamePrefix = strings.TrimSpace(filter.NamePrefix)
+	filter.Limit = clampLimit(filter.Limit, domain.DefaultTagPageSize, domain.MaxTagPageSize)
+
+	tags, err := uc.tagRepo.GetAll(ctx, filter)
+	if err != nil {
+		uc.logger.ErrorContext(ctx, "failed to get tags", "error", err)
+		return nil, fmt.Errorf("%w: %w", ErrGetTag, err)
+	}
+	return tags, nil
+}
+
+// Update renames a tag and/or sets whether it is curated
+func (uc *tagUseCase) Update(ctx context.Context, id int32, name *string, curated *bool) (*domain.Tag, error) {
+	if err := domain.RequireAdmin(ctx); err != nil {
+		uc.logger.InfoContext(ctx, "tag update not allowed", "reason", err, "id", id)
+		return nil, err
+	}
+
+	var newName string
+	if name != nil {
+		var err error
+		if newName, err = normalizeTagName(*name); err != nil {
+			uc.logger.InfoContext(ctx, "invalid tag name", "error", err, "id", id)
+			return nil, err
+		}
+	}
+
+	var tag *domain.Tag
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		current, err := uc.tagRepo.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if name == nil {
+			newName = current.Name
+		}
+		newCurated := current.Curated
+		if curated != nil {
+			newCurated = *curated
+		}
+		if _, err := uc.tagRepo.Update(ctx, id, newName, newCurated); err != nil {
+			return err
+		}
+		tag, err = uc.tagRepo.GetByID(ctx, id)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrTagNotFound) || errors.Is(err, domain.ErrTagAlreadyExists) {
+			uc.logger.InfoContext(ctx, "tag update refused", "reason", err, "id", id)
+			return nil, err
+		}
+		uc.logger.ErrorContext(ctx, "failed to update tag", "error", err, "id", id)
+		return nil, fmt.Errorf("%w: %w", ErrUpdateTag, err)
+	}
+
+	uc.logger.InfoContext(ctx, "tag updated successfully", "tag_id", id)
+	return tag, nil
+}
+
+// Delete deletes a tag and removes it from its competencies
+func (uc *tagUseCase) Delete(ctx context.Context, id int32) error {
+	if err := domain.RequireAdmin(ctx); err != nil {
+		uc.logger.InfoContext(ctx, "tag deletion not allowed", "reason", err, "id", id)
+		return err
+	}
+
+	if err := uc.tagRepo.Delete(ctx, id); err != nil {
+		if errors.Is(err, domain.ErrTagNotFound) {
+			return domain.ErrTagNotFound
+		}
+		uc.logger.ErrorContext(ctx, "failed to delete tag", "error", err, "id", id)
+		return fmt.Errorf("%w: %w", ErrDeleteTag, err)
+	}
+
+	uc.logger.InfoContext(ctx, "tag deleted successfully", "tag_id", id)
+	return nil
+}
+
+// GetCompetencyTags retrieves the tags of a competency
+func (uc *tagUseCase) GetCompetencyTags(ctx context.Context, competencyID int32) ([]*domain.Tag, error) {
+	var tags []*domain.Tag
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if _, err := uc.competencyRepo.GetByID(ctx, competencyID); err != nil {
+			return err
+		}
+		var err error
+		tags, err = uc.tagRepo.GetByCompetency(ctx, competencyID)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrCompetencyNotFound) {
+			return nil, domain.ErrCompetencyNotFound
+		}
+		uc.logger.ErrorContext(ctx, "failed to get competency tags", "error", err, "competency_id", competencyID)
+		return nil, fmt.Errorf("%w: %w", ErrGetTag, err)
+	}
+	return tags, nil
+}
+
+// TagCompetency adds tags to a competency by name
+// Business logic flow:
+// 1. Check that the user is authenticated
+// 2. Normalize and validate the names, dropping duplicates
+// 3. Check that the competency exists
+// 4. Create the unknown tags as free-form ones; curated tags need an admin or a steward of the competency
+// 5. Link them all to the competency
+func (uc *tagUseCase) TagCompetency(ctx context.Context, competencyID int32, names []string) ([]*domain.Tag, error) {
+	// Step 1: Authenticate
+	if _, ok := domain.ActorFromContext(ctx); !ok {
+		return nil, domain.ErrAuthenticationRequired
+	}
+
+	// Step 2: Validate
+	names, err := normalizeTagNames(names)
+	if err != nil {
+		uc.logger.InfoContext(ctx, "invalid tag names", "error", err, "competency_id", competencyID)
+		return nil, err
+	}
+	if len(names) == 0 {
+		uc.logger.InfoContext(ctx, "no tags given", "competency_id", competencyID)
+		return nil, fmt.Errorf("%w: at least one tag is required", domain.ErrInvalidTagName)
+	}
+
+	var tags []*domain.Tag
+	err = uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		// Step 3: Check the competency
+		if _, err := uc.competencyRepo.GetByID(ctx, competencyID); err != nil {
+			return err
+		}
+
+		// Step 4: Get the tags, only editors of the competency attach curated ones
+		ensured, err := uc.tagRepo.Ensure(ctx, names)
+		if err != nil {
+			return err
+		}
+		if slices.ContainsFunc(ensured, func(tag *domain.Tag) bool { return tag.Curated }) {
+			if err := requireEditor(ctx, uc.stewardRepo, competencyID); err != nil {
+				return err
+			}
+		}
+
+		// Step 5: Tag
+		tagIDs := make([]int32, len(ensured))
+		for i, tag := range ensured {
+			tagIDs[i] = tag.ID
+		}
+		if err := uc.tagRepo.AddToCompetency(ctx, competencyID, tagIDs); err != nil {
+			return err
+		}
+		tags, err = uc.tagRepo.GetByCompetency(ctx, competencyID)
+		return err
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrCompetencyNotFound):
+			uc.logger.InfoContext(ctx, "competency not found", "competency_id", competencyID)
+			return nil, domain.ErrCompetencyNotFound
+		case errors.Is(err, domain.ErrForbidden):
+			uc.logger.InfoContext(ctx, "curated tagging not allowed", "reason", err, "competency_id", competencyID)
+			return nil, err
+		}
+		uc.logger.ErrorContext(ctx, "failed to tag competency", "error", err, "competency_id", competencyID)
+		return nil, fmt.Errorf("%w: %w", ErrUpdateCompetencyTags, err)
+	}
+
+	uc.logger.InfoContext(ctx, "competency tagged successfully", "competency_id", competencyID, "tags", names)
+	return tags, nil
+}
+
+// UntagCompetency removes a tag from a competency; the user must be authenticated, and an admin or a steward of
+// the competency to remove a curated tag
+func (uc *tagUseCase) UntagCompetency(ctx context.Context, competencyID, tagID int32) error {
+	if _, ok := domain.ActorFromContext(ctx); !ok {
+		return domain.ErrAuthenticationRequired
+	}
+
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if _, err := uc.competencyRepo.GetByID(ctx, competencyID); err != nil {
+			return err
+		}
+		tag, err := uc.tagRepo.GetByID(ctx, tagID)
+		if err != nil {
+			return err
+		}
+		if tag.Curated {
+			if err := requireEditor(ctx, uc.stewardRepo, competencyID); err != nil {
+				return err
+			}
+		}
+		return uc.tagRepo.RemoveFromCompetency(ctx, competencyID, tagID)
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrCompetencyNotFound) || errors.Is(err, domain.ErrTagNotFound) || errors.Is(err, domain.ErrForbidden) {
+			uc.logger.InfoContext(ctx, "competency untag refused", "reason", err, "competency_id", competencyID, "tag_id", tagID)
+			return err
+		}
+		uc.logger.ErrorContext(ctx, "failed to untag competency", "error", err, "competency_id", competencyID, "tag_id", tagID)
+		return fmt.Errorf("%w: %w", ErrUpdateCompetencyTags, err)
+	}
+
+	uc.logger.InfoContext(ctx, "competency untagged successfully", "competency_id", competencyID, "tag_id", tagID)
+	return nil
+}
+
+// normalizeTagName trims a tag name, collapses its inner spaces and checks it
+// Names are letters, digits, spaces and the punctuation of names like "c++", "c#", "node.js" or "soft-skill"
+func normalizeTagName(name string) (string, error) {
+	name = strings.Join(strings.Fields(name), " ")
+	if name == "" || utf8.RuneCountInString(name) > domain.MaxTagNameLength {
+		return "", fmt.Errorf("%w: must be 1-%d characters", domain.ErrInvalidTagName, domain.MaxTagNameLength)
+	}
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && !strings.ContainsRune(" -_.+#", r) {
+			return "", fmt.Errorf("%w: %q has unsupported characters", domain.ErrInvalidTagName, name)
+		}
+	}
+	return name, nil
+}
+
+// normalizeTagNames normalizes tag names, dropping case-insensitive duplicates
+func normalizeTagNames(names []string) ([]string, error) {
+	if len(names) > domain.MaxTagsPerRequest {
+		return nil, fmt.Errorf("%w: at most %d tags", domain.ErrInvalidTagName, domain.MaxTagsPerRequest)
+	}
+
+	normalized := make([]string, 0, len(names))
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		name, err := normalizeTagName(name)
+		if err != nil {
+			return nil, err
+		}
+		if key := strings.ToLower(name); !seen[key] {
+			seen[key] = true
+			normalized = append(normalized, name)
+		}
+	}
+	return normalized, nil
+}
@@ -0,0 +1,286 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mehrnoosh-hk/devnorth-back/internal/domain"
+)
+
+// translationUseCase implements domain.TranslationUseCase
+type translationUseCase struct {
+	translationRepo domain.TranslationRepository
+	competencyRepo  domain.CompetencyRepository
+	rubricRepo      domain.RubricRepository
+	stewardRepo     domain.StewardRepository
+	transactor      domain.Transactor
+	logger          *slog.Logger
+}
+
+// NewTranslationUseCase creates a new translation use case instance
+func NewTranslationUseCase(
+	translationRepo domain.TranslationRepository,
+	competencyRepo domain.CompetencyRepository,
+	rubricRepo domain.RubricRepository,
+	stewardRepo domain.StewardRepository,
+	transactor domain.Transactor,
+	logger *slog.Logger,
+) (domain.TranslationUseCase, error) {
+	// Nil-check the injected dependencies
+	if translationRepo == nil {
+		return nil, ErrTranslationRepositoryNil
+	}
+	if competencyRepo == nil {
+		return nil, ErrCompetencyRepositoryNil
+	}
+	if rubricRepo == nil {
+		return nil, ErrRubricRepositoryNil
+	}
+	if stewardRepo == nil {
+		return nil, ErrStewardRepositoryNil
+	}
+	if transactor == nil {
+		return nil, ErrTransactorNil
+	}
+	if logger == nil {
+		return nil, ErrLoggerNil
+	}
+	return &translationUseCase{
+		translationRepo: translationRepo,
+		competencyRepo:  competencyRepo,
+		rubricRepo:      rubricRepo,
+		stewardRepo:     stewardRepo,
+		transactor:      transactor,
+		logger:          logger,
+	}, nil
+}
+
+// GetTranslations retrieves the translations of a competency in every locale
+func (uc *translationUseCase) GetTranslations(ctx context.Context, competencyID int32) ([]*domain.CompetencyTranslation, error) {
+	if err := requireEditor(ctx, uc.stewardRepo, competencyID); err != nil {
+		uc.logger.InfoContext(ctx, "competency translations read not allowed", "reason", err, "competency_id", competencyID)
+		return nil, err
+	}
+
+	var translations []*domain.CompetencyTranslation
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if _, err := uc.competencyRepo.GetByID(ctx, competencyID); err != nil {
+			return err
+		}
+		var err error
+		translations, err = uc.translationRepo.GetAll(ctx, competencyID)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrCompetencyNotFound) {
+			return nil, domain.ErrCompetencyNotFound
+		}
+		uc.logger.ErrorContext(ctx, "failed to get competency translations", "error", err, "competency_id", competencyID)
+		return nil, fmt.Errorf("%w: %w", ErrGetTranslations, err)
+	}
+	return translations, nil
+}
+
+// SetTranslation creates or replaces the translation of a competency into a locale
+// Business logic flow:
+// 1. Authorize
+// 2. Check the locale, then normalize and validate the texts
+// 3. Check the competency, and that the translated levels are described in its rubric
+// 4. Check the name isn't shown by another competency in the locale
+// 5. Save the translation and bump the version of the competency
+func (uc *translationUseCase) SetTranslation(ctx context.Context, translation domain.CompetencyTranslation) (*domain.CompetencyTranslation, error) {
+	// Step 1: Authorize
+	if err := requireEditor(ctx, uc.stewardRepo, translation.CompetencyID); err != nil {
+		uc.logger.InfoContext(ctx, "competency translation not allowed", "reason", err, "competency_id", translation.CompetencyID)
+		return nil, err
+	}
+
+	// Step 2: Validate
+	translation, err := normalizeTranslation(translation)
+	if err != nil {
+		uc.logger.InfoContext(ctx, "invalid competency translation", "error", err, "competency_id", translation.CompetencyID, "locale", translation.Locale)
+		return nil, err
+	}
+
+	var saved *domain.CompetencyTranslation
+	err = uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		// Step 3: Check references
+		if _, err := uc.competencyRepo.GetByID(ctx, translation.CompetencyID); err != nil {
+			return err
+		}
+		if len(translation.Levels) > 0 {
+			rubric, err := uc.rubricRepo.GetRubric(ctx, translation.CompetencyID)
+			if err != nil {
+				return err
+			}
+			for _, level := range translation.Levels {
+				described := slices.ContainsFunc(rubric.Levels, func(l domain.CompetencyLevel) bool {
+					return l.Value == level.Value && l.IsDescribed()
+				})
+				if !described {
+					return fmt.Errorf("%w: %d", domain.ErrCompetencyLevelNotFound, level.Value)
+				}
+			}
+		}
+
+		// Step 4: Names are unique per locale
+		if err := checkNameAvailable(ctx, uc.translationRepo, translation.Name, []domain.Locale{translation.Locale}, translation.CompetencyID); err != nil {
+			return err
+		}
+
+		// Step 5: Save
+		if saved, err = uc.translationRepo.Set(ctx, translation); err != nil {
+			return err
+		}
+		return uc.translationRepo.TouchCompetency(ctx, translation.CompetencyID)
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrCompetencyNotFound),
+			errors.Is(err, domain.ErrRubricNotFound),
+			errors.Is(err, domain.ErrCompetencyLevelNotFound),
+			errors.Is(err, domain.ErrCompetencyAlreadyExists):
+			uc.logger.InfoContext(ctx, "competency translation refused", "reason", err, "competency_id", translation.CompetencyID, "locale", translation.Locale)
+			return nil, err
+		}
+		uc.logger.ErrorContext(ctx, "failed to set competency translation", "error", err, "competency_id", translation.CompetencyID)
+		return nil, fmt.Errorf("%w: %w", ErrUpdateTranslation, err)
+	}
+
+	uc.logger.InfoContext(ctx, "competency translation set successfully", "competency_id", translation.CompetencyID, "locale", translation.Locale)
+	return saved, nil
+}
+
+// DeleteTranslation deletes the translation of a competency into a locale
+func (uc *translationUseCase) DeleteTranslation(ctx context.Context, competencyID int32, locale domain.Locale) error {
+	if err := requireEditor(ctx, uc.stewardRepo, competencyID); err != nil {
+		uc.logger.InfoContext(ctx, "competency translation deletion not allowed", "reason", err, "competency_id", competencyID)
+		return err
+	}
+	if !locale.Valid() {
+		return domain.ErrUnsupportedLocale
+	}
+
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.translationRepo.Delete(ctx, competencyID, locale); err != nil {
+			return err
+		}
+		return uc.translationRepo.TouchCompetency(ctx, competencyID)
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrTranslationNotFound) {
+			return domain.ErrTranslationNotFound
+		}
+		uc.logger.ErrorContext(ctx, "failed to delete competency translation", "error", err, "competency_id", competencyID)
+		return fmt.Errorf("%w: %w", ErrUpdateTranslation, err)
+	}
+
+	uc.logger.InfoContext(ctx, "competency translation deleted successfully", "competency_id", competencyID, "locale", locale)
+	return nil
+}
+
+// GetMissing reports the competencies lacking translations into a locale, or into every translated locale
+func (uc *translationUseCase) GetMissing(ctx context.Context, locale domain.Locale) ([]*domain.MissingTranslation, error) {
+	if err := domain.RequireAdmin(ctx); err != nil {
+		uc.logger.InfoContext(ctx, "missing translations report not allowed", "reason", err)
+		return nil, err
+	}
+
+	locales := domain.TranslatedLocales()
+	if locale != "" {
+		if !locale.Valid() || locale == domain.FallbackLocale {
+			uc.logger.InfoContext(ctx, "unsupported translation locale", "locale", locale)
+			return nil, domain.ErrUnsupportedLocale
+		}
+		locales = []domain.Locale{locale}
+	}
+
+	missing, err := uc.translationRepo.GetMissing(ctx, locales)
+	if err != nil {
+		uc.logger.ErrorContext(ctx, "failed to get missing translations", "error", err)
+		return nil, fmt.Errorf("%w: %w", ErrGetTranslations, err)
+	}
+
+	uc.logger.InfoContext(ctx, "missing translations retrieved successfully", "count", len(missing))
+	return missing, nil
+}
+
+// checkNameAvailable checks that no competency other than competencyID shows name in any of locales, as its
+// translation or as its own name; names are unique per locale
+// Returns domain.ErrCompetencyAlreadyExists if one does
+func checkNameAvailable(ctx context.Context, translationRepo domain.TranslationRepository, name string, locales []domain.Locale, competencyID int32) error {
+	conflicts, err := translationRepo.GetNameConflicts(ctx, name, locales, competencyID)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrCheckExistingCompetency, err)
+	}
+	if len(conflicts) > 0 {
+		return domain.ErrCompetencyAlreadyExists
+	}
+	return nil
+}
+
+// normalizeTranslation trims the texts of a translation and checks them
+// The name follows the rules of competency names, counted in characters since translations aren't all ASCII
+func normalizeTranslation(translation domain.CompetencyTranslation) (domain.CompetencyTranslation, error) {
+	if !translation.Locale.Valid() {
+		return translation, domain.ErrUnsupportedLocale
+	}
+	if translation.Locale == domain.FallbackLocale {
+		return translation, fmt.Errorf("%w: %s texts are edited on the competency itself", domain.ErrInvalidTranslation, domain.FallbackLocale)
+	}
+
+	translation.Name = strings.TrimSpace(translation.Name)
+	translation.Description = strings.TrimSpace(translation.Description)
+	if length := utf8.RuneCountInString(translation.Name); length < 2 || length > 100 {
+		return translation, fmt.Errorf("%w: name must be 2-100 characters", domain.ErrInvalidTranslation)
+	}
+
+	levels := make([]domain.CompetencyLevel, len(translation.Levels))
+	seen := make(map[int32]bool, len(translation.Levels))
+	for i, level := range translation.Levels {
+		level, err := normalizeCompetencyLevel(level)
+		if err != nil {
+			return translation, err
+		}
+		if !level.IsDescribed() {
+			return translation, fmt.Errorf("%w: level %d has no description nor indicators", domain.ErrInvalidTranslation, level.Value)
+		}
+		if seen[level.Value] {
+			return translation, fmt.Errorf("%w: level %d is given more than once", domain.ErrInvalidTranslation, level.Value)
+		}
+		seen[level.Value] = true
+		levels[i] = level
+	}
+	slices.SortFunc(levels, func(a, b domain.CompetencyLevel) int { return int(a.Value - b.Value) })
+	translation.Levels = levels
+	return translation, nil
+}
+
+// localize replaces the texts of competencies with their translation into the locale of ctx (see domain.Competency.Localize)
+func localize(ctx context.Context, translationRepo domain.TranslationRepository, competencies ...*domain.Competency) error {
+	locale := domain.LocaleFromContext(ctx)
+	if locale == domain.FallbackLocale || len(competencies) == 0 {
+		for _, competency := range competencies {
+			competency.Localize(nil)
+		}
+		return nil
+	}
+
+	ids := make([]int32, len(competencies))
+	for i, competency := range competencies {
+		ids[i] = competency.ID
+	}
+	translations, err := translationRepo.GetByLocale(ctx, locale, ids)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrLocalize, err)
+	}
+	for _, competency := range competencies {
+		competency.Localize(translations[competency.ID])
+	}
+	return nil
+}
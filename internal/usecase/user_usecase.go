@@ -63,38 +63,38 @@ func (uc *userUseCase) Register(ctx context.Context, email, password string) (*d
 
 	// Step 1: Validate email (basic validation for POC)
 	if err := uc.validateEmail(email); err != nil {
-		uc.logger.Error("failed to validate email", "error", err)
+		uc.logger.ErrorContext(ctx, "failed to validate email", "error", err)
 		return nil, err
 	}
 
 	// Step 2: Validate password (basic validation for POC)
 	if err := uc.validatePassword(password); err != nil {
-		uc.logger.Error("failed to validate password", "error", err)
+		uc.logger.ErrorContext(ctx, "failed to validate password", "error", err)
 		return nil, err
 	}
 
 	// Step 3: Check if email already exists
 	existingUser, err := uc.userRepo.GetByEmail(ctx, email)
 	if err != nil && !errors.Is(err, domain.ErrUserNotFound) {
-		uc.logger.Error("failed to check existing user", "error", err)
+		uc.logger.ErrorContext(ctx, "failed to check existing user", "error", err)
 		return nil, fmt.Errorf("%w: %w", ErrCheckExistingUser, err)
 	}
 	if existingUser != nil {
-		uc.logger.Error("email already exists", "email", email)
+		uc.logger.ErrorContext(ctx, "email already exists", "email", email)
 		return nil, domain.ErrEmailAlreadyExists
 	}
 
 	// Step 4: Hash password
 	hashedPassword, err := uc.passwordHasher.Hash(password)
 	if err != nil {
-		uc.logger.Error("failed to hash password", "error", err)
+		uc.logger.ErrorContext(ctx, "failed to hash password", "error", err)
 		return nil, fmt.Errorf("%w: %w", ErrHashPassword, err)
 	}
 
 	// Step 5: Create user with default role (USER)
 	user, err := uc.userRepo.Create(ctx, email, hashedPassword, domain.UserRoleUSER)
 	if err != nil {
-		uc.logger.Error("failed to create user", "error", err)
+		uc.logger.ErrorContext(ctx, "failed to create user", "error", err)
 		return nil, fmt.Errorf("%w: %w", ErrCreateUser, err)
 	}
 
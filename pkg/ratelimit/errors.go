@@ -0,0 +1,8 @@
+package ratelimit
+
+import "errors"
+
+var (
+	// ErrStoreNil is returned when a limiter is created without a store
+	ErrStoreNil = errors.New("rate limit store cannot be nil")
+)
@@ -1,6 +1,8 @@
 package ratelimit
 
 import (
+	"context"
+	"fmt"
 	"maps"
 	"sync"
 	"time"
@@ -9,73 +11,34 @@ import (
 // RateLimit defines the maximum number of requests allowed within a time window
 type RateLimit struct {
 	MaxRequests int           // Maximum number of requests allowed
-	Window      time.Duration // Time window for rate limiting (sliding window)
+	Window      time.Duration // Time window for rate limiting
 }
 
-// Limiter implements a sliding window rate limiter that tracks requests per IP and path
+// Limiter enforces named rate limits for arbitrary request identities (IP, user ID, email, ...)
+// Request counting is delegated to a Store, which decides whether state is local or shared
 type Limiter struct {
-	mu     sync.Mutex
-	limits map[string]RateLimit // Per-path rate limit configuration
-	// store maps IP addresses to their request history per path
-	// Structure: IP -> Path -> []timestamp of requests
-	store map[string]map[string][]time.Time
+	mu     sync.RWMutex
+	limits map[string]RateLimit // Rate limit configuration per limit name
+	store  Store
 }
 
-// Allow checks if a request from the given IP to the given path should be allowed
+// Allow checks if a request identified by key should be allowed under the named limit
 // Returns true if the request is within rate limits, false otherwise
-func (l *Limiter) Allow(ip, path string) bool {
-	l.mu.Lock()
-	defer l.mu.Unlock()
+// An error is returned only when the store could not be consulted
+func (l *Limiter) Allow(ctx context.Context, name, key string) (bool, error) {
+	l.mu.RLock()
+	rateLimit, exists := l.limits[name]
+	l.mu.RUnlock()
 
-	// If path has no rate limit configured, allow the request
-	rateLimit, exists := l.limits[path]
+	// If no limit is configured under this name, allow the request
 	if !exists {
-		return true
-	}
-
-	// Initialize IP entry if this is the first request from this IP
-	if l.store[ip] == nil {
-		l.store[ip] = make(map[string][]time.Time)
-	}
-
-	// Get request history for this IP and path
-	// Note: Returns nil if path not accessed before, which is safe in Go
-	history := l.store[ip][path]
-
-	// Filter out expired requests (outside the sliding window)
-	validRequests := l.filterValidRequests(history, rateLimit.Window)
-	l.store[ip][path] = validRequests
-
-	// Check if adding this request would exceed the limit
-	if len(validRequests) >= rateLimit.MaxRequests {
-		return false
-	}
-
-	// Allow the request and record it
-	l.store[ip][path] = append(validRequests, time.Now())
-	return true
-}
-
-// filterValidRequests returns only the requests that fall within the current time window
-func (l *Limiter) filterValidRequests(requests []time.Time, window time.Duration) []time.Time {
-	if len(requests) == 0 {
-		return []time.Time{}
-	}
-
-	now := time.Now()
-	cutoff := now.Add(-window)
-
-	valid := make([]time.Time, 0, len(requests))
-	for _, timestamp := range requests {
-		if timestamp.After(cutoff) {
-			valid = append(valid, timestamp)
-		}
+		return true, nil
 	}
 
-	return valid
+	return l.store.Allow(ctx, fmt.Sprintf("%s:%s", name, key), rateLimit)
 }
 
-// AddLimit adds or updates rate limits for multiple paths
+// AddLimit adds or updates multiple named rate limits
 // This method is thread-safe and can be called during runtime
 func (l *Limiter) AddLimit(limitList map[string]RateLimit) {
 	l.mu.Lock()
@@ -83,11 +46,32 @@ func (l *Limiter) AddLimit(limitList map[string]RateLimit) {
 	maps.Copy(l.limits, limitList)
 }
 
-// NewLimiter creates a new rate limiter instance
-// Use AddLimit to configure rate limits for specific paths
-func NewLimiter() *Limiter {
+// RunCleanup periodically removes expired state from the store until ctx is cancelled
+// onError is called for every failed cleanup and may be nil
+func (l *Limiter) RunCleanup(ctx context.Context, interval time.Duration, onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := l.store.Cleanup(ctx); err != nil && onError != nil {
+				onError(err)
+			}
+		}
+	}
+}
+
+// NewLimiter creates a new rate limiter backed by the given store
+// Use AddLimit to configure the named rate limits
+func NewLimiter(store Store) (*Limiter, error) {
+	if store == nil {
+		return nil, ErrStoreNil
+	}
 	return &Limiter{
 		limits: make(map[string]RateLimit),
-		store:  make(map[string]map[string][]time.Time),
-	}
+		store:  store,
+	}, nil
 }
@@ -0,0 +1,87 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// memoryEntry holds the request history of a single key
+type memoryEntry struct {
+	window   time.Duration // Window of the limit the key was last checked against
+	requests []time.Time   // Timestamps of allowed requests
+}
+
+// MemoryStore implements Store with an in-process sliding window
+// State is not shared between instances, so each instance enforces limits on its own
+type MemoryStore struct {
+	mu sync.Mutex
+	// entries maps a key to its request history
+	entries map[string]*memoryEntry
+}
+
+// NewMemoryStore creates a new in-memory rate limit store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		entries: make(map[string]*memoryEntry),
+	}
+}
+
+// Allow checks the sliding window of the key and records the request if it is allowed
+func (s *MemoryStore) Allow(ctx context.Context, key string, limit RateLimit) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Initialize entry if this is the first request for this key
+	entry, exists := s.entries[key]
+	if !exists {
+		entry = &memoryEntry{}
+		s.entries[key] = entry
+	}
+	entry.window = limit.Window
+
+	// Filter out expired requests (outside the sliding window)
+	entry.requests = filterValidRequests(entry.requests, limit.Window)
+
+	// Check if adding this request would exceed the limit
+	if len(entry.requests) >= limit.MaxRequests {
+		return false, nil
+	}
+
+	// Allow the request and record it
+	entry.requests = append(entry.requests, time.Now())
+	return true, nil
+}
+
+// Cleanup removes keys that have no requests left inside their window
+func (s *MemoryStore) Cleanup(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for key, entry := range s.entries {
+		entry.requests = filterValidRequests(entry.requests, entry.window)
+		if len(entry.requests) == 0 {
+			delete(s.entries, key)
+		}
+	}
+	return nil
+}
+
+// filterValidRequests returns only the requests that fall within the current time window
+func filterValidRequests(requests []time.Time, window time.Duration) []time.Time {
+	if len(requests) == 0 {
+		return []time.Time{}
+	}
+
+	now := time.Now()
+	cutoff := now.Add(-window)
+
+	valid := make([]time.Time, 0, len(requests))
+	for _, timestamp := range requests {
+		if timestamp.After(cutoff) {
+			valid = append(valid, timestamp)
+		}
+	}
+
+	return valid
+}
@@ -0,0 +1,114 @@
+package ratelimit
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"testing"
+	"time"
+)
+
+// seedEntry records requests made the given durations ago under a one minute window
+func seedEntry(ages ...time.Duration) *memoryEntry {
+	entry := &memoryEntry{window: time.Minute, requests: []time.Time{}}
+	for _, age := range ages {
+		entry.requests = append(entry.requests, time.Now().Add(-age))
+	}
+	return entry
+}
+
+// TestMemoryStoreAllow checks the sliding window through a limiter: limits are enforced per name and key,
+// rejected requests aren't counted and requests older than the window no longer count
+func TestMemoryStoreAllow(t *testing.T) {
+	type call struct {
+		name, key string
+		want      bool
+	}
+	limits := map[string]RateLimit{
+		"api":  {MaxRequests: 2, Window: time.Minute},
+		"auth": {MaxRequests: 1, Window: time.Minute},
+	}
+
+	tests := []struct {
+		name  string
+		seed  map[string]*memoryEntry
+		calls []call
+	}{
+		{
+			name:  "within the limit",
+			calls: []call{{"api", "ip:1", true}, {"api", "ip:1", true}},
+		},
+		{
+			name:  "limit reached",
+			calls: []call{{"api", "ip:1", true}, {"api", "ip:1", true}, {"api", "ip:1", false}, {"api", "ip:1", false}},
+		},
+		{
+			name:  "window reset",
+			seed:  map[string]*memoryEntry{"api:ip:1": seedEntry(2*time.Minute, 90*time.Second)},
+			calls: []call{{"api", "ip:1", true}, {"api", "ip:1", true}, {"api", "ip:1", false}},
+		},
+		{
+			name:  "partly expired window",
+			seed:  map[string]*memoryEntry{"api:ip:1": seedEntry(2*time.Minute, 10*time.Second)},
+			calls: []call{{"api", "ip:1", true}, {"api", "ip:1", false}},
+		},
+		{
+			name:  "keys stay separate",
+			calls: []call{{"auth", "ip:1", true}, {"auth", "ip:1", false}, {"auth", "ip:2", true}, {"auth", "user:1", true}},
+		},
+		{
+			name:  "names stay separate",
+			seed:  map[string]*memoryEntry{"auth:ip:1": seedEntry(time.Second)},
+			calls: []call{{"auth", "ip:1", false}, {"api", "ip:1", true}, {"api", "ip:1", true}, {"api", "ip:1", false}},
+		},
+		{
+			name:  "unknown names are not limited",
+			calls: []call{{"other", "ip:1", true}, {"other", "ip:1", true}, {"other", "ip:1", true}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := NewMemoryStore()
+			maps.Copy(store.entries, tt.seed)
+			limiter, err := NewLimiter(store)
+			if err != nil {
+				t.Fatalf("NewLimiter: %v", err)
+			}
+			limiter.AddLimit(limits)
+
+			for i, c := range tt.calls {
+				got, err := limiter.Allow(context.Background(), c.name, c.key)
+				if err != nil {
+					t.Fatalf("call %d: Allow(%q, %q) error = %v", i, c.name, c.key, err)
+				}
+				if got != c.want {
+					t.Errorf("call %d: Allow(%q, %q) = %v, want %v", i, c.name, c.key, got, c.want)
+				}
+			}
+		})
+	}
+}
+
+// TestMemoryStoreCleanup checks that only keys without requests inside their window are removed
+func TestMemoryStoreCleanup(t *testing.T) {
+	store := NewMemoryStore()
+	store.entries = map[string]*memoryEntry{
+		"api:expired": seedEntry(2*time.Minute, 90*time.Second),
+		"api:active":  seedEntry(10*time.Second, time.Second),
+		"api:mixed":   seedEntry(2*time.Minute, 10*time.Second),
+		"api:empty":   seedEntry(),
+	}
+
+	if err := store.Cleanup(context.Background()); err != nil {
+		t.Fatalf("Cleanup: %v", err)
+	}
+
+	if got, want := slices.Sorted(maps.Keys(store.entries)), []string{"api:active", "api:mixed"}; !slices.Equal(got, want) {
+		t.Fatalf("keys after Cleanup = %v, want %v", got, want)
+	}
+	for key, want := range map[string]int{"api:active": 2, "api:mixed": 1} {
+		if got := len(store.entries[key].requests); got != want {
+			t.Errorf("%s has %d requests after Cleanup, want %d", key, got, want)
+		}
+	}
+}
@@ -0,0 +1,15 @@
+package ratelimit
+
+import "context"
+
+// Store defines the contract for rate limit state storage
+// Implementations decide how request counts are tracked (in-process, shared database, ...)
+// so the same Limiter can enforce limits on a single instance or across many
+type Store interface {
+	// Allow records a request for the given key and reports whether it fits within the limit
+	// A rejected request must not be counted against the key
+	Allow(ctx context.Context, key string, limit RateLimit) (bool, error)
+
+	// Cleanup removes state that can no longer affect any limit decision
+	Cleanup(ctx context.Context) error
+}